@@ -28,6 +28,30 @@ func main() {
 	ast := cmd.NewGenerateASTCommand()
 	root.AddCommand(ast)
 
+	format := cmd.NewFormatCommand()
+	root.AddCommand(format)
+
+	lint := cmd.NewLintCommand()
+	root.AddCommand(lint)
+
+	codegen := cmd.NewCodegenCommand()
+	root.AddCommand(codegen)
+
+	fuzz := cmd.NewFuzzCommand()
+	root.AddCommand(fuzz)
+
+	graph := cmd.NewGraphCommand()
+	root.AddCommand(graph)
+
+	seed := cmd.NewSeedCommand()
+	root.AddCommand(seed)
+
+	repl := cmd.NewReplCommand()
+	root.AddCommand(repl)
+
+	lsp := cmd.NewLSPCommand()
+	root.AddCommand(lsp)
+
 	migrate := cmd.NewMigrateCommand()
 	root.AddCommand(migrate)
 
@@ -119,6 +119,7 @@ type (
 		Headers  []string `mapstructure:"headers"`
 		Interval int      `mapstructure:"interval"`
 		Protocol string   `mapstructure:"protocol"` // Protocol for the metrics exporter, e.g., http, grpc
+		Port     string   `mapstructure:"port"`     // Dedicated port for the prometheus /metrics endpoint, the HTTP server is used if empty
 	}
 
 	// Service contains configuration for various service-level features.
@@ -128,6 +129,7 @@ type (
 		Schema         Schema     `mapstructure:"schema"`          // Schema service configuration
 		Permission     Permission `mapstructure:"permission"`      // Permission service configuration
 		Data           Data       `mapstructure:"data"`            // Data service configuration
+		Tenancy        Tenancy    `mapstructure:"tenancy"`         // Tenancy service configuration
 	}
 
 	// Watch contains configuration for the watch service.
@@ -150,6 +152,12 @@ type (
 	// Data is a placeholder struct for the data service configuration.
 	Data struct{}
 
+	// Tenancy contains configuration for the tenancy service.
+	Tenancy struct {
+		Retention     time.Duration `mapstructure:"retention"`      // How long soft deleted tenants are kept before they are purged, zero deletes immediately
+		PurgeInterval time.Duration `mapstructure:"purge_interval"` // Interval between runs of the soft deleted tenant purge
+	}
+
 	// Cache contains configuration for caching.
 	Cache struct {
 		NumberOfCounters int64  `mapstructure:"number_of_counters"` // Number of counters for the cache
@@ -175,6 +183,8 @@ type (
 		MaxRetries            int               `mapstructure:"max_retries"`
 		WatchBufferSize       int               `mapstructure:"watch_buffer_size"`
 		GarbageCollection     GarbageCollection `mapstructure:"garbage_collection"`
+		TenantDeletion        TenantDeletion    `mapstructure:"tenant_deletion"`
+		Seed                  string            `mapstructure:"seed"` // Shape file the memory database is seeded with at startup
 	}
 
 	GarbageCollection struct {
@@ -184,6 +194,12 @@ type (
 		Window   time.Duration `mapstructure:"window"`
 	}
 
+	// TenantDeletion contains configuration for the background removal of deleted tenants' data.
+	TenantDeletion struct {
+		Interval  time.Duration `mapstructure:"interval"`   // Interval between checks for tenants marked for deletion
+		BatchSize int           `mapstructure:"batch_size"` // Maximum number of rows removed by a single statement
+	}
+
 	Distributed struct {
 		Enabled bool   `mapstructure:"enabled"`
 		Address string `mapstructure:"address"`
@@ -333,6 +349,10 @@ func DefaultConfig() *Config {
 				},
 			},
 			Data: Data{},
+			Tenancy: Tenancy{
+				Retention:     0,
+				PurgeInterval: time.Hour,
+			},
 		},
 		Authn: Authn{
 			Enabled:   false,
@@ -357,6 +377,10 @@ func DefaultConfig() *Config {
 			GarbageCollection: GarbageCollection{
 				Enabled: false,
 			},
+			TenantDeletion: TenantDeletion{
+				Interval:  10 * time.Second,
+				BatchSize: 10_000,
+			},
 		},
 		Distributed: Distributed{
 			Enabled: false,
@@ -2721,7 +2721,8 @@ func newSchema(model string) ([]storage.SchemaDefinition, error) {
 		return nil, err
 	}
 
-	_, _, err = compiler.NewCompiler(false, sch).Compile()
+	c := compiler.NewCompiler(false, sch)
+	_, _, err = c.Compile()
 	if err != nil {
 		return nil, err
 	}
@@ -2730,11 +2731,15 @@ func newSchema(model string) ([]storage.SchemaDefinition, error) {
 
 	cnf := make([]storage.SchemaDefinition, 0, len(sch.Statements))
 	for _, st := range sch.Statements {
+		serialized, err := c.Serialize(st)
+		if err != nil {
+			return nil, err
+		}
 		cnf = append(cnf, storage.SchemaDefinition{
 			TenantID:             "t1",
 			Version:              version,
 			Name:                 st.GetName(),
-			SerializedDefinition: []byte(st.String()),
+			SerializedDefinition: []byte(serialized),
 		})
 	}
 
@@ -30,6 +30,8 @@ type CheckEngine struct {
 	dataReader storage.DataReader
 	// concurrencyLimit is the maximum number of concurrent permission checks allowed
 	concurrencyLimit int
+	// recorder receives the results of the children of the permissions, if set
+	recorder BranchRecorder
 }
 
 // NewCheckEngine creates a new CheckEngine instance for performing permission checks.
@@ -138,10 +140,11 @@ func (engine *CheckEngine) check(
 		// If the child has a rewrite, check the rewrite.
 		// If not, check the leaf.
 		if child.GetRewrite() != nil {
-			fn = engine.checkRewrite(ctx, request, child.GetRewrite())
+			fn = engine.checkRewrite(ctx, request, child.GetRewrite(), []int{})
 		} else {
 			fn = engine.checkLeaf(request, child.GetLeaf())
 		}
+		fn = engine.record(request, []int{}, fn)
 	case base.EntityDefinition_REFERENCE_ATTRIBUTE:
 		// If the reference is an attribute, check the direct attribute.
 		fn = engine.checkDirectAttribute(request)
@@ -165,21 +168,22 @@ func (engine *CheckEngine) check(
 
 // checkRewrite prepares a CheckFunction according to the provided Rewrite operation.
 // It uses a Rewrite object that describes how to combine the results of multiple CheckFunctions.
-func (engine *CheckEngine) checkRewrite(ctx context.Context, request *base.PermissionCheckRequest, rewrite *base.Rewrite) CheckFunction {
+// The path is the position of the rewrite in the permission tree, as the indexes of the children leading to it.
+func (engine *CheckEngine) checkRewrite(ctx context.Context, request *base.PermissionCheckRequest, rewrite *base.Rewrite, path []int) CheckFunction {
 	// Switch statement depending on the Rewrite operation
 	switch rewrite.GetRewriteOperation() {
 	// In case of UNION operation, set the children CheckFunctions to be run concurrently
 	// and return the permission if any of the CheckFunctions succeeds (union).
 	case *base.Rewrite_OPERATION_UNION.Enum():
-		return engine.setChild(ctx, request, rewrite.GetChildren(), checkUnion)
+		return engine.setChild(ctx, request, rewrite.GetChildren(), path, checkUnion)
 	// In case of INTERSECTION operation, set the children CheckFunctions to be run concurrently
 	// and return the permission if all the CheckFunctions succeed (intersection).
 	case *base.Rewrite_OPERATION_INTERSECTION.Enum():
-		return engine.setChild(ctx, request, rewrite.GetChildren(), checkIntersection)
+		return engine.setChild(ctx, request, rewrite.GetChildren(), path, checkIntersection)
 	// In case of EXCLUSION operation, set the children CheckFunctions to be run concurrently
 	// and return the permission if the first CheckFunction succeeds and all others fail (exclusion).
 	case *base.Rewrite_OPERATION_EXCLUSION.Enum():
-		return engine.setChild(ctx, request, rewrite.GetChildren(), checkExclusion)
+		return engine.setChild(ctx, request, rewrite.GetChildren(), path, checkExclusion)
 	// In case of an undefined child type, return a CheckFunction that always fails.
 	default:
 		return checkFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
@@ -220,20 +224,27 @@ func (engine *CheckEngine) setChild(
 	ctx context.Context,
 	request *base.PermissionCheckRequest,
 	children []*base.Child,
+	path []int,
 	combiner CheckCombiner,
 ) CheckFunction {
 	// Create a slice to store the CheckFunctions
 	var functions []CheckFunction
 	// Loop over each child node
-	for _, child := range children {
+	for i, child := range children {
+		// The path of the child is the path of its parent followed by its index, it is only computed for the
+		// branch recorder
+		var childPath []int
+		if engine.recorder != nil {
+			childPath = append(append(make([]int, 0, len(path)+1), path...), i)
+		}
 		// Switch on the type of the child node
 		switch child.GetType().(type) {
 		// In case of a Rewrite node, create a CheckFunction for the Rewrite and append it
 		case *base.Child_Rewrite:
-			functions = append(functions, engine.checkRewrite(ctx, request, child.GetRewrite()))
+			functions = append(functions, engine.record(request, childPath, engine.checkRewrite(ctx, request, child.GetRewrite(), childPath)))
 		// In case of a Leaf node, create a CheckFunction for the Leaf and append it
 		case *base.Child_Leaf:
-			functions = append(functions, engine.checkLeaf(request, child.GetLeaf()))
+			functions = append(functions, engine.record(request, childPath, engine.checkLeaf(request, child.GetLeaf())))
 		// In case of an undefined type, return a CheckFunction that always fails
 		default:
 			return checkFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
@@ -247,6 +258,22 @@ func (engine *CheckEngine) setChild(
 	}
 }
 
+// record wraps the CheckFunction of the child at the given path of the requested permission, so that its result is
+// passed to the branch recorder. The recorded children are not cancelled once the result of their parent is known,
+// so that the recorded results do not depend on which child finished first. Children that fail are not recorded.
+func (engine *CheckEngine) record(request *base.PermissionCheckRequest, path []int, fn CheckFunction) CheckFunction {
+	if engine.recorder == nil {
+		return fn
+	}
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		res, err := fn(context.WithoutCancel(ctx))
+		if err == nil {
+			engine.recorder.RecordBranch(request.GetEntity().GetType(), request.GetPermission(), path, res.GetCan() == base.CheckResult_CHECK_RESULT_ALLOWED)
+		}
+		return res, err
+	}
+}
+
 // checkDirectRelation is a method of CheckEngine struct that returns a CheckFunction.
 // It's responsible for directly checking the permissions on an entity
 func (engine *CheckEngine) checkDirectRelation(request *base.PermissionCheckRequest) CheckFunction {
@@ -482,6 +509,17 @@ func (engine *CheckEngine) checkDirectAttribute(
 			}
 		}
 
+		if val == nil {
+			// The attribute is not written, so fall back to its default value declared in the schema, if any.
+			defaults, err := getDefaultValues(ctx, engine.schemaReader, request.GetTenantId(), request.GetEntity().GetType(), request.GetMetadata().GetSchemaVersion(), request.GetPermission())
+			if err != nil {
+				return denied(emptyResponseMetadata()), err
+			}
+			if value, ok := defaults[request.GetPermission()]; ok {
+				val = &base.Attribute{Entity: request.GetEntity(), Attribute: request.GetPermission(), Value: value}
+			}
+		}
+
 		// No attribute was found matching the provided filter. In this case, we return a denied response with empty metadata
 		// and no error.
 		if val == nil {
@@ -552,6 +590,9 @@ func (engine *CheckEngine) checkDirectCall(
 		// List to store computed attributes.
 		attributes := make([]string, 0)
 
+		// Values of the attributes of the related entities, by the names of the arguments.
+		related := make(map[string][]interface{})
+
 		// Iterate over request arguments to classify and process them.
 		for _, arg := range request.GetArguments() {
 			switch actualArg := arg.Type.(type) {
@@ -561,6 +602,17 @@ func (engine *CheckEngine) checkDirectCall(
 				emptyValue := getEmptyValueForType(ru.GetArguments()[attrName])
 				arguments[attrName] = emptyValue
 				attributes = append(attributes, attrName)
+			case *base.Argument_TupleToComputedAttribute:
+				// Handle attributes of the related entities: Take the value of each related entity.
+				attrName := actualArg.TupleToComputedAttribute.GetComputed().GetName()
+				values, err := getRelatedAttributeValues(ctx, engine.schemaReader, engine.dataReader, request.GetTenantId(), request.GetEntity(), actualArg.TupleToComputedAttribute, ru.GetArguments()[attrName], request.GetMetadata().GetSnapToken(), request.GetMetadata().GetSchemaVersion(), request.GetContext())
+				if err != nil {
+					return denied(emptyResponseMetadata()), err
+				}
+				related[attrName] = make([]interface{}, 0, len(values))
+				for _, value := range values {
+					related[attrName] = append(related[attrName], utils.ConvertProtoAnyToInterface(value))
+				}
 			default:
 				// Return an error for any unsupported argument types.
 				return denied(emptyResponseMetadata()), fmt.Errorf(base.ErrorCode_ERROR_CODE_INTERNAL.String())
@@ -569,6 +621,15 @@ func (engine *CheckEngine) checkDirectCall(
 
 		// If there are computed attributes, fetch them from the data source.
 		if len(attributes) > 0 {
+			// Attributes that are not written take the default values declared in the schema.
+			defaults, err := getDefaultValues(ctx, engine.schemaReader, request.GetTenantId(), request.GetEntity().GetType(), request.GetMetadata().GetSchemaVersion(), attributes...)
+			if err != nil {
+				return denied(emptyResponseMetadata()), err
+			}
+			for attr, value := range defaults {
+				arguments[attr] = utils.ConvertProtoAnyToInterface(value)
+			}
+
 			filter := &base.AttributeFilter{
 				Entity: &base.EntityFilter{
 					Type: request.GetEntity().GetType(),
@@ -611,24 +672,18 @@ func (engine *CheckEngine) checkDirectCall(
 			return nil, err
 		}
 
-		// Evaluate the rule expression with the provided arguments.
-		out, _, err := prg.Eval(arguments)
+		// Evaluate the rule expression with the provided arguments, for the combinations of the related entities until
+		// one of them is true.
+		result, err := evaluateCombinations(prg, arguments, related)
 		if err != nil {
-			return denied(emptyResponseMetadata()), fmt.Errorf("failed to evaluate expression: %w", err)
-		}
-
-		// Ensure the result of evaluation is boolean and decide on permission.
-		result, ok := out.Value().(bool)
-		if !ok {
-			return denied(emptyResponseMetadata()), fmt.Errorf("expected boolean result, but got %T", out.Value())
+			return denied(emptyResponseMetadata()), err
 		}
 
 		// If the result of the CEL evaluation is true, return an "allowed" response, otherwise return a "denied" response
 		if result {
 			return allowed(emptyResponseMetadata()), nil
 		}
-
-		return denied(emptyResponseMetadata()), err
+		return denied(emptyResponseMetadata()), nil
 	}
 }
 
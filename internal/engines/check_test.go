@@ -2069,4 +2069,335 @@ var _ = Describe("check-engine", func() {
 			}
 		})
 	})
+
+	// CLASSIFICATION SAMPLE
+	ClassificationSchema := `
+		entity user {}
+
+		entity folder {
+			attribute classification string = "internal"
+		}
+
+		entity document {
+			relation parent @folder
+			relation viewer @user
+
+			permission view = is_open(parent.classification) and viewer
+		}
+
+		rule is_public(classification string) {
+			classification != "secret"
+		}
+
+		rule is_open(classification string) {
+			is_public(classification) && classification != "confidential"
+		}
+		`
+
+	Context("Classification Sample: Check", func() {
+		It("Classification Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(ClassificationSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			type check struct {
+				entity     string
+				subject    string
+				assertions map[string]base.CheckResult
+			}
+
+			tests := struct {
+				relationships []string
+				attributes    []string
+				checks        []check
+			}{
+				relationships: []string{
+					"document:1#parent@folder:1",
+					"document:1#viewer@user:1",
+					"document:2#parent@folder:2",
+					"document:2#viewer@user:1",
+					"document:3#parent@folder:2",
+					"document:3#parent@folder:3",
+					"document:3#viewer@user:1",
+					"document:4#viewer@user:1",
+				},
+				attributes: []string{
+					"folder:2$classification|string:secret",
+					"folder:3$classification|string:public",
+				},
+				checks: []check{
+					{
+						entity:  "document:1",
+						subject: "user:1",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_ALLOWED,
+						},
+					},
+					{
+						entity:  "document:2",
+						subject: "user:1",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_DENIED,
+						},
+					},
+					{
+						entity:  "document:3",
+						subject: "user:1",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_ALLOWED,
+						},
+					},
+					{
+						entity:  "document:4",
+						subject: "user:1",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_DENIED,
+						},
+					},
+				},
+			}
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			var attributes []*base.Attribute
+
+			for _, relationship := range tests.relationships {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			for _, attr := range tests.attributes {
+				t, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, check := range tests.checks {
+				entity, err := tuple.E(check.entity)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ear, err := tuple.EAR(check.subject)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				subject := &base.Subject{
+					Type:     ear.GetEntity().GetType(),
+					Id:       ear.GetEntity().GetId(),
+					Relation: ear.GetRelation(),
+				}
+
+				for permission, res := range check.assertions {
+					response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+						TenantId:   "t1",
+						Entity:     entity,
+						Subject:    subject,
+						Permission: permission,
+						Metadata: &base.PermissionCheckRequestMetadata{
+							SnapToken:     token.NewNoopToken().Encode().String(),
+							SchemaVersion: "",
+							Depth:         20,
+						},
+					})
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(res).Should(Equal(response.GetCan()))
+				}
+			}
+		})
+	})
+
+	// DEFAULT VALUE SAMPLE
+
+	defaultValueSchema := `	entity user {}
+
+	entity organization {
+		relation member @user
+
+		attribute balance integer = 5000
+
+		permission view = check_balance(balance) and member
+	}
+
+	entity repository {
+		relation owner @user
+		relation organization @organization
+
+		attribute is_public boolean = true
+
+		permission view = is_public or owner
+		permission edit = organization.view
+	}
+
+	rule check_balance(balance integer) {
+		balance >= 5000
+	}
+	`
+
+	Context("Default Value Sample: Check", func() {
+		It("Default Value Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(defaultValueSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			type check struct {
+				entity     string
+				subject    string
+				assertions map[string]base.CheckResult
+			}
+
+			tests := struct {
+				relationships []string
+				attributes    []string
+				checks        []check
+			}{
+				relationships: []string{
+					"organization:1#member@user:1",
+					"organization:2#member@user:1",
+					"repository:3#organization@organization:1",
+					"repository:4#organization@organization:2",
+				},
+				attributes: []string{
+					"repository:1$is_public|boolean:false",
+					"organization:2$balance|integer:100",
+				},
+				checks: []check{
+					{
+						entity:  "repository:1",
+						subject: "user:1",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_DENIED,
+						},
+					},
+					{
+						entity:  "repository:2",
+						subject: "user:1",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_ALLOWED,
+						},
+					},
+					{
+						entity:  "repository:3",
+						subject: "user:1",
+						assertions: map[string]base.CheckResult{
+							"edit": base.CheckResult_CHECK_RESULT_ALLOWED,
+						},
+					},
+					{
+						entity:  "repository:4",
+						subject: "user:1",
+						assertions: map[string]base.CheckResult{
+							"edit": base.CheckResult_CHECK_RESULT_DENIED,
+						},
+					},
+				},
+			}
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			var attributes []*base.Attribute
+
+			for _, relationship := range tests.relationships {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			for _, attr := range tests.attributes {
+				t, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, check := range tests.checks {
+				entity, err := tuple.E(check.entity)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ear, err := tuple.EAR(check.subject)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				subject := &base.Subject{
+					Type:     ear.GetEntity().GetType(),
+					Id:       ear.GetEntity().GetId(),
+					Relation: ear.GetRelation(),
+				}
+
+				for permission, res := range check.assertions {
+					response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+						TenantId:   "t1",
+						Entity:     entity,
+						Subject:    subject,
+						Permission: permission,
+						Metadata: &base.PermissionCheckRequestMetadata{
+							SnapToken:     token.NewNoopToken().Encode().String(),
+							SchemaVersion: "",
+							Depth:         20,
+						},
+					})
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(res).Should(Equal(response.GetCan()))
+				}
+			}
+		})
+	})
 })
@@ -102,6 +102,11 @@ func (engine *EntityFilter) EntityFilter(
 			if err != nil {
 				return err
 			}
+		case schema.TupleToAttributeLinkedEntrance: // If the linked entrance is a tuple to attribute entrance.
+			err = engine.tupleToAttributeEntrance(cont, request, entrance, visits, publisher) // Call the tuple to attribute entrance method.
+			if err != nil {
+				return err
+			}
 		case schema.TupleToUserSetLinkedEntrance: // If the linked entrance is a tuple to user set entrance.
 			err = engine.tupleToUserSetEntrance(cont, request, entrance, visits, g, publisher) // Call the tuple to user set entrance method.
 			if err != nil {
@@ -128,6 +133,12 @@ func (engine *EntityFilter) attributeEntrance(
 		return nil
 	}
 
+	// The attributes of the other entity types are read through the relations that lead to them, which are not followed
+	// back from the attributes, so the candidate entities of the requested type are left to the checker.
+	if entrance.TargetEntrance.GetType() != request.GetEntrance().GetType() {
+		return engine.candidateEntities(ctx, request, visits, publisher)
+	}
+
 	// Retrieve the scope associated with the target entrance type.
 	// Check if it exists to avoid accessing a nil map entry.
 	scope, exists := request.GetScope()[entrance.TargetEntrance.GetType()]
@@ -210,9 +221,123 @@ func (engine *EntityFilter) attributeEntrance(
 		}, request.GetContext(), base.CheckResult_CHECK_RESULT_UNSPECIFIED)
 	}
 
+	// Entities without the attribute written take its default value, so when the schema declares one, the candidate
+	// entities of the type are published as well and left to the checker.
+	if engine.schema.GetEntityDefinitions()[entrance.TargetEntrance.GetType()].GetAttributes()[entrance.TargetEntrance.GetValue()].GetDefaultValue() != nil {
+		return engine.candidateEntities(ctx, request, visits, publisher)
+	}
+
 	return nil
 }
 
+// candidateEntities publishes the entities of the requested type of the scope or, without a scope, the ones that have
+// any relationship or attribute, written or contextual, so that the checker decides on them. It is used when the
+// entities can not be found by querying the attribute: entities without any data can not be found.
+func (engine *EntityFilter) candidateEntities(
+	ctx context.Context, // A context used for tracing and cancellation.
+	request *base.PermissionEntityFilterRequest, // A permission request for linked entities.
+	visits *VisitsMap, // A map that keeps track of visited entities to avoid infinite loops.
+	publisher *BulkEntityPublisher, // A custom publisher that publishes results in bulk.
+) error { // Returns an error if one occurs during execution.
+	entityType := request.GetEntrance().GetType()
+
+	// If the scope exists, its entities are the candidates.
+	scope, exists := request.GetScope()[entityType]
+	if exists {
+		engine.publishEntities(request, entityType, scope.GetData(), visits, publisher)
+		return nil
+	}
+
+	// Use cursor pagination with sorting by "entity_id", since the entities are of the requested type.
+	pagination := database.NewCursorPagination(database.Cursor(request.GetCursor()), database.Sort("entity_id"))
+
+	var ids []string
+
+	// Collect the entities of the relationships of the type, with any relation to any subject.
+	tupleFilter := &base.TupleFilter{
+		Entity: &base.EntityFilter{
+			Type: entityType,
+		},
+	}
+
+	cti, err := storageContext.NewContextualTuples(request.GetContext().GetTuples()...).QueryRelationships(tupleFilter, pagination)
+	if err != nil {
+		return err
+	}
+
+	rit, err := engine.dataReader.QueryRelationships(ctx, request.GetTenantId(), tupleFilter, request.GetMetadata().GetSnapToken(), pagination)
+	if err != nil {
+		return err
+	}
+
+	tuples := database.NewUniqueTupleIterator(rit, cti)
+	for tuples.HasNext() {
+		current, ok := tuples.GetNext()
+		if !ok {
+			break
+		}
+		ids = append(ids, current.GetEntity().GetId())
+	}
+
+	// Collect the entities of the attributes of the type, whatever the attribute.
+	attributeFilter := &base.AttributeFilter{
+		Entity: &base.EntityFilter{
+			Type: entityType,
+		},
+	}
+
+	cai, err := storageContext.NewContextualAttributes(request.GetContext().GetAttributes()...).QueryAttributes(attributeFilter, pagination)
+	if err != nil {
+		return err
+	}
+
+	rai, err := engine.dataReader.QueryAttributes(ctx, request.GetTenantId(), attributeFilter, request.GetMetadata().GetSnapToken(), pagination)
+	if err != nil {
+		return err
+	}
+
+	attributes := database.NewUniqueAttributeIterator(rai, cai)
+	for attributes.HasNext() {
+		current, ok := attributes.GetNext()
+		if !ok {
+			break
+		}
+		ids = append(ids, current.GetEntity().GetId())
+	}
+
+	engine.publishEntities(request, entityType, ids, visits, publisher)
+	return nil
+}
+
+// publishEntities publishes the entities of the given type and ids that have not been published yet.
+func (engine *EntityFilter) publishEntities(
+	request *base.PermissionEntityFilterRequest, // A permission request for linked entities.
+	entityType string, // The type of the entities to publish.
+	ids []string, // The ids of the entities to publish.
+	visits *VisitsMap, // A map that keeps track of visited entities to avoid infinite loops.
+	publisher *BulkEntityPublisher, // A custom publisher that publishes results in bulk.
+) {
+	for _, id := range ids {
+		entity := &base.Entity{
+			Type: entityType,
+			Id:   id,
+		}
+
+		// Check if the entity has already been visited to prevent processing it again.
+		if !visits.AddPublished(entity) {
+			continue
+		}
+
+		// Publish the entity with its metadata, the checker decides on it.
+		publisher.Publish(entity, &base.PermissionCheckRequestMetadata{
+			SnapToken:     request.GetMetadata().GetSnapToken(),
+			SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+			Depth:         request.GetMetadata().GetDepth(),
+		}, request.GetContext(), base.CheckResult_CHECK_RESULT_UNSPECIFIED)
+	}
+
+}
+
 // relationEntrance is a method of the EntityFilterEngine struct. It handles relation entrances.
 func (engine *EntityFilter) relationEntrance(
 	ctx context.Context, // A context used for tracing and cancellation.
@@ -395,6 +520,101 @@ func (engine *EntityFilter) tupleToUserSetEntrance(
 	return nil
 }
 
+// tupleToAttributeEntrance is a method of the EntityFilterEngine struct. It handles tuple to attribute entrances.
+// The entities related to any entity by the tuple set relation are published, the checker evaluates the attributes
+// of the related entities, since they may take their default values when they are not written.
+func (engine *EntityFilter) tupleToAttributeEntrance(
+	ctx context.Context, // A context used for tracing and cancellation.
+	request *base.PermissionEntityFilterRequest, // A permission request for linked entities.
+	entrance *schema.LinkedEntrance, // A linked entrance.
+	visits *VisitsMap, // A map that keeps track of visited entities to avoid infinite loops.
+	publisher *BulkEntityPublisher, // A custom publisher that publishes results in bulk.
+) error { // Returns an error if one occurs during execution.
+	if !visits.AddEA(entrance.TargetEntrance.GetType(), entrance.TupleSetRelation+"."+entrance.TargetEntrance.GetValue()) { // If the entity and attribute has already been visited.
+		return nil
+	}
+
+	// Retrieve the scope associated with the target entrance type.
+	// Check if it exists to avoid accessing a nil map entry.
+	scope, exists := request.GetScope()[entrance.TargetEntrance.GetType()]
+
+	// Initialize data as an empty slice of strings.
+	var data []string
+
+	// If the scope exists, assign its Data field to the data slice.
+	if exists {
+		data = scope.GetData()
+	}
+
+	// Define a TupleFilter. This specifies which tuples we're interested in.
+	// We want tuples of the entities of the target entrance type with the tuple set relation, to any subject.
+	filter := &base.TupleFilter{
+		Entity: &base.EntityFilter{
+			Type: entrance.TargetEntrance.GetType(),
+			Ids:  data,
+		},
+		Relation: entrance.TupleSetRelation,
+	}
+
+	var (
+		cti, rit   *database.TupleIterator
+		err        error
+		pagination database.CursorPagination
+	)
+
+	// Determine the pagination settings based on the entity type in the request.
+	// If the entity type matches the target entrance, use cursor pagination with sorting by "entity_id".
+	// Otherwise, use the default pagination settings.
+	if request.GetEntrance().GetType() == entrance.TargetEntrance.GetType() {
+		pagination = database.NewCursorPagination(database.Cursor(request.GetCursor()), database.Sort("entity_id"))
+	} else {
+		pagination = database.NewCursorPagination()
+	}
+
+	// Query the relationships using the specified pagination settings.
+	// The context tuples are filtered based on the provided filter.
+	cti, err = storageContext.NewContextualTuples(request.GetContext().GetTuples()...).QueryRelationships(filter, pagination)
+	if err != nil {
+		return err
+	}
+
+	// Query the relationships for the entity in the request.
+	// The results are filtered based on the provided filter and pagination settings.
+	rit, err = engine.dataReader.QueryRelationships(ctx, request.GetTenantId(), filter, request.GetMetadata().GetSnapToken(), pagination)
+	if err != nil {
+		return err
+	}
+
+	// Create a new UniqueTupleIterator from the two TupleIterators.
+	it := database.NewUniqueTupleIterator(rit, cti)
+
+	for it.HasNext() { // Loop over each relationship.
+		current, ok := it.GetNext()
+		if !ok {
+			break
+		}
+
+		entity := &base.Entity{
+			Type: entrance.TargetEntrance.GetType(),
+			Id:   current.GetEntity().GetId(),
+		}
+
+		// Check if the entity has already been visited to prevent processing it again.
+		if !visits.AddPublished(entity) {
+			continue
+		}
+
+		// Publish the entity with its metadata.
+		publisher.Publish(entity, &base.PermissionCheckRequestMetadata{
+			SnapToken:     request.GetMetadata().GetSnapToken(),
+			SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+			Depth:         request.GetMetadata().GetDepth(),
+		}, request.GetContext(), base.CheckResult_CHECK_RESULT_UNSPECIFIED)
+	}
+
+	return nil
+}
+
 // run is a method of the EntityFilterEngine struct. It executes the linked entity engine for a given request.
 func (engine *EntityFilter) lt(
 	ctx context.Context, // A context used for tracing and cancellation.
@@ -3,6 +3,7 @@ package engines
 import (
 	"context"
 	"errors"
+	"maps"
 
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -503,7 +504,7 @@ func (engine *ExpandEngine) expandDirectAttribute(
 			}
 		}
 
-		// If the attribute is still nil, create a new attribute with a false value.
+		// If the attribute is still nil, create a new attribute with its default value, or false if it has none.
 		if val == nil {
 			val = &base.Attribute{
 				Entity: &base.Entity{
@@ -512,11 +513,23 @@ func (engine *ExpandEngine) expandDirectAttribute(
 				},
 				Attribute: request.GetPermission(),
 			}
-			val.Value, err = anypb.New(&base.BooleanValue{Data: false})
+
+			var defaults map[string]*anypb.Any
+			defaults, err = getDefaultValues(ctx, engine.schemaReader, request.GetTenantId(), request.GetEntity().GetType(), request.GetMetadata().GetSchemaVersion(), request.GetPermission())
 			if err != nil {
 				expandChan <- expandFailResponse(err)
 				return
 			}
+
+			if value, ok := defaults[request.GetPermission()]; ok {
+				val.Value = value
+			} else {
+				val.Value, err = anypb.New(&base.BooleanValue{Data: false})
+				if err != nil {
+					expandChan <- expandFailResponse(err)
+					return
+				}
+			}
 		}
 
 		// Send an ExpandResponse containing the permission expansion response with the attribute value through the channel.
@@ -586,6 +599,9 @@ func (engine *ExpandEngine) expandDirectCall(
 		// Prepare a slice for attributes
 		attributes := make([]string, 0)
 
+		// Prepare a map for the values of the attributes of the related entities
+		related := make(map[string][]*anypb.Any)
+
 		// For each argument in the call...
 		for _, arg := range request.GetArguments() {
 			switch actualArg := arg.Type.(type) { // Switch on the type of the argument.
@@ -604,6 +620,16 @@ func (engine *ExpandEngine) expandDirectCall(
 
 				// Append the attribute name to the attributes slice.
 				attributes = append(attributes, attrName)
+			case *base.Argument_TupleToComputedAttribute: // If the argument is an attribute of the related entities...
+				attrName := actualArg.TupleToComputedAttribute.GetComputed().GetName() // get the name of the attribute.
+
+				// Get the value of the attribute for each related entity.
+				values, err := getRelatedAttributeValues(ctx, engine.schemaReader, engine.dataReader, request.GetTenantId(), request.GetEntity(), actualArg.TupleToComputedAttribute, ru.GetArguments()[attrName], request.GetMetadata().GetSnapToken(), request.GetMetadata().GetSchemaVersion(), request.GetContext())
+				if err != nil {
+					expandChan <- expandFailResponse(err)
+					return
+				}
+				related[attrName] = values
 			default:
 				// If the argument type is unknown, send a failure response and return from the function.
 				expandChan <- expandFailResponse(errors.New(base.ErrorCode_ERROR_CODE_INTERNAL.String()))
@@ -613,6 +639,16 @@ func (engine *ExpandEngine) expandDirectCall(
 
 		// If there are any attributes to query...
 		if len(attributes) > 0 {
+			// Attributes that are not written take the default values declared in the schema.
+			defaults, err := getDefaultValues(ctx, engine.schemaReader, request.GetTenantId(), request.GetEntity().GetType(), request.GetMetadata().GetSchemaVersion(), attributes...)
+			if err != nil {
+				expandChan <- expandFailResponse(err)
+				return
+			}
+			for attr, value := range defaults {
+				arguments[attr] = value
+			}
+
 			// Create an AttributeFilter for the attributes.
 			filter := &base.AttributeFilter{
 				Entity: &base.EntityFilter{
@@ -651,20 +687,55 @@ func (engine *ExpandEngine) expandDirectCall(
 			}
 		}
 
+		// leaf creates the tree of the call with the given argument values.
+		leaf := func(values map[string]*anypb.Any) *base.Expand {
+			return &base.Expand{
+				Entity:     request.GetEntity(),
+				Permission: request.GetPermission(),
+				Arguments:  request.GetArguments(),
+				Node: &base.Expand_Leaf{
+					Leaf: &base.ExpandLeaf{
+						Type: &base.ExpandLeaf_Values{
+							Values: &base.Values{
+								Values: values,
+							},
+						},
+					},
+				},
+			}
+		}
+
 		// Send an ExpandResponse containing the permission expansion response with the computed arguments through the channel.
+		if len(related) == 0 {
+			expandChan <- ExpandResponse{
+				Response: &base.PermissionExpandResponse{
+					Tree: leaf(arguments),
+				},
+			}
+			return
+		}
+
+		// The call is satisfied by any of the related entities, so their argument values are expanded as a union.
+		children := make([]*base.Expand, 0)
+		err = combineArguments(arguments, related, func(combination map[string]*anypb.Any) (bool, error) {
+			children = append(children, leaf(maps.Clone(combination)))
+			return false, nil
+		})
+		if err != nil {
+			expandChan <- expandFailResponse(err)
+			return
+		}
+
 		expandChan <- ExpandResponse{
 			Response: &base.PermissionExpandResponse{
 				Tree: &base.Expand{
 					Entity:     request.GetEntity(),
 					Permission: request.GetPermission(),
 					Arguments:  request.GetArguments(),
-					Node: &base.Expand_Leaf{
-						Leaf: &base.ExpandLeaf{
-							Type: &base.ExpandLeaf_Values{
-								Values: &base.Values{
-									Values: arguments,
-								},
-							},
+					Node: &base.Expand_Expand{
+						Expand: &base.ExpandTreeNode{
+							Operation: base.ExpandTreeNode_OPERATION_UNION,
+							Children:  children,
 						},
 					},
 				},
@@ -1503,4 +1503,256 @@ var _ = Describe("expand-engine", func() {
 			}
 		})
 	})
+
+	// DEFAULT VALUE SAMPLE
+
+	defaultValueSchema := `	entity user {}
+
+	entity organization {
+		relation member @user
+
+		attribute balance integer = 5000
+
+		permission view = check_balance(balance) and member
+	}
+
+	entity repository {
+		relation owner @user
+		relation organization @organization
+
+		attribute is_public boolean = true
+
+		permission view = is_public or owner
+		permission edit = organization.view
+	}
+
+	rule check_balance(balance integer) {
+		balance >= 5000
+	}
+	`
+
+	Context("Default Value Sample: Expand", func() {
+		It("Default Value Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// SCHEMA
+
+			conf, err := newSchema(defaultValueSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// RELATIONSHIPS
+
+			type expand struct {
+				entity     string
+				assertions map[string]*base.Expand
+			}
+
+			isPublic, _ := anypb.New(&base.BooleanValue{Data: true})
+			balance, _ := anypb.New(&base.IntegerValue{Data: 5000})
+
+			tests := struct {
+				relationships []string
+				attributes    []string
+				expands       []expand
+			}{
+				relationships: []string{
+					"organization:1#member@user:1",
+					"repository:1#owner@user:2",
+				},
+				attributes: []string{},
+				expands: []expand{
+					{
+						entity: "repository:1",
+						assertions: map[string]*base.Expand{
+							"view": {
+								Entity: &base.Entity{
+									Type: "repository",
+									Id:   "1",
+								},
+								Permission: "view",
+								Node: &base.Expand_Expand{
+									Expand: &base.ExpandTreeNode{
+										Operation: base.ExpandTreeNode_OPERATION_UNION,
+										Children: []*base.Expand{
+											{
+												Entity: &base.Entity{
+													Type: "repository",
+													Id:   "1",
+												},
+												Permission: "is_public",
+												Node: &base.Expand_Leaf{
+													Leaf: &base.ExpandLeaf{
+														Type: &base.ExpandLeaf_Value{
+															Value: isPublic,
+														},
+													},
+												},
+											},
+											{
+												Entity: &base.Entity{
+													Type: "repository",
+													Id:   "1",
+												},
+												Permission: "owner",
+												Node: &base.Expand_Leaf{
+													Leaf: &base.ExpandLeaf{
+														Type: &base.ExpandLeaf_Subjects{
+															Subjects: &base.Subjects{
+																Subjects: []*base.Subject{
+																	{
+																		Type: "user",
+																		Id:   "2",
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						entity: "organization:1",
+						assertions: map[string]*base.Expand{
+							"view": {
+								Entity: &base.Entity{
+									Type: "organization",
+									Id:   "1",
+								},
+								Permission: "view",
+								Node: &base.Expand_Expand{
+									Expand: &base.ExpandTreeNode{
+										Operation: base.ExpandTreeNode_OPERATION_INTERSECTION,
+										Children: []*base.Expand{
+											{
+												Entity: &base.Entity{
+													Type: "organization",
+													Id:   "1",
+												},
+												Permission: "check_balance",
+												Arguments: []*base.Argument{
+													{
+														Type: &base.Argument_ComputedAttribute{
+															ComputedAttribute: &base.ComputedAttribute{
+																Name: "balance",
+															},
+														},
+													},
+												},
+												Node: &base.Expand_Leaf{
+													Leaf: &base.ExpandLeaf{
+														Type: &base.ExpandLeaf_Values{
+															Values: &base.Values{
+																Values: map[string]*anypb.Any{
+																	"balance": balance,
+																},
+															},
+														},
+													},
+												},
+											},
+											{
+												Entity: &base.Entity{
+													Type: "organization",
+													Id:   "1",
+												},
+												Permission: "member",
+												Node: &base.Expand_Leaf{
+													Leaf: &base.ExpandLeaf{
+														Type: &base.ExpandLeaf_Subjects{
+															Subjects: &base.Subjects{
+																Subjects: []*base.Subject{
+																	{
+																		Type: "user",
+																		Id:   "1",
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			expandEngine := NewExpandEngine(schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				nil,
+				expandEngine,
+				nil,
+				nil,
+			)
+
+			var tuples []*base.Tuple
+			var attributes []*base.Attribute
+
+			for _, relationship := range tests.relationships {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			for _, attr := range tests.attributes {
+				a, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, a)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, expand := range tests.expands {
+				entity, err := tuple.E(expand.entity)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				for permission, res := range expand.assertions {
+					var response *base.PermissionExpandResponse
+					response, err = invoker.Expand(context.Background(), &base.PermissionExpandRequest{
+						TenantId:   "t1",
+						Entity:     entity,
+						Permission: permission,
+						Metadata: &base.PermissionExpandRequestMetadata{
+							SnapToken:     token.NewNoopToken().Encode().String(),
+							SchemaVersion: "",
+						},
+						Context: &base.Context{
+							Tuples:     []*base.Tuple{},
+							Attributes: []*base.Attribute{},
+							Data:       &structpb.Struct{},
+						},
+					})
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(response.Tree).Should(Equal(res))
+				}
+			}
+		})
+	})
 })
@@ -4753,4 +4753,294 @@ entity group_perms {
 			}
 		})
 	})
+
+	// DEFAULT VALUE SAMPLE
+
+	defaultValueSchema := `	entity user {}
+
+	entity organization {
+		relation member @user
+
+		attribute balance integer = 5000
+
+		permission view = check_balance(balance) and member
+	}
+
+	entity repository {
+		relation owner @user
+		relation organization @organization
+
+		attribute is_public boolean = true
+
+		permission view = is_public or owner
+		permission edit = organization.view
+	}
+
+	rule check_balance(balance integer) {
+		balance >= 5000
+	}
+	`
+
+	Context("Default Value Sample: Entity Filter", func() {
+		It("Default Value Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(defaultValueSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			type filter struct {
+				entityType string
+				subject    string
+				assertions map[string][]string
+			}
+
+			tests := struct {
+				relationships []string
+				attributes    []string
+				filters       []filter
+			}{
+				relationships: []string{
+					"organization:1#member@user:1",
+					"organization:2#member@user:1",
+					"organization:2#member@user:2",
+					"repository:2#owner@user:2",
+					"repository:3#organization@organization:1",
+					"repository:4#organization@organization:2",
+				},
+				attributes: []string{
+					"repository:1$is_public|boolean:false",
+					"organization:2$balance|integer:100",
+				},
+				filters: []filter{
+					{
+						entityType: "repository",
+						subject:    "user:1",
+						assertions: map[string][]string{
+							"view": {"2", "3", "4"},
+							"edit": {"3"},
+						},
+					},
+					{
+						entityType: "organization",
+						subject:    "user:1",
+						assertions: map[string][]string{
+							"view": {"1"},
+						},
+					},
+				},
+			}
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			lookupEngine := NewLookupEngine(
+				checkEngine,
+				schemaReader,
+				dataReader,
+			)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				lookupEngine,
+				nil,
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+
+			for _, relationship := range tests.relationships {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			var attributes []*base.Attribute
+
+			for _, attr := range tests.attributes {
+				a, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, a)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, filter := range tests.filters {
+				ear, err := tuple.EAR(filter.subject)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				subject := &base.Subject{
+					Type:     ear.GetEntity().GetType(),
+					Id:       ear.GetEntity().GetId(),
+					Relation: ear.GetRelation(),
+				}
+
+				for permission, res := range filter.assertions {
+					response, err := invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+						TenantId:   "t1",
+						EntityType: filter.entityType,
+						Subject:    subject,
+						Permission: permission,
+						Metadata: &base.PermissionLookupEntityRequestMetadata{
+							SnapToken:     token.NewNoopToken().Encode().String(),
+							SchemaVersion: "",
+							Depth:         100,
+						},
+					})
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(response.GetEntityIds()).Should(Equal(res))
+				}
+			}
+		})
+	})
+
+	Context("Default Value Sample: Subject Filter", func() {
+		It("Default Value Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(defaultValueSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			type filter struct {
+				subjectReference string
+				entity           string
+				assertions       map[string][]string
+			}
+
+			tests := struct {
+				relationships []string
+				attributes    []string
+				filters       []filter
+			}{
+				relationships: []string{
+					"organization:1#member@user:1",
+					"organization:2#member@user:1",
+					"organization:2#member@user:2",
+					"repository:2#owner@user:2",
+					"repository:3#organization@organization:1",
+					"repository:4#organization@organization:2",
+				},
+				attributes: []string{
+					"repository:1$is_public|boolean:false",
+					"organization:2$balance|integer:100",
+				},
+				filters: []filter{
+					{
+						subjectReference: "user",
+						entity:           "repository:2",
+						assertions: map[string][]string{
+							"view": {"1", "2"},
+						},
+					},
+					{
+						subjectReference: "user",
+						entity:           "repository:3",
+						assertions: map[string][]string{
+							"edit": {"1"},
+						},
+					},
+					{
+						subjectReference: "user",
+						entity:           "organization:1",
+						assertions: map[string][]string{
+							"view": {"1"},
+						},
+					},
+				},
+			}
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			lookupEngine := NewLookupEngine(
+				checkEngine,
+				schemaReader,
+				dataReader,
+			)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				lookupEngine,
+				nil,
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+
+			for _, relationship := range tests.relationships {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			var attributes []*base.Attribute
+
+			for _, attr := range tests.attributes {
+				a, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, a)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, filter := range tests.filters {
+				entity, err := tuple.E(filter.entity)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				for permission, res := range filter.assertions {
+					response, err := invoker.LookupSubject(context.Background(), &base.PermissionLookupSubjectRequest{
+						TenantId:         "t1",
+						SubjectReference: tuple.RelationReference(filter.subjectReference),
+						Entity:           entity,
+						Permission:       permission,
+						Metadata: &base.PermissionLookupSubjectRequestMetadata{
+							SnapToken:     token.NewNoopToken().Encode().String(),
+							SchemaVersion: "",
+						},
+					})
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(response.GetSubjectIds()).Should(Equal(res))
+				}
+			}
+		})
+	})
 })
@@ -264,6 +264,17 @@ func (engine *SubjectFilter) subjectFilterDirectAttribute(
 			}
 		}
 
+		if val == nil {
+			// The attribute is not written, so fall back to its default value declared in the schema, if any.
+			defaults, err := getDefaultValues(ctx, engine.schemaReader, request.GetTenantId(), request.GetEntity().GetType(), request.GetMetadata().GetSchemaVersion(), request.GetPermission())
+			if err != nil {
+				return subjectFilterEmpty(), err
+			}
+			if value, ok := defaults[request.GetPermission()]; ok {
+				val = &base.Attribute{Entity: request.GetEntity(), Attribute: request.GetPermission(), Value: value}
+			}
+		}
+
 		// No attribute was found matching the provided filter. In this case, we return a denied response with empty metadata
 		// and no error.
 		if val == nil {
@@ -328,6 +339,9 @@ func (engine *SubjectFilter) subjectFilterDirectCall(
 		// List to store computed attributes.
 		attributes := make([]string, 0)
 
+		// Values of the attributes of the related entities, by the names of the arguments.
+		related := make(map[string][]interface{})
+
 		// Iterate over request arguments to classify and process them.
 		for _, arg := range request.GetArguments() {
 			switch actualArg := arg.Type.(type) {
@@ -337,6 +351,17 @@ func (engine *SubjectFilter) subjectFilterDirectCall(
 				emptyValue := getEmptyValueForType(ru.GetArguments()[attrName])
 				arguments[attrName] = emptyValue
 				attributes = append(attributes, attrName)
+			case *base.Argument_TupleToComputedAttribute:
+				// Handle attributes of the related entities: Take the value of each related entity.
+				attrName := actualArg.TupleToComputedAttribute.GetComputed().GetName()
+				values, err := getRelatedAttributeValues(ctx, engine.schemaReader, engine.dataReader, request.GetTenantId(), request.GetEntity(), actualArg.TupleToComputedAttribute, ru.GetArguments()[attrName], request.GetMetadata().GetSnapToken(), request.GetMetadata().GetSchemaVersion(), request.GetContext())
+				if err != nil {
+					return subjectFilterEmpty(), err
+				}
+				related[attrName] = make([]interface{}, 0, len(values))
+				for _, value := range values {
+					related[attrName] = append(related[attrName], utils.ConvertProtoAnyToInterface(value))
+				}
 			default:
 				// Return an error for any unsupported argument types.
 				return subjectFilterEmpty(), fmt.Errorf(base.ErrorCode_ERROR_CODE_INTERNAL.String())
@@ -345,6 +370,15 @@ func (engine *SubjectFilter) subjectFilterDirectCall(
 
 		// If there are computed attributes, fetch them from the data source.
 		if len(attributes) > 0 {
+			// Attributes that are not written take the default values declared in the schema.
+			defaults, err := getDefaultValues(ctx, engine.schemaReader, request.GetTenantId(), request.GetEntity().GetType(), request.GetMetadata().GetSchemaVersion(), attributes...)
+			if err != nil {
+				return subjectFilterEmpty(), err
+			}
+			for attr, value := range defaults {
+				arguments[attr] = utils.ConvertProtoAnyToInterface(value)
+			}
+
 			filter := &base.AttributeFilter{
 				Entity: &base.EntityFilter{
 					Type: request.GetEntity().GetType(),
@@ -387,24 +421,18 @@ func (engine *SubjectFilter) subjectFilterDirectCall(
 			return subjectFilterEmpty(), err
 		}
 
-		// Evaluate the rule expression with the provided arguments.
-		out, _, err := prg.Eval(arguments)
+		// Evaluate the rule expression with the provided arguments, for the combinations of the related entities until
+		// one of them is true.
+		result, err := evaluateCombinations(prg, arguments, related)
 		if err != nil {
-			return subjectFilterEmpty(), fmt.Errorf("failed to evaluate expression: %w", err)
-		}
-
-		// Ensure the result of evaluation is boolean and decide on permission.
-		result, ok := out.Value().(bool)
-		if !ok {
-			return subjectFilterEmpty(), fmt.Errorf("expected boolean result, but got %T", out.Value())
+			return subjectFilterEmpty(), err
 		}
 
 		// If the result of the CEL evaluation is true, return an "allowed" response, otherwise return a "denied" response
 		if result {
 			return []string{ALL}, nil
 		}
-
-		return subjectFilterEmpty(), err
+		return subjectFilterEmpty(), nil
 	}
 }
 
@@ -1,22 +1,31 @@
 package engines
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"sort"
 	"strings"
 	"sync"
 
+	"github.com/google/cel-go/cel"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	storageContext "github.com/Permify/permify/internal/storage/context"
 	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/tuple"
 )
 
 const (
 	_defaultConcurrencyLimit = 100
+	// _maxArgumentCombinations - the maximum number of combinations of the attributes of the related entities a call
+	// to a rule is evaluated with
+	_maxArgumentCombinations = 10000
 )
 
 // CheckOption - a functional option type for configuring the CheckEngine.
@@ -29,6 +38,20 @@ func CheckConcurrencyLimit(limit int) CheckOption {
 	}
 }
 
+// BranchRecorder - receives the results of the children of the permissions evaluated by the CheckEngine.
+type BranchRecorder interface {
+	// RecordBranch records the result of the child at the given path of the permission of the entity type. The path
+	// holds the indexes of the children leading to the child from the child of the permission, which has an empty path.
+	RecordBranch(entityType, permission string, path []int, allowed bool)
+}
+
+// CheckBranchRecorder - a functional option that sets the recorder of the results of the children of the permissions.
+func CheckBranchRecorder(recorder BranchRecorder) CheckOption {
+	return func(c *CheckEngine) {
+		c.recorder = recorder
+	}
+}
+
 type LookupOption func(engine *LookupEngine)
 
 func LookupConcurrencyLimit(limit int) LookupOption {
@@ -256,6 +279,191 @@ func getEmptyProtoValueForType(typ base.AttributeType) (*anypb.Any, error) {
 	}
 }
 
+// getDefaultValues returns the default values of the given attributes of the entity type, as declared in the schema.
+// Attributes without a default value are not included in the returned map; their values fall back to the
+// empty value of their type.
+func getDefaultValues(ctx context.Context, schemaReader storage.SchemaReader, tenantID, entityType, version string, attributes ...string) (map[string]*anypb.Any, error) {
+	en, _, err := schemaReader.ReadEntityDefinition(ctx, tenantID, entityType, version)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]*anypb.Any, len(attributes))
+	for _, attr := range attributes {
+		if value := en.GetAttributes()[attr].GetDefaultValue(); value != nil {
+			values[attr] = value
+		}
+	}
+
+	return values, nil
+}
+
+// getRelatedAttributeValues returns the values of the attribute of the entities related to the entity by the tuple set
+// relation of the argument, one for each related entity. Related entities without the attribute written take its
+// default value, or the empty value of the given type if the schema declares no default value.
+func getRelatedAttributeValues(
+	ctx context.Context,
+	schemaReader storage.SchemaReader,
+	dataReader storage.DataReader,
+	tenantID string,
+	entity *base.Entity,
+	argument *base.TupleToComputedAttribute,
+	typ base.AttributeType,
+	snapToken, schemaVersion string,
+	contextual *base.Context,
+) ([]*anypb.Any, error) {
+	filter := &base.TupleFilter{
+		Entity: &base.EntityFilter{
+			Type: entity.GetType(),
+			Ids:  []string{entity.GetId()},
+		},
+		Relation: argument.GetTupleSet().GetRelation(),
+	}
+
+	rit, err := dataReader.QueryRelationships(ctx, tenantID, filter, snapToken, database.NewCursorPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	cti, err := storageContext.NewContextualTuples(contextual.GetTuples()...).QueryRelationships(filter, database.NewCursorPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	name := argument.GetComputed().GetName()
+
+	var values []*anypb.Any
+	seen := map[string]struct{}{}
+	defaults := map[string]*anypb.Any{}
+
+	it := database.NewUniqueTupleIterator(rit, cti)
+	for it.HasNext() {
+		t, ok := it.GetNext()
+		if !ok {
+			break
+		}
+
+		// The subjects with relations, such as organization#member, are related through their entities.
+		related := &base.Entity{Type: t.GetSubject().GetType(), Id: t.GetSubject().GetId()}
+		if _, ok := seen[tuple.EntityToString(related)]; ok {
+			continue
+		}
+		seen[tuple.EntityToString(related)] = struct{}{}
+
+		attributeFilter := &base.AttributeFilter{
+			Entity: &base.EntityFilter{
+				Type: related.GetType(),
+				Ids:  []string{related.GetId()},
+			},
+			Attributes: []string{name},
+		}
+
+		val, err := storageContext.NewContextualAttributes(contextual.GetAttributes()...).QuerySingleAttribute(attributeFilter)
+		if err != nil {
+			return nil, err
+		}
+
+		if val == nil {
+			val, err = dataReader.QuerySingleAttribute(ctx, tenantID, attributeFilter, snapToken)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if val != nil {
+			values = append(values, val.GetValue())
+			continue
+		}
+
+		// The default value is the same for every related entity of the same type.
+		value, ok := defaults[related.GetType()]
+		if !ok {
+			d, err := getDefaultValues(ctx, schemaReader, tenantID, related.GetType(), schemaVersion, name)
+			if err != nil {
+				return nil, err
+			}
+
+			value, ok = d[name]
+			if !ok {
+				value, err = getEmptyProtoValueForType(typ)
+				if err != nil {
+					return nil, err
+				}
+			}
+			defaults[related.GetType()] = value
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// combineArguments calls visit with the arguments of a call to a rule for each combination of the values of the
+// arguments that take the attribute of the related entities, until visit returns true or an error. The combinations are
+// made one at a time in the same map, the call has no combinations if an argument has no related entities, and an error
+// is returned once the call has more than _maxArgumentCombinations combinations.
+func combineArguments[T any](arguments map[string]T, related map[string][]T, visit func(combination map[string]T) (bool, error)) error {
+	names := make([]string, 0, len(related))
+	for name := range related {
+		if len(related[name]) == 0 {
+			return nil
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// indexes holds the index of the value of each name in the current combination, the last name changes first.
+	indexes := make([]int, len(names))
+	combination := maps.Clone(arguments)
+	for count := 1; ; count++ {
+		if count > _maxArgumentCombinations {
+			return fmt.Errorf("the call to the rule has more than %d combinations of the attributes of the related entities", _maxArgumentCombinations)
+		}
+
+		for i, name := range names {
+			combination[name] = related[name][indexes[i]]
+		}
+		done, err := visit(combination)
+		if err != nil || done {
+			return err
+		}
+
+		i := len(names) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(related[names[i]]) {
+				break
+			}
+			indexes[i] = 0
+		}
+		if i < 0 {
+			return nil
+		}
+	}
+}
+
+// evaluateCombinations evaluates the program of a rule with the combinations of the arguments of the call, and returns
+// whether one of them is true.
+func evaluateCombinations(prg cel.Program, arguments map[string]interface{}, related map[string][]interface{}) (bool, error) {
+	var result bool
+	err := combineArguments(arguments, related, func(combination map[string]interface{}) (bool, error) {
+		out, _, err := prg.Eval(combination)
+		if err != nil {
+			return false, fmt.Errorf("failed to evaluate expression: %w", err)
+		}
+
+		// Ensure the result of evaluation is boolean and decide on permission.
+		var ok bool
+		result, ok = out.Value().(bool)
+		if !ok {
+			return false, fmt.Errorf("expected boolean result, but got %T", out.Value())
+		}
+		return result, nil
+	})
+	return result, err
+}
+
 // ConvertToAnyPB is a function to convert various basic Go types into *anypb.Any.
 // It supports conversion from bool, int, float64, and string.
 // It uses a type switch to detect the type of the input value.
@@ -2,6 +2,7 @@ package engines
 
 import (
 	"errors"
+	"maps"
 	"reflect"
 
 	"google.golang.org/protobuf/types/known/structpb"
@@ -233,6 +234,66 @@ var _ = Describe("utils", func() {
 		})
 	})
 
+	Context("combineArguments", func() {
+		It("combineArguments: Case 1", func() {
+			// Every combination is visited in order, the last argument changes first
+			var visited []map[string]int
+			err := combineArguments(map[string]int{"a": 0}, map[string][]int{"b": {1, 2}, "c": {3, 4}}, func(combination map[string]int) (bool, error) {
+				visited = append(visited, maps.Clone(combination))
+				return false, nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(visited).Should(Equal([]map[string]int{
+				{"a": 0, "b": 1, "c": 3},
+				{"a": 0, "b": 1, "c": 4},
+				{"a": 0, "b": 2, "c": 3},
+				{"a": 0, "b": 2, "c": 4},
+			}))
+
+			// The iteration stops at the first combination visit returns true for
+			count := 0
+			err = combineArguments(map[string]int{}, map[string][]int{"b": {1, 2}, "c": {3, 4}}, func(combination map[string]int) (bool, error) {
+				count++
+				return combination["c"] == 4, nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(2))
+
+			// A call without related arguments has a single combination, and none if an argument has no related entities
+			count = 0
+			visit := func(map[string]int) (bool, error) {
+				count++
+				return false, nil
+			}
+			Expect(combineArguments(map[string]int{"a": 0}, map[string][]int{}, visit)).Should(Succeed())
+			Expect(count).Should(Equal(1))
+			Expect(combineArguments(map[string]int{"a": 0}, map[string][]int{"b": {1}, "c": {}}, visit)).Should(Succeed())
+			Expect(count).Should(Equal(1))
+		})
+
+		It("combineArguments: Case 2", func() {
+			// The combinations over the limit return an error unless a combination before the limit is true
+			values := make([]int, 101)
+			for i := range values {
+				values[i] = i
+			}
+			related := map[string][]int{"a": values, "b": values}
+
+			count := 0
+			err := combineArguments(map[string]int{}, related, func(map[string]int) (bool, error) {
+				count++
+				return false, nil
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(count).Should(Equal(_maxArgumentCombinations))
+
+			err = combineArguments(map[string]int{}, related, func(combination map[string]int) (bool, error) {
+				return combination["a"] == 1, nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
 	Context("IsRelational", func() {
 		It("IsRelational: Case 1", func() {
 			sch, err := schema.NewSchemaFromStringDefinitions(false, `
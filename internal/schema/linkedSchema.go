@@ -40,13 +40,16 @@ func NewLinkedGraph(schema *base.SchemaDefinition) *LinkedSchemaGraph {
 //   - RelationLinkedEntrance: represents an entry point into a relationship object in the schema graph
 //   - TupleToUserSetLinkedEntrance: represents an entry point into a tuple-to-user-set object in the schema graph
 //   - ComputedUserSetLinkedEntrance: represents an entry point into a computed user set object in the schema graph
+//   - AttributeLinkedEntrance: represents an entry point into an attribute of the entities in the schema graph
+//   - TupleToAttributeLinkedEntrance: represents an entry point into an attribute of the entities related by a tuple set
 type LinkedEntranceKind string
 
 const (
-	RelationLinkedEntrance        LinkedEntranceKind = "relation"
-	TupleToUserSetLinkedEntrance  LinkedEntranceKind = "tuple_to_user_set"
-	ComputedUserSetLinkedEntrance LinkedEntranceKind = "computed_user_set"
-	AttributeLinkedEntrance       LinkedEntranceKind = "attribute"
+	RelationLinkedEntrance         LinkedEntranceKind = "relation"
+	TupleToUserSetLinkedEntrance   LinkedEntranceKind = "tuple_to_user_set"
+	ComputedUserSetLinkedEntrance  LinkedEntranceKind = "computed_user_set"
+	AttributeLinkedEntrance        LinkedEntranceKind = "attribute"
+	TupleToAttributeLinkedEntrance LinkedEntranceKind = "tuple_to_attribute"
 )
 
 // LinkedEntrance represents an entry point into the LinkedSchemaGraph, which is used to resolve permissions and expand user
@@ -316,6 +319,17 @@ func (g *LinkedSchemaGraph) findEntranceLeaf(target, source *base.Entrance, leaf
 					},
 				})
 			}
+			tupleToAttr := arg.GetTupleToComputedAttribute()
+			if tupleToAttr != nil {
+				entrances = append(entrances, &LinkedEntrance{
+					Kind: TupleToAttributeLinkedEntrance,
+					TargetEntrance: &base.Entrance{
+						Type:  target.GetType(),
+						Value: tupleToAttr.GetComputed().GetName(),
+					},
+					TupleSetRelation: tupleToAttr.GetTupleSet().GetRelation(),
+				})
+			}
 		}
 		return entrances, nil
 	default:
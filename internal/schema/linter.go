@@ -0,0 +1,522 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/pkg/dsl/utils"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// LintRule is the name of a check of the schema linter.
+type LintRule string
+
+const (
+	// LintUnusedRelation reports the relations that are not used by any permission or relation.
+	LintUnusedRelation LintRule = "unused-relation"
+	// LintUnusedAttribute reports the attributes that are not used by any permission.
+	LintUnusedAttribute LintRule = "unused-attribute"
+	// LintUnusedPermission reports the permissions that are neither used by another permission nor asserted.
+	// It is only run if the asserted permissions are known.
+	LintUnusedPermission LintRule = "unused-permission"
+	// LintUnusedRule reports the rules that are not called by any permission, directly or through other rules.
+	LintUnusedRule LintRule = "unused-rule"
+	// LintTopLevelExclusion reports the permissions with an exclusion (not) at the top of their expression that are
+	// denied to everyone, as the left side of the exclusion grants nothing or is excluded by its right side.
+	LintTopLevelExclusion LintRule = "top-level-exclusion"
+	// LintTupleToUserSetDepth reports the permissions that follow more tuple to user set relations than allowed.
+	LintTupleToUserSetDepth LintRule = "tuple-to-user-set-depth"
+	// LintPermissionCycle reports the cycles between the permissions of different entities, and the cycles
+	// that do not follow any relation, which can never be resolved.
+	LintPermissionCycle LintRule = "permission-cycle"
+	// LintNaming reports the names that do not match the naming convention.
+	LintNaming LintRule = "naming"
+)
+
+// LintRules are all rules of the schema linter.
+var LintRules = []LintRule{
+	LintUnusedRelation,
+	LintUnusedAttribute,
+	LintUnusedPermission,
+	LintUnusedRule,
+	LintTopLevelExclusion,
+	LintTupleToUserSetDepth,
+	LintPermissionCycle,
+	LintNaming,
+}
+
+const (
+	// DefaultMaxTupleToUserSetDepth is the default number of tuple to user set relations a permission may follow.
+	DefaultMaxTupleToUserSetDepth = 3
+)
+
+// DefaultNamingConvention is the default pattern of the names of entities, relations, attributes, permissions and rules.
+var DefaultNamingConvention = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Linter reports the problems of a schema that do not prevent it from being compiled.
+type Linter struct {
+	schema *base.SchemaDefinition
+
+	disabled         map[LintRule]struct{}
+	maxDepth         int
+	namingConvention *regexp.Regexp
+	// asserted is the set of the asserted permissions in the form of entity#permission, nil if unknown.
+	asserted map[string]struct{}
+	// ruleCalls are the rules called by the expression of each rule.
+	ruleCalls map[string][]string
+
+	// used is the set of the relations, attributes and permissions that are used by the others.
+	used map[string]struct{}
+	// called is the set of the rules that are called by permissions, directly or through other rules.
+	called map[string]struct{}
+	// graph is the linked schema graph the engines resolve the permissions with.
+	graph *LinkedSchemaGraph
+	// reaches are the permissions and relations each permission or relation depends on, directly or not.
+	reaches map[string]map[string]struct{}
+	// edges are the permissions and relations read by each permission or relation, true if the read follows a relation.
+	edges map[string]map[string]bool
+
+	warnings []*base.SchemaWarning
+}
+
+// LinterOption is a function that configures the linter.
+type LinterOption func(*Linter)
+
+// DisableLintRules disables the given rules.
+func DisableLintRules(rules ...LintRule) LinterOption {
+	return func(l *Linter) {
+		for _, rule := range rules {
+			l.disabled[rule] = struct{}{}
+		}
+	}
+}
+
+// MaxTupleToUserSetDepth sets the number of tuple to user set relations a permission may follow.
+func MaxTupleToUserSetDepth(depth int) LinterOption {
+	return func(l *Linter) {
+		l.maxDepth = depth
+	}
+}
+
+// NamingConvention sets the pattern of the names.
+func NamingConvention(pattern *regexp.Regexp) LinterOption {
+	return func(l *Linter) {
+		l.namingConvention = pattern
+	}
+}
+
+// AssertedPermissions sets the permissions asserted by the tests, in the form of entity#permission,
+// which enables the unused permission rule.
+func AssertedPermissions(keys ...string) LinterOption {
+	return func(l *Linter) {
+		if l.asserted == nil {
+			l.asserted = map[string]struct{}{}
+		}
+		for _, key := range keys {
+			l.asserted[key] = struct{}{}
+		}
+	}
+}
+
+// RuleCalls sets the rules called by the expression of each rule, as returned by the compiler, so that the rules
+// called by other rules are not reported as unused.
+func RuleCalls(calls map[string][]string) LinterOption {
+	return func(l *Linter) {
+		l.ruleCalls = calls
+	}
+}
+
+// NewLinter creates a new linter for the given schema.
+func NewLinter(schema *base.SchemaDefinition, opts ...LinterOption) *Linter {
+	l := &Linter{
+		schema:           schema,
+		disabled:         map[LintRule]struct{}{},
+		maxDepth:         DefaultMaxTupleToUserSetDepth,
+		namingConvention: DefaultNamingConvention,
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// Lint returns the warnings of the schema, sorted by the definitions and the names they are about.
+func (l *Linter) Lint() []*base.SchemaWarning {
+	l.warnings = []*base.SchemaWarning{}
+	l.used = map[string]struct{}{}
+	l.called = map[string]struct{}{}
+	l.graph = NewLinkedGraph(l.schema)
+	l.reaches = map[string]map[string]struct{}{}
+	l.edges = map[string]map[string]bool{}
+
+	for _, entity := range l.entities() {
+		l.collect(entity)
+	}
+
+	// The rules called by the called rules are called too.
+	for queue := sortedKeys(l.called); len(queue) > 0; queue = queue[1:] {
+		for _, callee := range l.ruleCalls[queue[0]] {
+			if _, ok := l.called[callee]; !ok {
+				l.called[callee] = struct{}{}
+				queue = append(queue, callee)
+			}
+		}
+	}
+
+	for _, entity := range l.entities() {
+		l.lintEntity(entity)
+	}
+
+	for _, name := range sortedKeys(l.schema.GetRuleDefinitions()) {
+		if _, ok := l.called[name]; !ok {
+			l.warn(LintUnusedRule, name, "", "rule %s is not called by any permission", name)
+		}
+		l.lintName(name, "", "rule", name)
+	}
+
+	l.lintCycles()
+
+	sort.SliceStable(l.warnings, func(i, j int) bool {
+		if l.warnings[i].GetDefinition() != l.warnings[j].GetDefinition() {
+			return l.warnings[i].GetDefinition() < l.warnings[j].GetDefinition()
+		}
+		return l.warnings[i].GetName() < l.warnings[j].GetName()
+	})
+
+	return l.warnings
+}
+
+// collect collects the references of the permissions and relations of the entity, as found by the linked schema
+// graph the engines resolve the permissions with.
+func (l *Linter) collect(entity *base.EntityDefinition) {
+	for _, name := range sortedKeys(entity.GetReferences()) {
+		if entity.GetReferences()[name] == base.EntityDefinition_REFERENCE_ATTRIBUTE {
+			continue
+		}
+		from := utils.Key(entity.GetName(), name)
+		l.reaches[from] = map[string]struct{}{}
+		for _, source := range l.nodes() {
+			entrances, err := l.graph.LinkedEntrances(&base.Entrance{Type: entity.GetName(), Value: name}, source)
+			if err != nil {
+				continue
+			}
+			for _, entrance := range entrances {
+				l.use(from, source, entrance)
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(entity.GetPermissions()) {
+		l.collectCalls(entity.GetPermissions()[name].GetChild())
+	}
+}
+
+// use marks what the entrance reads as used. If the entrance reads the source, the source is reachable from the
+// permission or relation the entrances were searched from.
+func (l *Linter) use(from string, source *base.Entrance, entrance *LinkedEntrance) {
+	target := entrance.TargetEntrance
+	switch entrance.LinkedEntranceKind() {
+	case AttributeLinkedEntrance:
+		l.used[utils.Key(target.GetType(), target.GetValue())] = struct{}{}
+		return
+	case TupleToAttributeLinkedEntrance:
+		l.used[utils.Key(target.GetType(), entrance.TupleSetRelation)] = struct{}{}
+		entity := l.schema.GetEntityDefinitions()[target.GetType()]
+		for _, ref := range entity.GetRelations()[entrance.TupleSetRelation].GetRelationReferences() {
+			l.used[utils.Key(ref.GetType(), target.GetValue())] = struct{}{}
+		}
+		return
+	case TupleToUserSetLinkedEntrance:
+		l.used[utils.Key(target.GetType(), entrance.TupleSetRelation)] = struct{}{}
+	}
+
+	to := utils.Key(source.GetType(), source.GetValue())
+	l.used[to] = struct{}{}
+	l.reaches[from][to] = struct{}{}
+
+	// The entrances are the permissions and relations that read the source, record whether they follow a relation.
+	key := utils.Key(target.GetType(), target.GetValue())
+	if l.edges[key] == nil {
+		l.edges[key] = map[string]bool{}
+	}
+	l.edges[key][to] = l.edges[key][to] || entrance.LinkedEntranceKind() != ComputedUserSetLinkedEntrance
+}
+
+// collectCalls collects the rules called by the permission expression.
+func (l *Linter) collectCalls(child *base.Child) {
+	if child.GetRewrite() != nil {
+		for _, c := range child.GetRewrite().GetChildren() {
+			l.collectCalls(c)
+		}
+		return
+	}
+	if call := child.GetLeaf().GetCall(); call != nil {
+		l.called[call.GetRuleName()] = struct{}{}
+	}
+}
+
+// nodes returns the permissions and relations of all entities, sorted by the entities and their names.
+func (l *Linter) nodes() []*base.Entrance {
+	var nodes []*base.Entrance
+	for _, entity := range l.entities() {
+		for _, name := range sortedKeys(entity.GetReferences()) {
+			if entity.GetReferences()[name] != base.EntityDefinition_REFERENCE_ATTRIBUTE {
+				nodes = append(nodes, &base.Entrance{Type: entity.GetName(), Value: name})
+			}
+		}
+	}
+	return nodes
+}
+
+// lintEntity reports the problems of the entity and its relations, attributes and permissions.
+func (l *Linter) lintEntity(entity *base.EntityDefinition) {
+	name := entity.GetName()
+	l.lintName(name, "", "entity", name)
+
+	for _, relation := range sortedKeys(entity.GetRelations()) {
+		if _, ok := l.used[utils.Key(name, relation)]; !ok {
+			l.warn(LintUnusedRelation, name, relation, "relation %s is not used by any permission or relation", utils.Key(name, relation))
+		}
+		l.lintName(name, relation, "relation", relation)
+	}
+
+	for _, attribute := range sortedKeys(entity.GetAttributes()) {
+		if _, ok := l.used[utils.Key(name, attribute)]; !ok {
+			l.warn(LintUnusedAttribute, name, attribute, "attribute %s is not used by any permission", utils.Key(name, attribute))
+		}
+		l.lintName(name, attribute, "attribute", attribute)
+	}
+
+	for _, permission := range sortedKeys(entity.GetPermissions()) {
+		key := utils.Key(name, permission)
+
+		if l.asserted != nil {
+			_, used := l.used[key]
+			_, asserted := l.asserted[key]
+			if !used && !asserted {
+				l.warn(LintUnusedPermission, name, permission, "permission %s is neither used by any permission nor asserted in any scenario", key)
+			}
+		}
+
+		if rewrite := entity.GetPermissions()[permission].GetChild().GetRewrite(); rewrite.GetRewriteOperation() == base.Rewrite_OPERATION_EXCLUSION {
+			left := rewrite.GetChildren()[0]
+			switch {
+			case l.childGrantsNothing(entity, left, map[string]struct{}{key: {}}):
+				l.warn(LintTopLevelExclusion, name, permission, "permission %s has an exclusion at the top whose left side grants nothing, it is denied to everyone", key)
+			case excludes(rewrite.GetChildren()[1:], left):
+				l.warn(LintTopLevelExclusion, name, permission, "permission %s has an exclusion at the top whose left side is excluded by its right side, it is denied to everyone", key)
+			}
+		}
+
+		if depth := l.depth(name, permission, map[string]struct{}{}); depth > l.maxDepth {
+			l.warn(LintTupleToUserSetDepth, name, permission, "permission %s follows %d tuple to user set relations, more than the maximum of %d", key, depth, l.maxDepth)
+		}
+
+		l.lintName(name, permission, "permission", permission)
+	}
+}
+
+// lintName reports the name if it does not match the naming convention.
+func (l *Linter) lintName(definition, name, kind, value string) {
+	if l.namingConvention != nil && !l.namingConvention.MatchString(value) {
+		l.warn(LintNaming, definition, name, "%s name %s does not match the naming convention %s", kind, value, l.namingConvention.String())
+	}
+}
+
+// depth returns the maximum number of tuple to user set relations followed by the permission.
+func (l *Linter) depth(entityType, name string, visiting map[string]struct{}) int {
+	key := utils.Key(entityType, name)
+	if _, ok := visiting[key]; ok {
+		return 0
+	}
+	visiting[key] = struct{}{}
+	defer delete(visiting, key)
+
+	entity, ok := l.schema.GetEntityDefinitions()[entityType]
+	if !ok {
+		return 0
+	}
+	permission, ok := entity.GetPermissions()[name]
+	if !ok {
+		return 0
+	}
+	return l.childDepth(entity, permission.GetChild(), visiting)
+}
+
+// childDepth returns the maximum number of tuple to user set relations followed by the permission expression.
+func (l *Linter) childDepth(entity *base.EntityDefinition, child *base.Child, visiting map[string]struct{}) int {
+	deepest := 0
+	if child.GetRewrite() != nil {
+		for _, c := range child.GetRewrite().GetChildren() {
+			if d := l.childDepth(entity, c, visiting); d > deepest {
+				deepest = d
+			}
+		}
+		return deepest
+	}
+
+	switch t := child.GetLeaf().GetType().(type) {
+	case *base.Leaf_ComputedUserSet:
+		return l.depth(entity.GetName(), t.ComputedUserSet.GetRelation(), visiting)
+	case *base.Leaf_TupleToUserSet:
+		for _, ref := range entity.GetRelations()[t.TupleToUserSet.GetTupleSet().GetRelation()].GetRelationReferences() {
+			if d := 1 + l.depth(ref.GetType(), t.TupleToUserSet.GetComputed().GetRelation(), visiting); d > deepest {
+				deepest = d
+			}
+		}
+	}
+	return deepest
+}
+
+// grantsNothing returns whether the permission or relation cannot be granted to anyone, as a permission that only
+// refers to itself.
+func (l *Linter) grantsNothing(entityType, name string, visiting map[string]struct{}) bool {
+	key := utils.Key(entityType, name)
+	if _, ok := visiting[key]; ok {
+		return true
+	}
+	visiting[key] = struct{}{}
+	defer delete(visiting, key)
+
+	entity, ok := l.schema.GetEntityDefinitions()[entityType]
+	if !ok {
+		return true
+	}
+	permission, ok := entity.GetPermissions()[name]
+	if !ok {
+		_, ok = entity.GetRelations()[name]
+		return !ok
+	}
+	return l.childGrantsNothing(entity, permission.GetChild(), visiting)
+}
+
+// childGrantsNothing returns whether the permission expression cannot be granted to anyone.
+func (l *Linter) childGrantsNothing(entity *base.EntityDefinition, child *base.Child, visiting map[string]struct{}) bool {
+	if rewrite := child.GetRewrite(); rewrite != nil {
+		children := rewrite.GetChildren()
+		switch rewrite.GetRewriteOperation() {
+		case base.Rewrite_OPERATION_UNION:
+			for _, c := range children {
+				if !l.childGrantsNothing(entity, c, visiting) {
+					return false
+				}
+			}
+			return true
+		case base.Rewrite_OPERATION_INTERSECTION:
+			for _, c := range children {
+				if l.childGrantsNothing(entity, c, visiting) {
+					return true
+				}
+			}
+			return false
+		case base.Rewrite_OPERATION_EXCLUSION:
+			return l.childGrantsNothing(entity, children[0], visiting) || excludes(children[1:], children[0])
+		}
+		return false
+	}
+
+	switch t := child.GetLeaf().GetType().(type) {
+	case *base.Leaf_ComputedUserSet:
+		return l.grantsNothing(entity.GetName(), t.ComputedUserSet.GetRelation(), visiting)
+	case *base.Leaf_TupleToUserSet:
+		for _, ref := range entity.GetRelations()[t.TupleToUserSet.GetTupleSet().GetRelation()].GetRelationReferences() {
+			if !l.grantsNothing(ref.GetType(), t.TupleToUserSet.GetComputed().GetRelation(), visiting) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// excludes returns whether the excluded expressions exclude everyone granted by the child, as they contain the same
+// expression directly or in a union.
+func excludes(excluded []*base.Child, child *base.Child) bool {
+	for _, e := range excluded {
+		if proto.Equal(e, child) {
+			return true
+		}
+		if e.GetRewrite().GetRewriteOperation() == base.Rewrite_OPERATION_UNION && excludes(e.GetRewrite().GetChildren(), child) {
+			return true
+		}
+	}
+	return false
+}
+
+// lintCycles reports the cycles between permissions and relations that span multiple entities or
+// do not follow any relation.
+func (l *Linter) lintCycles() {
+	reported := map[string]struct{}{}
+	for _, node := range sortedKeys(l.reaches) {
+		// A node is on a cycle if it reaches itself, the cycle is made of the nodes it reaches that reach it back.
+		if _, ok := l.reaches[node][node]; !ok {
+			continue
+		}
+		if _, ok := reported[node]; ok {
+			continue
+		}
+
+		var component []string
+		entities := map[string]struct{}{}
+		for _, other := range sortedKeys(l.reaches[node]) {
+			if _, ok := l.reaches[other][node]; ok {
+				component = append(component, other)
+				reported[other] = struct{}{}
+				entities[strings.SplitN(other, "#", 2)[0]] = struct{}{}
+			}
+		}
+
+		indirect := false
+		for _, from := range component {
+			for _, to := range component {
+				indirect = indirect || l.edges[from][to]
+			}
+		}
+
+		parts := strings.SplitN(component[0], "#", 2)
+		switch {
+		case len(entities) > 1:
+			l.warn(LintPermissionCycle, parts[0], parts[1], "%s form a cycle across entities", strings.Join(component, ", "))
+		case !indirect:
+			l.warn(LintPermissionCycle, parts[0], parts[1], "%s form a cycle that does not follow any relation", strings.Join(component, ", "))
+		}
+	}
+}
+
+// warn adds a warning if the rule is enabled.
+func (l *Linter) warn(rule LintRule, definition, name, format string, args ...interface{}) {
+	if _, ok := l.disabled[rule]; ok {
+		return
+	}
+	l.warnings = append(l.warnings, &base.SchemaWarning{
+		Rule:       string(rule),
+		Definition: definition,
+		Name:       name,
+		Message:    fmt.Sprintf(format, args...),
+	})
+}
+
+// entities returns the entity definitions sorted by their names.
+func (l *Linter) entities() []*base.EntityDefinition {
+	entities := make([]*base.EntityDefinition, 0, len(l.schema.GetEntityDefinitions()))
+	for _, name := range sortedKeys(l.schema.GetEntityDefinitions()) {
+		entities = append(entities, l.schema.GetEntityDefinitions()[name])
+	}
+	return entities
+}
+
+// sortedKeys returns the keys of the map in ascending order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
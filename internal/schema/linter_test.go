@@ -0,0 +1,253 @@
+package schema
+
+import (
+	"regexp"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// lint compiles the given schema and returns the rules and the messages of its warnings.
+func lint(schema string, opts ...LinterOption) []string {
+	sch, err := parser.NewParser(schema).Parse()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	c := compiler.NewCompiler(true, sch)
+	entities, rules, err := c.Compile()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var result []string
+	opts = append(opts, RuleCalls(c.RuleCalls()))
+	for _, w := range NewLinter(NewSchemaFromEntityAndRuleDefinitions(entities, rules), opts...).Lint() {
+		result = append(result, w.GetRule()+": "+w.GetMessage())
+	}
+	return result
+}
+
+var _ = Describe("linter", func() {
+	Context("Lint", func() {
+		It("Case 1 - Reports no warnings for a clean schema", func() {
+			Expect(lint(`
+			entity user {}
+
+			entity organization {
+				relation admin @user
+				relation member @user
+
+				permission view = admin or member
+			}
+
+			entity repository {
+				relation parent @organization
+				relation owner @user
+
+				attribute is_public boolean
+
+				permission read = is_public or owner or parent.view
+			}`)).Should(BeEmpty())
+		})
+
+		It("Case 2 - Reports unused relations, attributes and rules", func() {
+			Expect(lint(`
+			entity user {}
+
+			entity organization {
+				relation admin @user
+				relation member @user
+
+				attribute balance double
+
+				permission view = admin
+			}
+
+			rule check_balance(balance double) {
+				balance > 100.0
+			}`)).Should(Equal([]string{
+				"unused-rule: rule check_balance is not called by any permission",
+				"unused-attribute: attribute organization#balance is not used by any permission",
+				"unused-relation: relation organization#member is not used by any permission or relation",
+			}))
+		})
+
+		It("Case 3 - Reports the permissions that are neither used nor asserted", func() {
+			schema := `
+			entity user {}
+
+			entity organization {
+				relation admin @user
+
+				permission manage = admin
+				permission view = manage
+				permission delete = admin
+			}`
+
+			Expect(lint(schema)).Should(BeEmpty())
+			Expect(lint(schema, AssertedPermissions("organization#view"))).Should(Equal([]string{
+				"unused-permission: permission organization#delete is neither used by any permission nor asserted in any scenario",
+			}))
+		})
+
+		It("Case 4 - Reports exclusions at the top of permissions that deny everyone", func() {
+			Expect(lint(`
+			entity user {}
+
+			entity organization {
+				relation member @user
+				relation banned @user
+
+				permission view = member not banned
+				permission edit = member and (member not banned)
+				permission manage = member not (banned or member)
+			}
+
+			entity folder {
+				relation parent @folder
+				relation banned @user
+
+				permission view = parent.view not banned
+			}`)).Should(Equal([]string{
+				"top-level-exclusion: permission folder#view has an exclusion at the top whose left side grants nothing, it is denied to everyone",
+				"top-level-exclusion: permission organization#manage has an exclusion at the top whose left side is excluded by its right side, it is denied to everyone",
+			}))
+		})
+
+		It("Case 5 - Reports tuple to user set chains deeper than the maximum", func() {
+			schema := `
+			entity user {}
+
+			entity organization {
+				relation admin @user
+				permission manage = admin
+			}
+
+			entity team {
+				relation parent @organization
+				permission manage = parent.manage
+			}
+
+			entity project {
+				relation parent @team
+				permission manage = parent.manage
+			}`
+
+			Expect(lint(schema)).Should(BeEmpty())
+			Expect(lint(schema, MaxTupleToUserSetDepth(1))).Should(Equal([]string{
+				"tuple-to-user-set-depth: permission project#manage follows 2 tuple to user set relations, more than the maximum of 1",
+			}))
+		})
+
+		It("Case 6 - Reports cycles across entities and cycles without relations", func() {
+			Expect(lint(`
+			entity user {}
+
+			entity folder {
+				relation parent @folder
+				relation owner @user
+				relation project @project
+
+				permission view = owner or parent.view
+				permission edit = project.edit
+			}
+
+			entity project {
+				relation folder @folder
+				permission edit = folder.edit
+			}
+
+			entity document {
+				relation owner @user
+				permission a = owner or b
+				permission b = a
+			}`)).Should(Equal([]string{
+				"permission-cycle: document#a, document#b form a cycle that does not follow any relation",
+				"permission-cycle: folder#edit, project#edit form a cycle across entities",
+			}))
+		})
+
+		It("Case 7 - Reports names that do not match the naming convention", func() {
+			schema := `
+			entity user {}
+
+			entity Organization {
+				relation admin @user
+				permission viewDocuments = admin
+			}`
+
+			Expect(lint(schema)).Should(Equal([]string{
+				"naming: entity name Organization does not match the naming convention ^[a-z][a-z0-9_]*$",
+				"naming: permission name viewDocuments does not match the naming convention ^[a-z][a-z0-9_]*$",
+			}))
+			Expect(lint(schema, NamingConvention(regexp.MustCompile(`^[a-zA-Z]+$`)))).Should(BeEmpty())
+		})
+
+		It("Case 8 - Skips the disabled rules", func() {
+			sch, err := NewSchemaFromStringDefinitions(true, `
+			entity user {}
+
+			entity organization {
+				relation admin @user
+				relation member @user
+				permission view = admin
+			}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(NewLinter(sch).Lint()).Should(HaveLen(1))
+			Expect(NewLinter(sch, DisableLintRules(LintUnusedRelation)).Lint()).Should(Equal([]*base.SchemaWarning{}))
+		})
+
+		It("Case 9 - Does not report the rules called by used rules", func() {
+			schema := `
+			entity user {}
+
+			entity account {
+				relation owner @user
+
+				attribute x integer
+
+				permission p = ra(x) and owner
+			}
+
+			rule ra(x integer) {
+				rb(x) && x > 1
+			}
+
+			rule rb(x integer) {
+				x > 0
+			}
+
+			rule rc(x integer) {
+				rd(x)
+			}
+
+			rule rd(x integer) {
+				x < 10
+			}`
+
+			Expect(lint(schema)).Should(Equal([]string{
+				"unused-rule: rule rc is not called by any permission",
+				"unused-rule: rule rd is not called by any permission",
+			}))
+		})
+
+		It("Case 10 - Follows the subject relations of the relations", func() {
+			Expect(lint(`
+			entity user {}
+
+			entity group {
+				relation member @user @group#member
+				relation manager @user
+			}
+
+			entity document {
+				relation viewer @group#member
+				permission view = viewer
+			}`)).Should(Equal([]string{
+				"unused-relation: relation group#manager is not used by any permission or relation",
+			}))
+		})
+	})
+})
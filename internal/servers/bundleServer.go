@@ -4,11 +4,13 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/rs/xid"
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/validation"
+	"github.com/Permify/permify/pkg/database"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
@@ -49,10 +51,13 @@ func (r *BundleServer) Write(ctx context.Context, request *v1.BundleWriteRequest
 		}
 	}
 
+	version := xid.New().String()
+
 	var bundles []storage.Bundle
 	for _, b := range request.GetBundles() {
 		bundles = append(bundles, storage.Bundle{
 			Name:       b.GetName(),
+			Version:    version,
 			DataBundle: b,
 			TenantID:   request.GetTenantId(),
 		})
@@ -67,7 +72,8 @@ func (r *BundleServer) Write(ctx context.Context, request *v1.BundleWriteRequest
 	}
 
 	return &v1.BundleWriteResponse{
-		Names: names,
+		Names:   names,
+		Version: version,
 	}, nil
 }
 
@@ -81,7 +87,7 @@ func (r *BundleServer) Read(ctx context.Context, request *v1.BundleReadRequest)
 		return nil, status.Error(GetStatus(v), v.Error())
 	}
 
-	bundle, err := r.br.Read(ctx, request.GetTenantId(), request.GetName())
+	bundle, version, err := r.br.Read(ctx, request.GetTenantId(), request.GetName(), request.GetVersion())
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -90,7 +96,8 @@ func (r *BundleServer) Read(ctx context.Context, request *v1.BundleReadRequest)
 	}
 
 	return &v1.BundleReadResponse{
-		Bundle: bundle,
+		Bundle:  bundle,
+		Version: version,
 	}, nil
 }
 
@@ -116,3 +123,27 @@ func (r *BundleServer) Delete(ctx context.Context, request *v1.BundleDeleteReque
 		Name: request.GetName(),
 	}, nil
 }
+
+// List handles the listing of bundles.
+func (r *BundleServer) List(ctx context.Context, request *v1.BundleListRequest) (*v1.BundleListResponse, error) {
+	ctx, span := tracer.Start(ctx, "bundle.list")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, status.Error(GetStatus(v), v.Error())
+	}
+
+	bundles, ct, err := r.br.List(ctx, request.GetTenantId(), request.GetName(), database.NewPagination(database.Size(request.GetPageSize()), database.Token(request.GetContinuousToken())))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.BundleListResponse{
+		Bundles:         bundles,
+		ContinuousToken: ct.String(),
+	}, nil
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/validation"
 	"github.com/Permify/permify/pkg/attribute"
+	pkgBundle "github.com/Permify/permify/pkg/bundle"
 	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/development/graph"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/telemetry"
 	"github.com/Permify/permify/pkg/tuple"
@@ -22,17 +24,18 @@ import (
 type DataServer struct {
 	v1.UnimplementedDataServer
 
-	sr                           storage.SchemaReader
-	dr                           storage.DataReader
-	br                           storage.BundleReader
-	dw                           storage.DataWriter
-	writeDataHistogram           api.Int64Histogram
-	deleteDataHistogram          api.Int64Histogram
-	readAttributesHistogram      api.Int64Histogram
-	readRelationshipsHistogram   api.Int64Histogram
-	writeRelationshipsHistogram  api.Int64Histogram
-	deleteRelationshipsHistogram api.Int64Histogram
-	runBundleHistogram           api.Int64Histogram
+	sr                             storage.SchemaReader
+	dr                             storage.DataReader
+	br                             storage.BundleReader
+	dw                             storage.DataWriter
+	writeDataHistogram             api.Int64Histogram
+	deleteDataHistogram            api.Int64Histogram
+	readAttributesHistogram        api.Int64Histogram
+	readRelationshipsHistogram     api.Int64Histogram
+	readRelationshipGraphHistogram api.Int64Histogram
+	writeRelationshipsHistogram    api.Int64Histogram
+	deleteRelationshipsHistogram   api.Int64Histogram
+	runBundleHistogram             api.Int64Histogram
 }
 
 // NewDataServer - Creates new Data Server
@@ -43,17 +46,18 @@ func NewDataServer(
 	sr storage.SchemaReader,
 ) *DataServer {
 	return &DataServer{
-		dr:                           dr,
-		dw:                           dw,
-		br:                           br,
-		sr:                           sr,
-		writeDataHistogram:           telemetry.NewHistogram(meter, "write_data", "microseconds", "Duration of writing data in microseconds"),
-		deleteDataHistogram:          telemetry.NewHistogram(meter, "delete_data", "microseconds", "Duration of deleting data in microseconds"),
-		readAttributesHistogram:      telemetry.NewHistogram(meter, "read_attributes", "microseconds", "Duration of reading attributes in microseconds"),
-		readRelationshipsHistogram:   telemetry.NewHistogram(meter, "read_relationships", "microseconds", "Duration of reading relationships in microseconds"),
-		writeRelationshipsHistogram:  telemetry.NewHistogram(meter, "write_relationships", "microseconds", "Duration of writing relationships in microseconds"),
-		deleteRelationshipsHistogram: telemetry.NewHistogram(meter, "delete_relationships", "microseconds", "Duration of deleting relationships in microseconds"),
-		runBundleHistogram:           telemetry.NewHistogram(meter, "delete_relationships", "run_bundle", "Duration of running bunble in microseconds"),
+		dr:                             dr,
+		dw:                             dw,
+		br:                             br,
+		sr:                             sr,
+		writeDataHistogram:             telemetry.NewHistogram(meter, "write_data", "microseconds", "Duration of writing data in microseconds"),
+		deleteDataHistogram:            telemetry.NewHistogram(meter, "delete_data", "microseconds", "Duration of deleting data in microseconds"),
+		readAttributesHistogram:        telemetry.NewHistogram(meter, "read_attributes", "microseconds", "Duration of reading attributes in microseconds"),
+		readRelationshipsHistogram:     telemetry.NewHistogram(meter, "read_relationships", "microseconds", "Duration of reading relationships in microseconds"),
+		readRelationshipGraphHistogram: telemetry.NewHistogram(meter, "read_relationship_graph", "microseconds", "Duration of reading relationship graphs in microseconds"),
+		writeRelationshipsHistogram:    telemetry.NewHistogram(meter, "write_relationships", "microseconds", "Duration of writing relationships in microseconds"),
+		deleteRelationshipsHistogram:   telemetry.NewHistogram(meter, "delete_relationships", "microseconds", "Duration of deleting relationships in microseconds"),
+		runBundleHistogram:             telemetry.NewHistogram(meter, "delete_relationships", "run_bundle", "Duration of running bunble in microseconds"),
 	}
 }
 
@@ -159,6 +163,78 @@ func (r *DataServer) ReadAttributes(ctx context.Context, request *v1.AttributeRe
 	}, nil
 }
 
+// ReadRelationshipGraph - Walks the relationships around an entity, following the subject sets and the tuple sets of the
+// schema, and returns them as a graph
+func (r *DataServer) ReadRelationshipGraph(ctx context.Context, request *v1.RelationshipGraphRequest) (*v1.RelationshipGraphResponse, error) {
+	ctx, span := tracer.Start(ctx, "data.read.relationship.graph")
+	defer span.End()
+	start := time.Now()
+
+	depth := request.GetDepth()
+	if depth == 0 {
+		depth = 3
+	}
+
+	v := request.Validate()
+	if v != nil {
+		return nil, status.Error(GetStatus(v), v.Error())
+	}
+
+	snap := request.GetMetadata().GetSnapToken()
+	if snap == "" {
+		st, err := r.dr.HeadSnapshot(ctx, request.GetTenantId())
+		if err != nil {
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		snap = st.Encode().String()
+	}
+
+	version := request.GetMetadata().GetSchemaVersion()
+	if version == "" {
+		v, err := r.sr.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		version = v
+	}
+
+	sch, err := r.sr.ReadSchema(ctx, request.GetTenantId(), version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	g, err := graph.NewDataBuilder(r.dr, sch).RelationshipsToGraph(ctx, request.GetTenantId(), snap, request.GetEntity(), int(depth))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	nodes := make([]*v1.RelationshipGraphNode, 0, len(g.Nodes()))
+	for _, n := range g.Nodes() {
+		nodes = append(nodes, &v1.RelationshipGraphNode{Type: n.Type, Id: n.ID, Label: n.Label})
+	}
+
+	edges := make([]*v1.RelationshipGraphEdge, 0, len(g.Edges()))
+	for _, e := range g.Edges() {
+		edges = append(edges, &v1.RelationshipGraphEdge{From: e.From.ID, To: e.To.ID, Label: e.Label})
+	}
+
+	duration := time.Since(start)
+	r.readRelationshipGraphHistogram.Record(ctx, duration.Microseconds())
+
+	return &v1.RelationshipGraphResponse{
+		Nodes:     nodes,
+		Edges:     edges,
+		SnapToken: snap,
+	}, nil
+}
+
 // Write - Write relationships and attributes to writeDB
 func (r *DataServer) Write(ctx context.Context, request *v1.DataWriteRequest) (*v1.DataWriteResponse, error) {
 	ctx, span := tracer.Start(ctx, "data.write")
@@ -402,7 +478,7 @@ func (r *DataServer) RunBundle(ctx context.Context, request *v1.BundleRunRequest
 		return nil, status.Error(GetStatus(v), v.Error())
 	}
 
-	bundle, err := r.br.Read(ctx, request.GetTenantId(), request.GetName())
+	bundle, version, err := r.br.Read(ctx, request.GetTenantId(), request.GetName(), request.GetVersion())
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -415,6 +491,21 @@ func (r *DataServer) RunBundle(ctx context.Context, request *v1.BundleRunRequest
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 
+	if request.GetDryRun() {
+		changes, err := pkgBundle.DryRun(request.GetArguments(), bundle)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			slog.ErrorContext(ctx, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+
+		return &v1.BundleRunResponse{
+			Version: version,
+			Changes: changes,
+		}, nil
+	}
+
 	snap, err := r.dw.RunBundle(ctx, request.GetTenantId(), request.GetArguments(), bundle)
 	if err != nil {
 		span.RecordError(err)
@@ -428,5 +519,6 @@ func (r *DataServer) RunBundle(ctx context.Context, request *v1.BundleRunRequest
 
 	return &v1.BundleRunResponse{
 		SnapToken: snap.String(),
+		Version:   version,
 	}, nil
 }
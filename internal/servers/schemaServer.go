@@ -12,11 +12,13 @@ import (
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"golang.org/x/net/context"
 
+	internalSchema "github.com/Permify/permify/internal/schema"
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/pkg/database"
 	"github.com/Permify/permify/pkg/dsl/compiler"
 	"github.com/Permify/permify/pkg/dsl/parser"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/schema"
 	"github.com/Permify/permify/pkg/telemetry"
 )
 
@@ -48,29 +50,47 @@ func (r *SchemaServer) Write(ctx context.Context, request *v1.SchemaWriteRequest
 	defer span.End()
 	start := time.Now()
 
-	sch, err := parser.NewParser(request.GetSchema()).Parse()
+	// Resolve the imports of the schema from the files in the request, the file system and the network are not accessed.
+	modules, err := schema.NewInlineSchemaLoader().ResolveModules(schema.Module{Content: request.GetSchema()}, request.GetFiles())
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 
-	_, _, err = compiler.NewCompiler(true, sch).Compile()
+	sch, err := schema.Parse(modules...)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 
+	c := compiler.NewCompiler(true, sch)
+	entities, rules, err := c.Compile()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	// Lint the compiled schema, the warnings are returned to the client but do not prevent the schema from being written.
+	warnings := internalSchema.NewLinter(internalSchema.NewSchemaFromEntityAndRuleDefinitions(entities, rules), internalSchema.RuleCalls(c.RuleCalls())).Lint()
+
 	version := xid.New().String()
 
 	cnf := make([]storage.SchemaDefinition, 0, len(sch.Statements))
 	for _, st := range sch.Statements {
+		serialized, err := c.Serialize(st)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
 		cnf = append(cnf, storage.SchemaDefinition{
 			TenantID:             request.GetTenantId(),
 			Version:              version,
 			Name:                 st.GetName(),
-			SerializedDefinition: []byte(st.String()),
+			SerializedDefinition: []byte(serialized),
 		})
 	}
 
@@ -87,6 +107,7 @@ func (r *SchemaServer) Write(ctx context.Context, request *v1.SchemaWriteRequest
 
 	return &v1.SchemaWriteResponse{
 		SchemaVersion: version,
+		Warnings:      warnings,
 	}, nil
 }
 
@@ -168,7 +189,8 @@ func (r *SchemaServer) PartialWrite(ctx context.Context, request *v1.SchemaParti
 	}
 
 	// Compile the new schema to validate its correctness.
-	_, _, err = compiler.NewCompiler(true, sch).Compile()
+	c := compiler.NewCompiler(true, sch)
+	_, _, err = c.Compile()
 	if err != nil {
 		span.RecordError(err)
 		return nil, status.Error(GetStatus(err), err.Error())
@@ -180,11 +202,16 @@ func (r *SchemaServer) PartialWrite(ctx context.Context, request *v1.SchemaParti
 	// Prepare the new schema definition for storage.
 	cnf := make([]storage.SchemaDefinition, 0, len(sch.Statements))
 	for _, st := range sch.Statements {
+		serialized, err := c.Serialize(st)
+		if err != nil {
+			span.RecordError(err)
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
 		cnf = append(cnf, storage.SchemaDefinition{
 			TenantID:             request.GetTenantId(),
 			Version:              newVersion,
 			Name:                 st.GetName(),
-			SerializedDefinition: []byte(st.String()),
+			SerializedDefinition: []byte(serialized),
 		})
 	}
 
@@ -35,6 +35,7 @@ import (
 	"github.com/Permify/permify/internal/middleware"
 	"github.com/Permify/permify/internal/storage"
 	grpcV1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry/meterexporters"
 	health "google.golang.org/grpc/health/grpc_health_v1"
 )
 
@@ -108,6 +109,8 @@ func (s *Container) Run(
 	dst *config.Distributed,
 	authentication *config.Authn,
 	profiler *config.Profiler,
+	tenancy *config.Tenancy,
+	meter *config.Meter,
 	localInvoker invoke.Invoker,
 ) error {
 	var err error
@@ -180,7 +183,7 @@ func (s *Container) Run(
 	grpcV1.RegisterSchemaServer(grpcServer, NewSchemaServer(s.SW, s.SR))
 	grpcV1.RegisterDataServer(grpcServer, NewDataServer(s.DR, s.DW, s.BR, s.SR))
 	grpcV1.RegisterBundleServer(grpcServer, NewBundleServer(s.BR, s.BW))
-	grpcV1.RegisterTenancyServer(grpcServer, NewTenancyServer(s.TR, s.TW))
+	grpcV1.RegisterTenancyServer(grpcServer, NewTenancyServer(s.TR, s.TW, tenancy.Retention))
 	grpcV1.RegisterWatchServer(grpcServer, NewWatchServer(s.W, s.DR))
 
 	// Register health check and reflection services for gRPC.
@@ -229,6 +232,19 @@ func (s *Container) Run(
 		}()
 	}
 
+	// If the prometheus meter exporter is used with a dedicated port, serve the /metrics endpoint on it.
+	// Otherwise, the endpoint is registered on the HTTP server below.
+	prometheusEnabled := meter.Enabled && meter.Exporter == "prometheus"
+	if prometheusEnabled && meter.Port != "" {
+		go func() {
+			slog.Info(fmt.Sprintf("🚀 metrics server successfully started: %s", meter.Port))
+
+			if err := newMetricsServer(meter.Port).ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("failed to start metrics server", slog.Any("error", err))
+			}
+		}()
+	}
+
 	var lis net.Listener
 	lis, err = net.Listen("tcp", ":"+srv.GRPC.Port)
 	if err != nil {
@@ -323,6 +339,12 @@ func (s *Container) Run(
 			return err
 		}
 
+		if prometheusEnabled && meter.Port == "" {
+			if err = registerMetricsHandler(mux); err != nil {
+				return err
+			}
+		}
+
 		httpServer = &http.Server{
 			Addr: ":" + srv.HTTP.Port,
 			Handler: cors.New(cors.Options{
@@ -377,6 +399,28 @@ func (s *Container) Run(
 	return nil
 }
 
+// newMetricsServer creates the server of the /metrics endpoint of the prometheus meter exporter on a dedicated port.
+func newMetricsServer(port string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", meterexporters.PrometheusHandler())
+
+	return &http.Server{
+		Addr:         ":" + port,
+		Handler:      mux,
+		ReadTimeout:  20 * time.Second,
+		WriteTimeout: 20 * time.Second,
+		IdleTimeout:  15 * time.Second,
+	}
+}
+
+// registerMetricsHandler registers the /metrics endpoint of the prometheus meter exporter on the HTTP gateway.
+func registerMetricsHandler(mux *runtime.ServeMux) error {
+	handler := meterexporters.PrometheusHandler()
+	return mux.HandlePath(http.MethodGet, "/metrics", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		handler.ServeHTTP(w, r)
+	})
+}
+
 // InterceptorLogger adapts slog logger to interceptor logger.
 func InterceptorLogger(l *slog.Logger) logging.Logger {
 	return logging.LoggerFunc(func(ctx context.Context, lvl logging.Level, msg string, fields ...any) {
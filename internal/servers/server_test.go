@@ -0,0 +1,71 @@
+package servers
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/Permify/permify/pkg/telemetry/meterexporters"
+)
+
+var _ = Describe("metrics endpoint", Ordered, func() {
+	// The prometheus reader registers itself on the default registry, so it is only built once.
+	BeforeAll(func() {
+		reader, err := meterexporters.ReaderFactory("prometheus", "", false, "", nil, "", time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+		provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+		counter, err := provider.Meter("servers_test").Int64Counter("scraped_requests")
+		Expect(err).ShouldNot(HaveOccurred())
+		counter.Add(context.Background(), 3)
+	})
+
+	// scrape returns the body of the /metrics endpoint at the given base url.
+	scrape := func(url string) string {
+		res, err := http.Get(url + "/metrics")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer res.Body.Close()
+
+		Expect(res.StatusCode).Should(Equal(http.StatusOK))
+
+		body, err := io.ReadAll(res.Body)
+		Expect(err).ShouldNot(HaveOccurred())
+		return string(body)
+	}
+
+	It("should serve the metrics on the HTTP gateway", func() {
+		mux := runtime.NewServeMux()
+		Expect(registerMetricsHandler(mux)).Should(Succeed())
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		Expect(scrape(server.URL)).Should(MatchRegexp(`(?m)^scraped_requests_total\{.*otel_scope_name="servers_test".*\} 3$`))
+	})
+
+	It("should serve the metrics on a dedicated port", func() {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, port, err := net.SplitHostPort(lis.Addr().String())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		server := newMetricsServer(port)
+		Expect(server.Addr).Should(Equal(":" + port))
+
+		go func() {
+			_ = server.Serve(lis)
+		}()
+		defer server.Close()
+
+		Expect(scrape("http://" + lis.Addr().String())).Should(MatchRegexp(`(?m)^scraped_requests_total\{.*otel_scope_name="servers_test".*\} 3$`))
+	})
+})
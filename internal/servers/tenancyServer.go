@@ -3,6 +3,7 @@ package servers
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"google.golang.org/grpc/status"
@@ -18,13 +19,17 @@ type TenancyServer struct {
 
 	tr storage.TenantReader
 	tw storage.TenantWriter
+
+	// retention is how long soft deleted tenants are kept, zero deletes tenants immediately
+	retention time.Duration
 }
 
 // NewTenancyServer - Creates new Tenancy Server
-func NewTenancyServer(tr storage.TenantReader, tw storage.TenantWriter) *TenancyServer {
+func NewTenancyServer(tr storage.TenantReader, tw storage.TenantWriter, retention time.Duration) *TenancyServer {
 	return &TenancyServer{
-		tr: tr,
-		tw: tw,
+		tr:        tr,
+		tw:        tw,
+		retention: retention,
 	}
 }
 
@@ -51,7 +56,14 @@ func (t *TenancyServer) Delete(ctx context.Context, request *v1.TenantDeleteRequ
 	ctx, span := tracer.Start(ctx, "tenant.delete")
 	defer span.End()
 
-	tenant, err := t.tw.DeleteTenant(ctx, request.GetId())
+	var tenant *v1.Tenant
+	var err error
+	if t.retention > 0 {
+		// The tenant's data is purged in the background once the retention has elapsed
+		tenant, err = t.tw.SoftDeleteTenant(ctx, request.GetId())
+	} else {
+		tenant, err = t.tw.DeleteTenant(ctx, request.GetId())
+	}
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -64,12 +76,53 @@ func (t *TenancyServer) Delete(ctx context.Context, request *v1.TenantDeleteRequ
 	}, nil
 }
 
+// Read - Read a Tenant
+func (t *TenancyServer) Read(ctx context.Context, request *v1.TenantReadRequest) (*v1.TenantReadResponse, error) {
+	ctx, span := tracer.Start(ctx, "tenant.read")
+	defer span.End()
+
+	tenant, err := t.tr.ReadTenant(ctx, request.GetId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.TenantReadResponse{
+		Tenant: tenant,
+	}, nil
+}
+
+// Update - Rename a Tenant and change its labels
+func (t *TenancyServer) Update(ctx context.Context, request *v1.TenantUpdateRequest) (*v1.TenantUpdateResponse, error) {
+	ctx, span := tracer.Start(ctx, "tenant.update")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, status.Error(GetStatus(v), v.Error())
+	}
+
+	tenant, err := t.tw.UpdateTenant(ctx, request.GetId(), request.GetName(), request.GetLabels(), request.GetRemoveLabels())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.TenantUpdateResponse{
+		Tenant: tenant,
+	}, nil
+}
+
 // List - List Tenants
 func (t *TenancyServer) List(ctx context.Context, request *v1.TenantListRequest) (*v1.TenantListResponse, error) {
 	ctx, span := tracer.Start(ctx, "tenant.list")
 	defer span.End()
 
-	tenants, ct, err := t.tr.ListTenants(ctx, database.NewPagination(database.Size(request.GetPageSize()), database.Token(request.GetContinuousToken())))
+	tenants, ct, err := t.tr.ListTenants(ctx, request.GetFilter(), database.NewPagination(database.Size(request.GetPageSize()), database.Token(request.GetContinuousToken())))
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -6,6 +6,7 @@ import (
 	"github.com/sony/gobreaker"
 
 	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
@@ -21,12 +22,43 @@ func NewBundleReader(delegate storage.BundleReader, cb *gobreaker.CircuitBreaker
 }
 
 // Read - Reads bundles from the repository
-func (r *BundleReader) Read(ctx context.Context, tenantID, name string) (bundle *base.DataBundle, err error) {
+func (r *BundleReader) Read(ctx context.Context, tenantID, name, version string) (*base.DataBundle, string, error) {
+	type circuitBreakerResponse struct {
+		Bundle  *base.DataBundle
+		Version string
+	}
+
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		var err error
+		var resp circuitBreakerResponse
+		resp.Bundle, resp.Version, err = r.delegate.Read(ctx, tenantID, name, version)
+		return resp, err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp := response.(circuitBreakerResponse)
+	return resp.Bundle, resp.Version, nil
+}
+
+// List - Lists bundles from the repository
+func (r *BundleReader) List(ctx context.Context, tenantID, name string, pagination database.Pagination) ([]*base.BundleList, database.EncodedContinuousToken, error) {
+	type circuitBreakerResponse struct {
+		Bundles []*base.BundleList
+		Ct      database.EncodedContinuousToken
+	}
+
 	response, err := r.cb.Execute(func() (interface{}, error) {
-		return r.delegate.Read(ctx, tenantID, name)
+		var err error
+		var resp circuitBreakerResponse
+		resp.Bundles, resp.Ct, err = r.delegate.List(ctx, tenantID, name, pagination)
+		return resp, err
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return response.(*base.DataBundle), nil
+
+	resp := response.(circuitBreakerResponse)
+	return resp.Bundles, resp.Ct, nil
 }
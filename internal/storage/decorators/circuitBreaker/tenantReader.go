@@ -21,8 +21,19 @@ func NewTenantReader(delegate storage.TenantReader, cb *gobreaker.CircuitBreaker
 	return &TenantReader{delegate: delegate, cb: cb}
 }
 
+// ReadTenant - Read tenant from the repository
+func (r *TenantReader) ReadTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		return r.delegate.ReadTenant(ctx, tenantID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.(*base.Tenant), nil
+}
+
 // ListTenants - List tenants from the repository
-func (r *TenantReader) ListTenants(ctx context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error) {
+func (r *TenantReader) ListTenants(ctx context.Context, filter *base.TenantFilter, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error) {
 	type circuitBreakerResponse struct {
 		Tenants []*base.Tenant
 		Ct      database.EncodedContinuousToken
@@ -31,7 +42,7 @@ func (r *TenantReader) ListTenants(ctx context.Context, pagination database.Pagi
 	response, err := r.cb.Execute(func() (interface{}, error) {
 		var err error
 		var resp circuitBreakerResponse
-		resp.Tenants, resp.Ct, err = r.delegate.ListTenants(ctx, pagination)
+		resp.Tenants, resp.Ct, err = r.delegate.ListTenants(ctx, filter, pagination)
 		return resp, err
 	})
 	if err != nil {
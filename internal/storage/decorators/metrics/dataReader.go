@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataReader - Add metrics behaviour to data reader
+type DataReader struct {
+	delegate storage.DataReader
+	recorder recorder
+}
+
+// NewDataReader - Add metrics behaviour to new data reader
+func NewDataReader(delegate storage.DataReader, engine string) *DataReader {
+	return &DataReader{delegate: delegate, recorder: newRecorder(engine)}
+}
+
+// QueryRelationships - Reads relation tuples from the repository
+func (r *DataReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, token string, pagination database.CursorPagination) (*database.TupleIterator, error) {
+	start := time.Now()
+	it, err := r.delegate.QueryRelationships(ctx, tenantID, filter, token, pagination)
+	rows := 0
+	if it != nil {
+		rows = it.Len()
+	}
+	r.recorder.record(ctx, "query_relationships", start, rows, err)
+	return it, err
+}
+
+// ReadRelationships - Reads relation tuples from the repository with different options.
+func (r *DataReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, token string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+	start := time.Now()
+	collection, ct, err = r.delegate.ReadRelationships(ctx, tenantID, filter, token, pagination)
+	rows := 0
+	if collection != nil {
+		rows = len(collection.GetTuples())
+	}
+	r.recorder.record(ctx, "read_relationships", start, rows, err)
+	return collection, ct, err
+}
+
+// QuerySingleAttribute - Reads a single attribute from the repository.
+func (r *DataReader) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, token string) (*base.Attribute, error) {
+	start := time.Now()
+	attribute, err := r.delegate.QuerySingleAttribute(ctx, tenantID, filter, token)
+	rows := 0
+	if attribute != nil {
+		rows = 1
+	}
+	r.recorder.record(ctx, "query_single_attribute", start, rows, err)
+	return attribute, err
+}
+
+// QueryAttributes - Reads multiple attributes from the repository.
+func (r *DataReader) QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, token string, pagination database.CursorPagination) (*database.AttributeIterator, error) {
+	start := time.Now()
+	it, err := r.delegate.QueryAttributes(ctx, tenantID, filter, token, pagination)
+	rows := 0
+	if it != nil {
+		rows = it.Len()
+	}
+	r.recorder.record(ctx, "query_attributes", start, rows, err)
+	return it, err
+}
+
+// ReadAttributes - Reads multiple attributes from the repository with different options.
+func (r *DataReader) ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, token string, pagination database.Pagination) (collection *database.AttributeCollection, ct database.EncodedContinuousToken, err error) {
+	start := time.Now()
+	collection, ct, err = r.delegate.ReadAttributes(ctx, tenantID, filter, token, pagination)
+	rows := 0
+	if collection != nil {
+		rows = len(collection.GetAttributes())
+	}
+	r.recorder.record(ctx, "read_attributes", start, rows, err)
+	return collection, ct, err
+}
+
+// QueryUniqueSubjectReferences - Reads unique subject references from the repository with different options.
+func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, excluded []string, token string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
+	start := time.Now()
+	ids, ct, err = r.delegate.QueryUniqueSubjectReferences(ctx, tenantID, subjectReference, excluded, token, pagination)
+	r.recorder.record(ctx, "query_unique_subject_references", start, len(ids), err)
+	return ids, ct, err
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository.
+func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	start := time.Now()
+	snap, err := r.delegate.HeadSnapshot(ctx, tenantID)
+	r.recorder.record(ctx, "head_snapshot", start, 0, err)
+	return snap, err
+}
@@ -0,0 +1,96 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/memory"
+	"github.com/Permify/permify/internal/storage/memory/migrations"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/memory"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// failingDataReader fails every call it implements with the given error.
+type failingDataReader struct {
+	storage.DataReader
+	err error
+}
+
+func (r *failingDataReader) QueryRelationships(context.Context, string, *base.TupleFilter, string, database.CursorPagination) (*database.TupleIterator, error) {
+	return nil, r.err
+}
+
+var _ = Describe("DataReader", func() {
+	var mdb *db.Memory
+
+	BeforeEach(func() {
+		var err error
+		mdb, err = db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(mdb.Close()).Should(Succeed())
+	})
+
+	It("should record the duration and the rows of the successful calls", func() {
+		ctx := context.Background()
+
+		var tuples []*base.Tuple
+		for _, relationship := range []string{
+			"organization:1#admin@user:1",
+			"organization:1#admin@user:2",
+		} {
+			t, err := tuple.Tuple(relationship)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+
+		_, err := memory.NewDataWriter(mdb).Write(ctx, "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		dataReader := NewDataReader(memory.NewDataReader(mdb), "reader-success")
+
+		it, err := dataReader.QueryRelationships(ctx, "t1", &base.TupleFilter{
+			Entity: &base.EntityFilter{Type: "organization", Ids: []string{"1"}},
+		}, token.NewNoopToken().Encode().String(), database.NewCursorPagination())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(it.Len()).Should(Equal(2))
+
+		Expect(durations(labels("reader-success", "query_relationships")...)).Should(Equal(uint64(1)))
+		Expect(counter("storage_rows", labels("reader-success", "query_relationships")...)).Should(Equal(int64(2)))
+		Expect(counter("storage_errors", failure("reader-success", "query_relationships", "ERROR_CODE_INTERNAL")...)).Should(BeZero())
+	})
+
+	It("should record the duration of the failed calls and label the errors without a code as internal", func() {
+		ctx := context.Background()
+
+		dataReader := NewDataReader(memory.NewDataReader(mdb), "reader-failure")
+
+		_, _, err := dataReader.ReadRelationships(ctx, "t1", &base.TupleFilter{
+			Entity: &base.EntityFilter{Type: "organization"},
+		}, token.NewNoopToken().Encode().String(), database.NewPagination(database.Size(10), database.Token("invalid")))
+		Expect(err).Should(HaveOccurred())
+
+		Expect(durations(labels("reader-failure", "read_relationships")...)).Should(Equal(uint64(1)))
+		Expect(counter("storage_errors", failure("reader-failure", "read_relationships", "ERROR_CODE_INTERNAL")...)).Should(Equal(int64(1)))
+		Expect(counter("storage_rows", labels("reader-failure", "read_relationships")...)).Should(BeZero())
+	})
+
+	It("should label the errors with their codes", func() {
+		dataReader := NewDataReader(&failingDataReader{err: errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())}, "reader-code")
+
+		_, err := dataReader.QueryRelationships(context.Background(), "t1", &base.TupleFilter{}, "", database.NewCursorPagination())
+		Expect(err).Should(HaveOccurred())
+
+		Expect(durations(labels("reader-code", "query_relationships")...)).Should(Equal(uint64(1)))
+		Expect(counter("storage_errors", failure("reader-code", "query_relationships", "ERROR_CODE_EXECUTION")...)).Should(Equal(int64(1)))
+	})
+})
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataWriter - Add metrics behaviour to data writer
+type DataWriter struct {
+	delegate storage.DataWriter
+	recorder recorder
+}
+
+// NewDataWriter - Add metrics behaviour to new data writer
+func NewDataWriter(delegate storage.DataWriter, engine string) *DataWriter {
+	return &DataWriter{delegate: delegate, recorder: newRecorder(engine)}
+}
+
+// Write - Writes relation tuples and attributes to the repository
+func (w *DataWriter) Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributesCollection *database.AttributeCollection) (token.EncodedSnapToken, error) {
+	start := time.Now()
+	snap, err := w.delegate.Write(ctx, tenantID, tupleCollection, attributesCollection)
+	w.recorder.record(ctx, "write", start, 0, err)
+	return snap, err
+}
+
+// Delete - Deletes relation tuples and attributes from the repository
+func (w *DataWriter) Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token.EncodedSnapToken, error) {
+	start := time.Now()
+	snap, err := w.delegate.Delete(ctx, tenantID, tupleFilter, attributeFilter)
+	w.recorder.record(ctx, "delete", start, 0, err)
+	return snap, err
+}
+
+// RunBundle - Executes a data bundle on the repository
+func (w *DataWriter) RunBundle(ctx context.Context, tenantID string, arguments map[string]string, bundle *base.DataBundle) (token.EncodedSnapToken, error) {
+	start := time.Now()
+	snap, err := w.delegate.RunBundle(ctx, tenantID, arguments, bundle)
+	w.recorder.record(ctx, "run_bundle", start, 0, err)
+	return snap, err
+}
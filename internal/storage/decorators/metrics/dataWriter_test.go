@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/memory"
+	"github.com/Permify/permify/internal/storage/memory/migrations"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/memory"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// failingDataWriter fails every call it implements with the given error.
+type failingDataWriter struct {
+	storage.DataWriter
+	err error
+}
+
+func (w *failingDataWriter) Write(context.Context, string, *database.TupleCollection, *database.AttributeCollection) (token.EncodedSnapToken, error) {
+	return nil, w.err
+}
+
+var _ = Describe("DataWriter", func() {
+	It("should record the duration of the successful calls", func() {
+		mdb, err := db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer mdb.Close()
+
+		t, err := tuple.Tuple("organization:1#admin@user:1")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		dataWriter := NewDataWriter(memory.NewDataWriter(mdb), "writer-success")
+
+		_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(t), database.NewAttributeCollection())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(durations(labels("writer-success", "write")...)).Should(Equal(uint64(1)))
+		Expect(counter("storage_errors", failure("writer-success", "write", "ERROR_CODE_INTERNAL")...)).Should(BeZero())
+	})
+
+	It("should record the duration and the error code of the failed calls", func() {
+		dataWriter := NewDataWriter(&failingDataWriter{err: errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())}, "writer-failure")
+
+		_, err := dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(), database.NewAttributeCollection())
+		Expect(err).Should(HaveOccurred())
+
+		Expect(durations(labels("writer-failure", "write")...)).Should(Equal(uint64(1)))
+		Expect(counter("storage_errors", failure("writer-failure", "write", "ERROR_CODE_EXECUTION")...)).Should(Equal(int64(1)))
+	})
+})
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry"
+)
+
+var meter = otel.Meter("storage")
+
+// recorder - Records duration, returned rows and errors of storage calls
+type recorder struct {
+	engine string
+
+	durationHistogram api.Int64Histogram
+	rowsCounter       api.Int64Counter
+	errorsCounter     api.Int64Counter
+}
+
+// newRecorder - Creates new recorder for the given storage engine
+func newRecorder(engine string) recorder {
+	return recorder{
+		engine:            engine,
+		durationHistogram: telemetry.NewHistogram(meter, "storage_duration", "microseconds", "Duration of storage calls in microseconds"),
+		rowsCounter:       telemetry.NewCounter(meter, "storage_rows", "Number of rows returned by storage calls"),
+		errorsCounter:     telemetry.NewCounter(meter, "storage_errors", "Number of failed storage calls"),
+	}
+}
+
+// record - Records the result of a storage call that started at the given time
+func (r recorder) record(ctx context.Context, method string, start time.Time, rows int, err error) {
+	attrs := api.WithAttributes(
+		attribute.String("engine", r.engine),
+		attribute.String("method", method),
+	)
+
+	r.durationHistogram.Record(ctx, time.Since(start).Microseconds(), attrs)
+
+	if err != nil {
+		r.errorsCounter.Add(ctx, 1, api.WithAttributes(
+			attribute.String("engine", r.engine),
+			attribute.String("method", method),
+			attribute.String("code", errorCode(err)),
+		))
+		return
+	}
+
+	if rows > 0 {
+		r.rowsCounter.Add(ctx, int64(rows), attrs)
+	}
+}
+
+// errorCode - Returns the error code of the given storage error
+func errorCode(err error) string {
+	if _, ok := base.ErrorCode_value[err.Error()]; ok {
+		return err.Error()
+	}
+	return base.ErrorCode_ERROR_CODE_INTERNAL.String()
+}
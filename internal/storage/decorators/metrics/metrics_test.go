@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestMetrics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "metrics-suite")
+}
+
+// reader collects the metrics recorded by the decorators, the instruments of the package meter are delegated to it
+// once the global meter provider is set.
+var reader *sdkmetric.ManualReader
+
+var _ = BeforeSuite(func() {
+	reader = sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+})
+
+// collect returns the metrics recorded so far.
+func collect() []metricdata.Metrics {
+	var rm metricdata.ResourceMetrics
+	Expect(reader.Collect(context.Background(), &rm)).Should(Succeed())
+
+	var metrics []metricdata.Metrics
+	for _, sm := range rm.ScopeMetrics {
+		metrics = append(metrics, sm.Metrics...)
+	}
+	return metrics
+}
+
+// counter returns the value of the counter with the given name and attributes, zero if nothing was recorded.
+func counter(name string, attrs ...attribute.KeyValue) int64 {
+	set := attribute.NewSet(attrs...)
+	for _, m := range collect() {
+		if m.Name != name {
+			continue
+		}
+		for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+			if dp.Attributes.Equals(&set) {
+				return dp.Value
+			}
+		}
+	}
+	return 0
+}
+
+// durations returns the number of durations recorded by the histogram with the given attributes.
+func durations(attrs ...attribute.KeyValue) uint64 {
+	set := attribute.NewSet(attrs...)
+	for _, m := range collect() {
+		if m.Name != "storage_duration" {
+			continue
+		}
+		for _, dp := range m.Data.(metricdata.Histogram[int64]).DataPoints {
+			if dp.Attributes.Equals(&set) {
+				return dp.Count
+			}
+		}
+	}
+	return 0
+}
+
+// labels returns the attributes of a call of the method on the engine.
+func labels(engine, method string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("engine", engine),
+		attribute.String("method", method),
+	}
+}
+
+// failure returns the attributes of a failed call of the method on the engine.
+func failure(engine, method, code string) []attribute.KeyValue {
+	return append(labels(engine, method), attribute.String("code", code))
+}
+
+var _ = Describe("errorCode", func() {
+	It("should keep the error codes", func() {
+		Expect(errorCode(errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))).Should(Equal("ERROR_CODE_SCHEMA_NOT_FOUND"))
+	})
+
+	It("should label the other errors as internal", func() {
+		Expect(errorCode(errors.New("connection refused"))).Should(Equal("ERROR_CODE_INTERNAL"))
+	})
+})
@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// SchemaReader - Add metrics behaviour to schema reader
+type SchemaReader struct {
+	delegate storage.SchemaReader
+	recorder recorder
+}
+
+// NewSchemaReader - Add metrics behaviour to new schema reader
+func NewSchemaReader(delegate storage.SchemaReader, engine string) *SchemaReader {
+	return &SchemaReader{delegate: delegate, recorder: newRecorder(engine)}
+}
+
+// ReadSchema - Reads the schema definition from the repository
+func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string) (*base.SchemaDefinition, error) {
+	start := time.Now()
+	schema, err := r.delegate.ReadSchema(ctx, tenantID, version)
+	rows := 0
+	if schema != nil {
+		rows = len(schema.GetEntityDefinitions()) + len(schema.GetRuleDefinitions())
+	}
+	r.recorder.record(ctx, "read_schema", start, rows, err)
+	return schema, err
+}
+
+// ReadSchemaString - Reads the schema definitions as strings from the repository
+func (r *SchemaReader) ReadSchemaString(ctx context.Context, tenantID, version string) ([]string, error) {
+	start := time.Now()
+	definitions, err := r.delegate.ReadSchemaString(ctx, tenantID, version)
+	r.recorder.record(ctx, "read_schema_string", start, len(definitions), err)
+	return definitions, err
+}
+
+// ReadEntityDefinition - Reads entity definition from the repository
+func (r *SchemaReader) ReadEntityDefinition(ctx context.Context, tenantID, entityName, version string) (*base.EntityDefinition, string, error) {
+	start := time.Now()
+	definition, v, err := r.delegate.ReadEntityDefinition(ctx, tenantID, entityName, version)
+	rows := 0
+	if definition != nil {
+		rows = 1
+	}
+	r.recorder.record(ctx, "read_entity_definition", start, rows, err)
+	return definition, v, err
+}
+
+// ReadRuleDefinition - Reads rule definition from the repository
+func (r *SchemaReader) ReadRuleDefinition(ctx context.Context, tenantID, ruleName, version string) (*base.RuleDefinition, string, error) {
+	start := time.Now()
+	definition, v, err := r.delegate.ReadRuleDefinition(ctx, tenantID, ruleName, version)
+	rows := 0
+	if definition != nil {
+		rows = 1
+	}
+	r.recorder.record(ctx, "read_rule_definition", start, rows, err)
+	return definition, v, err
+}
+
+// HeadVersion - Finds the latest version of the schema.
+func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (string, error) {
+	start := time.Now()
+	version, err := r.delegate.HeadVersion(ctx, tenantID)
+	r.recorder.record(ctx, "head_version", start, 0, err)
+	return version, err
+}
+
+// ListSchemas - List all Schemas
+func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) ([]*base.SchemaList, database.EncodedContinuousToken, error) {
+	start := time.Now()
+	schemas, ct, err := r.delegate.ListSchemas(ctx, tenantID, pagination)
+	r.recorder.record(ctx, "list_schemas", start, len(schemas), err)
+	return schemas, ct, err
+}
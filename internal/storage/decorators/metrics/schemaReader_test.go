@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/memory"
+	"github.com/Permify/permify/internal/storage/memory/migrations"
+	db "github.com/Permify/permify/pkg/database/memory"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("SchemaReader", func() {
+	var mdb *db.Memory
+
+	BeforeEach(func() {
+		var err error
+		mdb, err = db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(mdb.Close()).Should(Succeed())
+	})
+
+	It("should record the duration and the rows of the successful calls", func() {
+		ctx := context.Background()
+
+		err := memory.NewSchemaWriter(mdb).WriteSchema(ctx, []storage.SchemaDefinition{
+			{TenantID: "t1", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: "v1"},
+			{TenantID: "t1", Name: "organization", SerializedDefinition: []byte("entity organization {\n\trelation admin @user\n}"), Version: "v1"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		schemaReader := NewSchemaReader(memory.NewSchemaReader(mdb), "schema-success")
+
+		definitions, err := schemaReader.ReadSchemaString(ctx, "t1", "v1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(definitions).Should(HaveLen(2))
+
+		Expect(durations(labels("schema-success", "read_schema_string")...)).Should(Equal(uint64(1)))
+		Expect(counter("storage_rows", labels("schema-success", "read_schema_string")...)).Should(Equal(int64(2)))
+	})
+
+	It("should record the duration and the error code of the failed calls", func() {
+		schemaReader := NewSchemaReader(memory.NewSchemaReader(mdb), "schema-failure")
+
+		_, _, err := schemaReader.ReadEntityDefinition(context.Background(), "t1", "user", "v1")
+		Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+
+		Expect(durations(labels("schema-failure", "read_entity_definition")...)).Should(Equal(uint64(1)))
+		Expect(counter("storage_errors", failure("schema-failure", "read_entity_definition", "ERROR_CODE_SCHEMA_NOT_FOUND")...)).Should(Equal(int64(1)))
+		Expect(counter("storage_rows", labels("schema-failure", "read_entity_definition")...)).Should(BeZero())
+	})
+})
@@ -3,9 +3,15 @@ package memory
 import (
 	"context"
 	"errors"
+	"sort"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/rs/xid"
 
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/memory/constants"
+	"github.com/Permify/permify/internal/storage/memory/utils"
+	"github.com/Permify/permify/pkg/database"
 
 	db "github.com/Permify/permify/pkg/database/memory"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
@@ -22,19 +28,110 @@ func NewBundleReader(database *db.Memory) *BundleReader {
 	}
 }
 
-func (b *BundleReader) Read(ctx context.Context, tenantID, name string) (bundle *base.DataBundle, err error) {
+func (b *BundleReader) Read(ctx context.Context, tenantID, name, version string) (bundle *base.DataBundle, v string, err error) {
 	txn := b.database.DB.Txn(false)
 	defer txn.Abort()
-	var raw interface{}
-	raw, err = txn.First(constants.BundlesTable, "id", tenantID, name)
+
+	var result memdb.ResultIterator
+	result, err = txn.Get(constants.BundlesTable, "name", tenantID, name)
 	if err != nil {
-		return bundle, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		return bundle, "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	var found *storage.Bundle
+	for obj := result.Next(); obj != nil; obj = result.Next() {
+		bun, ok := obj.(storage.Bundle)
+		if !ok {
+			return nil, "", errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if version != "" && bun.Version != version {
+			continue
+		}
+		if found == nil || bun.Version > found.Version {
+			found = &bun
+		}
+	}
+
+	if found == nil {
+		return nil, "", errors.New(base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String())
+	}
+
+	return found.DataBundle, found.Version, nil
+}
+
+// List lists the latest version of every bundle of a tenant. If a name is given,
+// all versions of that bundle are listed, newest first.
+func (b *BundleReader) List(ctx context.Context, tenantID, name string, pagination database.Pagination) (bundles []*base.BundleList, ct database.EncodedContinuousToken, err error) {
+	txn := b.database.DB.Txn(false)
+	defer txn.Abort()
+
+	var lowerBound string
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			return nil, nil, err
+		}
+		lowerBound = t.(utils.ContinuousToken).Value
+	}
+
+	var result memdb.ResultIterator
+	if name == "" {
+		result, err = txn.Get(constants.BundlesTable, "tenant", tenantID)
+	} else {
+		result, err = txn.Get(constants.BundlesTable, "name", tenantID, name)
+	}
+	if err != nil {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	latest := make(map[string]storage.Bundle)
+	var versions []storage.Bundle
+	for obj := result.Next(); obj != nil; obj = result.Next() {
+		bun, ok := obj.(storage.Bundle)
+		if !ok {
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if name != "" {
+			versions = append(versions, bun)
+			continue
+		}
+		if l, ok := latest[bun.Name]; !ok || bun.Version > l.Version {
+			latest[bun.Name] = bun
+		}
+	}
+
+	var keyOf func(storage.Bundle) string
+	if name == "" {
+		for _, bun := range latest {
+			versions = append(versions, bun)
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i].Name < versions[j].Name })
+		keyOf = func(bun storage.Bundle) string { return bun.Name }
+	} else {
+		sort.Slice(versions, func(i, j int) bool { return versions[i].Version > versions[j].Version })
+		keyOf = func(bun storage.Bundle) string { return bun.Version }
 	}
 
-	bun, ok := raw.(storage.Bundle)
-	if ok {
-		return bun.DataBundle, err
+	bundles = make([]*base.BundleList, 0, pagination.PageSize()+1)
+	for _, bun := range versions {
+		if lowerBound != "" {
+			if name == "" && bun.Name < lowerBound {
+				continue
+			}
+			if name != "" && bun.Version > lowerBound {
+				continue
+			}
+		}
+		var createdAt string
+		if id, err := xid.FromString(bun.Version); err == nil {
+			createdAt = id.Time().String()
+		}
+		bundles = append(bundles, &base.BundleList{Name: bun.Name, Version: bun.Version, CreatedAt: createdAt})
+		if len(bundles) > int(pagination.PageSize()) {
+			return bundles[:pagination.PageSize()], utils.NewContinuousToken(keyOf(bun)).Encode(), nil
+		}
 	}
 
-	return nil, errors.New(base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String())
+	return bundles, database.NewNoopContinuousToken().Encode(), nil
 }
@@ -38,14 +38,15 @@ func (b *BundleWriter) Write(ctx context.Context, bundles []storage.Bundle) (nam
 
 func (b *BundleWriter) Delete(ctx context.Context, tenantID, name string) (err error) {
 	txn := b.database.DB.Txn(true)
-	raw, err := txn.First(constants.BundlesTable, "id", tenantID, name)
+	defer txn.Abort()
 
-	if raw == nil {
-		return errors.New(base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String())
-	}
-	err = txn.Delete(constants.BundlesTable, raw)
+	var deleted int
+	deleted, err = txn.DeleteAll(constants.BundlesTable, "name", tenantID, name)
 	if err != nil {
-		return err
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if deleted == 0 {
+		return errors.New(base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String())
 	}
 	txn.Commit()
 
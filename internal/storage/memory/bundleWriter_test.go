@@ -77,7 +77,7 @@ var _ = Describe("BundleWriter", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(names1).Should(Equal([]string{"user_created"}))
 
-			bundle1, err := bundleReader.Read(ctx, "t1", "user_created")
+			bundle1, _, err := bundleReader.Read(ctx, "t1", "user_created", "")
 			Expect(err).ShouldNot(HaveOccurred())
 
 			Expect(bundle1.GetName()).Should(Equal("user_created"))
@@ -143,7 +143,7 @@ var _ = Describe("BundleWriter", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(names2).Should(Equal([]string{"user_created"}))
 
-			bundle2, err := bundleReader.Read(ctx, "t1", "user_created")
+			bundle2, _, err := bundleReader.Read(ctx, "t1", "user_created", "")
 			Expect(err).ShouldNot(HaveOccurred())
 
 			Expect(bundle2.GetName()).Should(Equal("user_created"))
@@ -231,16 +231,16 @@ var _ = Describe("BundleWriter", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(names).Should(Equal([]string{"user_created", "user_deleted"}))
 
-			_, err = bundleReader.Read(ctx, "t1", "user_created")
+			_, _, err = bundleReader.Read(ctx, "t1", "user_created", "")
 			Expect(err).ShouldNot(HaveOccurred())
 
 			err = bundleWriter.Delete(ctx, "t1", "user_created")
 			Expect(err).ShouldNot(HaveOccurred())
 
-			_, err = bundleReader.Read(ctx, "t1", "user_created")
+			_, _, err = bundleReader.Read(ctx, "t1", "user_created", "")
 			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String()))
 
-			_, err = bundleReader.Read(ctx, "t1", "user_deleted")
+			_, _, err = bundleReader.Read(ctx, "t1", "user_deleted", "")
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 	})
@@ -80,7 +80,7 @@ var _ = Describe("DataWriter", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(names).Should(Equal([]string{"user_created"}))
 
-			b, err := bundleReader.Read(ctx, "t1", "user_created")
+			b, _, err := bundleReader.Read(ctx, "t1", "user_created", "")
 			Expect(err).ShouldNot(HaveOccurred())
 
 			// Call RunBundle with the real implementation of runOperation
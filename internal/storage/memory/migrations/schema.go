@@ -80,6 +80,15 @@ var Schema = &memdb.DBSchema{
 						},
 					},
 				},
+				"tenant": {
+					Name:   "tenant",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+						},
+					},
+				},
 			},
 		},
 		constants.RelationTuplesTable: {
@@ -146,6 +155,15 @@ var Schema = &memdb.DBSchema{
 						},
 					},
 				},
+				"tenant": {
+					Name:   "tenant",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+						},
+					},
+				},
 			},
 		},
 		constants.TenantsTable: {
@@ -172,6 +190,27 @@ var Schema = &memdb.DBSchema{
 						Indexes: []memdb.Indexer{
 							&memdb.StringFieldIndex{Field: "TenantID"},
 							&memdb.StringFieldIndex{Field: "Name"},
+							&memdb.StringFieldIndex{Field: "Version"},
+						},
+						AllowMissing: true,
+					},
+				},
+				"name": {
+					Name:   "name",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+							&memdb.StringFieldIndex{Field: "Name"},
+						},
+					},
+				},
+				"tenant": {
+					Name:   "tenant",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
 						},
 					},
 				},
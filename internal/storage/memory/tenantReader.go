@@ -26,8 +26,30 @@ func NewTenantReader(database *db.Memory) *TenantReader {
 	}
 }
 
+// ReadTenant -
+func (r *TenantReader) ReadTenant(_ context.Context, tenantID string) (tenant *base.Tenant, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	var raw interface{}
+	raw, err = txn.First(constants.TenantsTable, "id", tenantID)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+	}
+
+	t, ok := raw.(storage.Tenant)
+	if !ok {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+
+	return t.ToTenant(), nil
+}
+
 // ListTenants -
-func (r *TenantReader) ListTenants(_ context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error) {
+func (r *TenantReader) ListTenants(_ context.Context, filter *base.TenantFilter, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error) {
 	txn := r.database.DB.Txn(false)
 	defer txn.Abort()
 
@@ -53,6 +75,9 @@ func (r *TenantReader) ListTenants(_ context.Context, pagination database.Pagina
 		if !ok {
 			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
+		if !t.MatchesFilter(filter) {
+			continue
+		}
 		tenants = append(tenants, t.ToTenant())
 		if len(tenants) > int(pagination.PageSize()) {
 			return tenants[:pagination.PageSize()], utils.NewContinuousToken(t.ID).Encode(), nil
@@ -3,9 +3,10 @@ package memory
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-memdb"
+
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/memory/constants"
 	db "github.com/Permify/permify/pkg/database/memory"
@@ -40,50 +41,118 @@ func (w *TenantWriter) CreateTenant(_ context.Context, id, name string) (result
 	return tenant.ToTenant(), nil
 }
 
-// DeleteTenant -
+// UpdateTenant -
+func (w *TenantWriter) UpdateTenant(_ context.Context, tenantID, name string, labels map[string]string, removeLabels []string) (result *base.Tenant, err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	tenant, err := w.activeTenant(txn, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != "" {
+		tenant.Name = name
+	}
+
+	// Copy the labels so that the stored object is never mutated in place
+	updated := make(map[string]string, len(tenant.Labels)+len(labels))
+	for key, value := range tenant.Labels {
+		updated[key] = value
+	}
+	for key, value := range labels {
+		updated[key] = value
+	}
+	for _, key := range removeLabels {
+		delete(updated, key)
+	}
+	tenant.Labels = updated
+
+	if err = txn.Insert(constants.TenantsTable, tenant); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+	return tenant.ToTenant(), nil
+}
+
+// SoftDeleteTenant -
+func (w *TenantWriter) SoftDeleteTenant(_ context.Context, tenantID string) (result *base.Tenant, err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	tenant, err := w.activeTenant(txn, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedAt := time.Now()
+	tenant.DeletedAt = &deletedAt
+
+	if err = txn.Insert(constants.TenantsTable, tenant); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+	return tenant.ToTenant(), nil
+}
+
+// activeTenant returns the tenant with the given id unless it does not exist or has been soft deleted.
+func (w *TenantWriter) activeTenant(txn *memdb.Txn, tenantID string) (storage.Tenant, error) {
+	raw, err := txn.First(constants.TenantsTable, "id", tenantID)
+	if err != nil {
+		return storage.Tenant{}, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return storage.Tenant{}, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+	}
+	tenant, ok := raw.(storage.Tenant)
+	if !ok {
+		return storage.Tenant{}, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	if tenant.DeletedAt != nil {
+		return storage.Tenant{}, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+	}
+	return tenant, nil
+}
+
+// DeleteTenant - Deletes the tenant with its relationships, attributes, schemas and bundles
 func (w *TenantWriter) DeleteTenant(_ context.Context, tenantID string) (result *base.Tenant, err error) {
 	txn := w.database.DB.Txn(true)
 	defer txn.Abort()
 
-	// Define a slice of tables to delete associated records
+	raw, err := txn.First(constants.TenantsTable, "id", tenantID)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+	}
+	tenant, ok := raw.(storage.Tenant)
+	if !ok {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+
+	// Delete the records of the tenant by the tenant index of each table
 	tables := []string{
 		constants.AttributesTable,
 		constants.BundlesTable,
 		constants.RelationTuplesTable,
 		constants.SchemaDefinitionsTable,
 	}
-
-	// Iterate through each table and delete records associated with the tenant
-	totalDeleted := 0
 	for _, table := range tables {
-		if _, err := txn.First(table, "tenant_id", tenantID); err == nil {
-			numDeleted, deleteErr := txn.DeleteAll(table, "tenant_id", tenantID)
-			if deleteErr != nil {
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
-			}
-			totalDeleted += numDeleted
-		}
-	}
-
-	// Retrieve the tenant first
-	raw, err := txn.First(constants.TenantsTable, "id", tenantID)
-	if err != nil {
-		if totalDeleted > 0 {
-			raw = storage.Tenant{
-				ID:        tenantID,
-				Name:      fmt.Sprintf("Affected rows: %d", totalDeleted),
-				CreatedAt: time.Now(),
-			}
-		} else {
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
-		}
-	} else {
-		// Finally, delete the tenant record
-		if _, err = txn.DeleteAll(constants.TenantsTable, "id", tenantID); err != nil {
+		if _, err = txn.DeleteAll(table, "tenant", tenantID); err != nil {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
 	}
 
+	// Finally, delete the tenant record
+	if err = txn.Delete(constants.TenantsTable, tenant); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
 	txn.Commit()
-	return raw.(storage.Tenant).ToTenant(), nil
+
+	mu.Lock()
+	delete(headVersion, tenantID)
+	mu.Unlock()
+
+	return tenant.ToTenant(), nil
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Permify/permify/internal/storage/memory/migrations"
 	"github.com/Permify/permify/pkg/database/memory"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
 var _ = Describe("TenantWriter", func() {
@@ -55,6 +56,59 @@ var _ = Describe("TenantWriter", func() {
 
 			Expect(tenant.Id).Should(Equal("test_id_1"))
 			Expect(tenant.Name).Should(Equal("test name 1"))
+
+			_, err = tenantWriter.DeleteTenant(ctx, "test_id_1")
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+		})
+	})
+
+	Context("Update Tenant", func() {
+		It("should rename tenant and change its labels", func() {
+			ctx := context.Background()
+
+			_, err := tenantWriter.CreateTenant(ctx, "test_id_1", "test name 1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tenant, err := tenantWriter.UpdateTenant(ctx, "test_id_1", "", map[string]string{"plan": "trial", "region": "eu"}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetName()).Should(Equal("test name 1"))
+			Expect(tenant.GetLabels()).Should(Equal(map[string]string{"plan": "trial", "region": "eu"}))
+
+			tenant, err = tenantWriter.UpdateTenant(ctx, "test_id_1", "renamed", map[string]string{"plan": "enterprise"}, []string{"region"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetName()).Should(Equal("renamed"))
+			Expect(tenant.GetLabels()).Should(Equal(map[string]string{"plan": "enterprise"}))
+		})
+
+		It("should get tenant not found error", func() {
+			ctx := context.Background()
+
+			_, err := tenantWriter.UpdateTenant(ctx, "unknown", "renamed", nil, nil)
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+		})
+	})
+
+	Context("Soft Delete Tenant", func() {
+		It("should mark tenant as deleted", func() {
+			ctx := context.Background()
+
+			_, err := tenantWriter.CreateTenant(ctx, "test_id_1", "test name 1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tenant, err := tenantWriter.SoftDeleteTenant(ctx, "test_id_1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetId()).Should(Equal("test_id_1"))
+			Expect(tenant.GetDeletedAt()).ShouldNot(BeNil())
+
+			_, err = tenantWriter.SoftDeleteTenant(ctx, "test_id_1")
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+
+			_, err = tenantWriter.UpdateTenant(ctx, "test_id_1", "renamed", nil, nil)
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+
+			tenant, err = tenantWriter.DeleteTenant(ctx, "test_id_1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetName()).Should(Equal("test name 1"))
 		})
 	})
 })
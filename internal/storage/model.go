@@ -75,21 +75,53 @@ func (e SchemaDefinition) Serialized() string {
 type Tenant struct {
 	ID        string
 	Name      string
+	Labels    map[string]string
 	CreatedAt time.Time
+	DeletedAt *time.Time
+	// Deletion progress, only set while the tenant's data is being removed
+	DeletionStartedAt *time.Time
+	DeletedRows       int64
+	DeletionTable     string
 }
 
 // ToTenant - Convert database tenant to base tenant
 func (r Tenant) ToTenant() *base.Tenant {
-	return &base.Tenant{
+	tenant := &base.Tenant{
 		Id:        r.ID,
 		Name:      r.Name,
+		Labels:    r.Labels,
 		CreatedAt: timestamppb.New(r.CreatedAt),
 	}
+	if r.DeletedAt != nil {
+		tenant.DeletedAt = timestamppb.New(*r.DeletedAt)
+	}
+	if r.DeletionStartedAt != nil {
+		tenant.Deletion = &base.TenantDeletion{
+			StartedAt:   timestamppb.New(*r.DeletionStartedAt),
+			DeletedRows: r.DeletedRows,
+			Table:       r.DeletionTable,
+		}
+	}
+	return tenant
+}
+
+// MatchesFilter - Reports whether the tenant satisfies the given tenant filter
+func (r Tenant) MatchesFilter(filter *base.TenantFilter) bool {
+	if r.DeletedAt != nil && !filter.GetIncludeDeleted() {
+		return false
+	}
+	for key, value := range filter.GetLabels() {
+		if v, ok := r.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
 }
 
 // Bundle - Structure for Bundle
 type Bundle struct {
 	Name       string
+	Version    string
 	DataBundle *base.DataBundle
 	TenantID   string
 }
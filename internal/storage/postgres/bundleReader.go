@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -13,6 +14,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 
 	"github.com/Permify/permify/internal/storage/postgres/utils"
+	"github.com/Permify/permify/pkg/database"
 	db "github.com/Permify/permify/pkg/database/postgres"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -29,20 +31,24 @@ func NewBundleReader(database *db.Postgres) *BundleReader {
 	}
 }
 
-func (b *BundleReader) Read(ctx context.Context, tenantID, name string) (bundle *base.DataBundle, err error) {
+func (b *BundleReader) Read(ctx context.Context, tenantID, name, version string) (bundle *base.DataBundle, v string, err error) {
 	ctx, span := tracer.Start(ctx, "bundle-reader.read-bundle")
 	defer span.End()
 
-	slog.DebugContext(ctx, "reading bundle", slog.Any("tenant_id", tenantID), slog.Any("name", name))
+	slog.DebugContext(ctx, "reading bundle", slog.Any("tenant_id", tenantID), slog.Any("name", name), slog.Any("version", version))
 
-	builder := b.database.Builder.Select("payload").From(BundlesTable).Where(squirrel.Eq{"name": name, "tenant_id": tenantID})
+	builder := b.database.Builder.Select("payload, version").From(BundlesTable).Where(squirrel.Eq{"name": name, "tenant_id": tenantID})
+	if version != "" {
+		builder = builder.Where(squirrel.Eq{"version": version})
+	}
+	builder = builder.OrderBy("version DESC").Limit(1)
 
 	var query string
 	var args []interface{}
 
 	query, args, err = builder.ToSql()
 	if err != nil {
-		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+		return nil, "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
 	}
 
 	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
@@ -51,12 +57,12 @@ func (b *BundleReader) Read(ctx context.Context, tenantID, name string) (bundle
 	row = b.database.ReadPool.QueryRow(ctx, query, args...)
 
 	var jsonData string
-	err = row.Scan(&jsonData)
+	err = row.Scan(&jsonData, &v)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String())
+			return nil, "", errors.New(base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String())
 		}
-		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+		return nil, "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
 	}
 
 	m := jsonpb.Unmarshaler{}
@@ -68,8 +74,89 @@ func (b *BundleReader) Read(ctx context.Context, tenantID, name string) (bundle
 
 		slog.ErrorContext(ctx, "failed to convert the value to bundle", slog.Any("error", err))
 
-		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+		return nil, "", errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+
+	return bundle, v, err
+}
+
+// List lists the latest version of every bundle of a tenant. If a name is given,
+// all versions of that bundle are listed, newest first.
+func (b *BundleReader) List(ctx context.Context, tenantID, name string, pagination database.Pagination) (bundles []*base.BundleList, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "bundle-reader.list-bundles")
+	defer span.End()
+
+	slog.DebugContext(ctx, "listing bundles with pagination", slog.Any("tenant_id", tenantID), slog.Any("name", name), slog.Any("pagination", pagination))
+
+	var builder squirrel.SelectBuilder
+	if name == "" {
+		builder = b.database.Builder.Select("DISTINCT ON (name) name, version, created_at").From(BundlesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	} else {
+		builder = b.database.Builder.Select("name, version, created_at").From(BundlesTable).Where(squirrel.Eq{"tenant_id": tenantID, "name": name})
+	}
+
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+		}
+		if name == "" {
+			builder = builder.Where(squirrel.GtOrEq{"name": t.(utils.ContinuousToken).Value})
+		} else {
+			builder = builder.Where(squirrel.LtOrEq{"version": t.(utils.ContinuousToken).Value})
+		}
+	}
+
+	if name == "" {
+		builder = builder.OrderBy("name", "version DESC")
+	} else {
+		builder = builder.OrderBy("version DESC")
+	}
+	builder = builder.Limit(uint64(pagination.PageSize() + 1))
+
+	var query string
+	var args []interface{}
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
+
+	var rows pgx.Rows
+	rows, err = b.database.ReadPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer rows.Close()
+
+	var last string
+	bundles = make([]*base.BundleList, 0, pagination.PageSize()+1)
+	for rows.Next() {
+		bl := &base.BundleList{}
+		var createdAt time.Time
+		err = rows.Scan(&bl.Name, &bl.Version, &createdAt)
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+		}
+		bl.CreatedAt = createdAt.String()
+		if name == "" {
+			last = bl.Name
+		} else {
+			last = bl.Version
+		}
+		bundles = append(bundles, bl)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
 	}
 
-	return bundle, err
+	slog.DebugContext(ctx, "successfully listed bundles", slog.Any("number_of_bundles", len(bundles)))
+
+	if len(bundles) > int(pagination.PageSize()) {
+		return bundles[:pagination.PageSize()], utils.NewContinuousToken(last).Encode(), nil
+	}
+	return bundles, database.NewNoopContinuousToken().Encode(), nil
 }
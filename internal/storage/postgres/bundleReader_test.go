@@ -79,7 +79,7 @@ var _ = Describe("BundleReader", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(names).Should(Equal([]string{"user_created"}))
 
-			bundle, err := bundleReader.Read(ctx, "t1", "user_created")
+			bundle, _, err := bundleReader.Read(ctx, "t1", "user_created", "")
 			Expect(err).ShouldNot(HaveOccurred())
 
 			Expect(bundle.GetName()).Should(Equal("user_created"))
@@ -107,8 +107,95 @@ var _ = Describe("BundleReader", func() {
 		It("should get error on non-existing bundle", func() {
 			ctx := context.Background()
 
-			_, err := bundleReader.Read(ctx, "t1", "user_created")
+			_, _, err := bundleReader.Read(ctx, "t1", "user_created", "")
 			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String()))
 		})
 	})
+
+	Context("Versions", func() {
+		It("should keep every written version and read them by version", func() {
+			ctx := context.Background()
+
+			first := &base.DataBundle{
+				Name:      "user_created",
+				Arguments: []string{"userID"},
+				Operations: []*base.Operation{
+					{RelationshipsWrite: []string{"organization:1#member@user:{{.userID}}"}},
+				},
+			}
+
+			second := &base.DataBundle{
+				Name:      "user_created",
+				Arguments: []string{"userID"},
+				Operations: []*base.Operation{
+					{RelationshipsWrite: []string{"organization:1#admin@user:{{.userID}}"}},
+				},
+			}
+
+			_, err := bundleWriter.Write(ctx, []storage.Bundle{{Name: first.Name, Version: "cs3a5cdh2ls3s5a7nh1g", DataBundle: first, TenantID: "t1"}})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = bundleWriter.Write(ctx, []storage.Bundle{{Name: second.Name, Version: "cs3a5cdh2ls3s5a7nh2g", DataBundle: second, TenantID: "t1"}})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			bundle, version, err := bundleReader.Read(ctx, "t1", "user_created", "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(version).Should(Equal("cs3a5cdh2ls3s5a7nh2g"))
+			Expect(bundle.GetOperations()[0].GetRelationshipsWrite()).Should(Equal([]string{"organization:1#admin@user:{{.userID}}"}))
+
+			bundle, version, err = bundleReader.Read(ctx, "t1", "user_created", "cs3a5cdh2ls3s5a7nh1g")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(version).Should(Equal("cs3a5cdh2ls3s5a7nh1g"))
+			Expect(bundle.GetOperations()[0].GetRelationshipsWrite()).Should(Equal([]string{"organization:1#member@user:{{.userID}}"}))
+
+			_, _, err = bundleReader.Read(ctx, "t1", "user_created", "cs3a5cdh2ls3s5a7nh3g")
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String()))
+		})
+	})
+
+	Context("List", func() {
+		It("should list the latest version of each bundle and the versions of a single bundle", func() {
+			ctx := context.Background()
+
+			var sBundles []storage.Bundle
+			for _, b := range []struct {
+				name    string
+				version string
+			}{
+				{"user_created", "cs3a5cdh2ls3s5a7nh1g"},
+				{"user_created", "cs3a5cdh2ls3s5a7nh2g"},
+				{"organization_created", "cs3a5cdh2ls3s5a7nh1g"},
+				{"user_deleted", "cs3a5cdh2ls3s5a7nh3g"},
+			} {
+				sBundles = append(sBundles, storage.Bundle{
+					Name:       b.name,
+					Version:    b.version,
+					DataBundle: &base.DataBundle{Name: b.name},
+					TenantID:   "t1",
+				})
+			}
+
+			_, err := bundleWriter.Write(ctx, sBundles)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			bundles, ct, err := bundleReader.List(ctx, "t1", "", database.NewPagination(database.Size(2), database.Token("")))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(bundles)).Should(Equal(2))
+			Expect(bundles[0].GetName()).Should(Equal("organization_created"))
+			Expect(bundles[1].GetName()).Should(Equal("user_created"))
+			Expect(bundles[1].GetVersion()).Should(Equal("cs3a5cdh2ls3s5a7nh2g"))
+
+			bundles, ct, err = bundleReader.List(ctx, "t1", "", database.NewPagination(database.Size(2), database.Token(ct.String())))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(bundles)).Should(Equal(1))
+			Expect(bundles[0].GetName()).Should(Equal("user_deleted"))
+			Expect(ct.String()).Should(Equal(""))
+
+			bundles, _, err = bundleReader.List(ctx, "t1", "user_created", database.NewPagination(database.Size(10), database.Token("")))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(bundles)).Should(Equal(2))
+			Expect(bundles[0].GetVersion()).Should(Equal("cs3a5cdh2ls3s5a7nh2g"))
+			Expect(bundles[1].GetVersion()).Should(Equal("cs3a5cdh2ls3s5a7nh1g"))
+		})
+	})
 })
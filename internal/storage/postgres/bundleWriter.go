@@ -35,8 +35,8 @@ func (b *BundleWriter) Write(ctx context.Context, bundles []storage.Bundle) (nam
 	slog.DebugContext(ctx, "writing bundles to the database", slog.Any("number_of_bundles", len(bundles)))
 
 	insertBuilder := b.database.Builder.Insert(BundlesTable).
-		Columns("name, payload, tenant_id").
-		Suffix("ON CONFLICT (name, tenant_id) DO UPDATE SET payload = EXCLUDED.payload")
+		Columns("name, version, payload, tenant_id").
+		Suffix("ON CONFLICT (name, tenant_id, version) DO UPDATE SET payload = EXCLUDED.payload")
 
 	for _, bundle := range bundles {
 
@@ -48,7 +48,7 @@ func (b *BundleWriter) Write(ctx context.Context, bundles []storage.Bundle) (nam
 			return names, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
 		}
 
-		insertBuilder = insertBuilder.Values(bundle.Name, jsonStr, bundle.TenantID)
+		insertBuilder = insertBuilder.Values(bundle.Name, bundle.Version, jsonStr, bundle.TenantID)
 	}
 
 	var query string
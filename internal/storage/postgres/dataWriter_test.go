@@ -465,7 +465,7 @@ var _ = Describe("DataWriter", func() {
 			})
 			Expect(err).ShouldNot(HaveOccurred())
 
-			dataBundle, err := bundleReader.Read(ctx, "t1", "user_created")
+			dataBundle, _, err := bundleReader.Read(ctx, "t1", "user_created", "")
 			Expect(err).ShouldNot(HaveOccurred())
 
 			token1, err := dataWriter.RunBundle(ctx, "t1", map[string]string{
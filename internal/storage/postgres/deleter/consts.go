@@ -0,0 +1,11 @@
+package deleter
+
+import (
+	"time"
+)
+
+const (
+	_defaultInterval  = 10 * time.Second
+	_defaultBatchSize = 10_000
+	_defaultTimeout   = 30 * time.Second
+)
@@ -0,0 +1,159 @@
+package deleter
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Permify/permify/internal/storage/postgres"
+	"github.com/Permify/permify/internal/storage/postgres/utils"
+	db "github.com/Permify/permify/pkg/database/postgres"
+)
+
+// tables lists the tables holding tenant data, in the order they are emptied.
+var tables = []string{
+	postgres.RelationTuplesTable,
+	postgres.AttributesTable,
+	postgres.SchemaDefinitionTable,
+	postgres.BundlesTable,
+	postgres.TransactionsTable,
+}
+
+// Deleter removes the data of tenants marked for deletion in bounded batches.
+// All progress is stored on the tenant row, so an interrupted deletion is
+// resumed by the next run, including after a restart.
+type Deleter struct {
+	// database is the database instance the tenants are deleted from.
+	database *db.Postgres
+	// interval is the duration between checks for tenants to delete.
+	interval time.Duration
+	// batchSize is the maximum number of rows removed by a single statement.
+	batchSize int
+	// timeout is the maximum time allowed for a single batch.
+	timeout time.Duration
+}
+
+// NewDeleter creates a new Deleter instance with the provided configuration.
+func NewDeleter(db *db.Postgres, opts ...Option) *Deleter {
+	d := &Deleter{
+		database:  db,
+		interval:  _defaultInterval,
+		batchSize: _defaultBatchSize,
+		timeout:   _defaultTimeout,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// Start resumes unfinished deletions and then checks for tenants to delete periodically.
+func (d *Deleter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop() // Ensure the ticker is stopped when the function exits.
+
+	for {
+		if err := d.Run(ctx); err != nil {
+			slog.Error("Tenant deletion failed:", slog.Any("error", err))
+		}
+
+		select {
+		case <-ticker.C: // Periodically look for tenants marked for deletion.
+		case <-ctx.Done():
+			return ctx.Err() // Return context error if cancellation is requested.
+		}
+	}
+}
+
+// Run deletes every tenant that is marked for deletion.
+func (d *Deleter) Run(ctx context.Context) error {
+	tenantIDs, err := d.pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, tenantID := range tenantIDs {
+		if err = d.Delete(ctx, tenantID); err != nil {
+			return err
+		}
+		slog.Info("Tenant deleted successfully", slog.Any("tenant_id", tenantID))
+	}
+
+	return nil
+}
+
+// Delete removes the data of a single tenant batch by batch and finally the tenant itself.
+func (d *Deleter) Delete(ctx context.Context, tenantID string) error {
+	for _, table := range tables {
+		for {
+			deleted, err := d.deleteBatch(ctx, tenantID, table)
+			if err != nil {
+				return err
+			}
+			if deleted < int64(d.batchSize) {
+				break
+			}
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	_, err := d.database.WritePool.Exec(ctx, utils.DeleteTenantTemplate, tenantID)
+	return err
+}
+
+// pending returns the identifiers of the tenants marked for deletion, oldest first.
+func (d *Deleter) pending(ctx context.Context) ([]string, error) {
+	query, args, err := d.database.Builder.
+		Select("id").
+		From(postgres.TenantsTable).
+		Where(squirrel.NotEq{"deletion_started_at": nil}).
+		OrderBy("deletion_started_at").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := d.database.ReadPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// deleteBatch removes at most batchSize rows of the tenant from the table and records the progress
+// on the tenant row within the same transaction.
+func (d *Deleter) deleteBatch(ctx context.Context, tenantID, table string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	tx, err := d.database.WritePool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, fmt.Sprintf(utils.DeleteBatchByTenantTemplate, table), tenantID, d.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = tx.Exec(ctx, utils.TenantDeletionProgressTemplate, tenantID, tag.RowsAffected(), table); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
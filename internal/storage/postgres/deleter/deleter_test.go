@@ -0,0 +1,145 @@
+package deleter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/storage/postgres"
+	"github.com/Permify/permify/internal/storage/postgres/instance"
+	"github.com/Permify/permify/pkg/database"
+	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestDeleter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "postgres-deleter-suite")
+}
+
+var _ = Describe("Deleter", func() {
+	var db database.Database
+	var ctx context.Context
+	var tenantDeleter *Deleter
+	var tenantWriter *postgres.TenantWriter
+	var tenantReader *postgres.TenantReader
+	var dataWriter *postgres.DataWriter
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		version := os.Getenv("POSTGRES_VERSION")
+		if version == "" {
+			version = "14"
+		}
+
+		db = instance.PostgresDB(version)
+		tenantDeleter = NewDeleter(
+			db.(*PQDatabase.Postgres),
+			BatchSize(2),
+		)
+
+		tenantWriter = postgres.NewTenantWriter(db.(*PQDatabase.Postgres))
+		tenantReader = postgres.NewTenantReader(db.(*PQDatabase.Postgres))
+		dataWriter = postgres.NewDataWriter(db.(*PQDatabase.Postgres))
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Run", func() {
+		It("should remove the data of tenants marked for deletion in batches", func() {
+			_, err := tenantWriter.CreateTenant(ctx, "deleted", "deleted tenant")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.CreateTenant(ctx, "kept", "kept tenant")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, tenantID := range []string{"deleted", "kept"} {
+				tuples := database.NewTupleCollection()
+				for i := 0; i < 5; i++ {
+					tup, err := tuple.Tuple(fmt.Sprintf("organization:%d#member@user:%d", i, i))
+					Expect(err).ShouldNot(HaveOccurred())
+					tuples.Add(tup)
+				}
+				_, err = dataWriter.Write(ctx, tenantID, tuples, database.NewAttributeCollection())
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			tenant, err := tenantWriter.DeleteTenant(ctx, "deleted")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetDeletion()).ShouldNot(BeNil())
+
+			// The tenant stays readable until its data has been removed
+			tenant, err = tenantReader.ReadTenant(ctx, "deleted")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetDeletion().GetDeletedRows()).Should(Equal(int64(0)))
+
+			// Listing hides tenants that are being deleted
+			tenants, _, err := tenantReader.ListTenants(ctx, &base.TenantFilter{}, database.NewPagination(database.Size(10), database.Token("")))
+			Expect(err).ShouldNot(HaveOccurred())
+			for _, t := range tenants {
+				Expect(t.GetId()).ShouldNot(Equal("deleted"))
+			}
+
+			err = tenantDeleter.Run(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantReader.ReadTenant(ctx, "deleted")
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+
+			var remaining int
+			err = db.(*PQDatabase.Postgres).ReadPool.QueryRow(ctx, "SELECT count(*) FROM relation_tuples WHERE tenant_id = $1", "deleted").Scan(&remaining)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(remaining).Should(Equal(0))
+
+			err = db.(*PQDatabase.Postgres).ReadPool.QueryRow(ctx, "SELECT count(*) FROM relation_tuples WHERE tenant_id = $1", "kept").Scan(&remaining)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(remaining).Should(Equal(5))
+		})
+
+		It("should resume an interrupted deletion", func() {
+			_, err := tenantWriter.CreateTenant(ctx, "deleted", "deleted tenant")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tuples := database.NewTupleCollection()
+			for i := 0; i < 5; i++ {
+				tup, err := tuple.Tuple(fmt.Sprintf("organization:%d#member@user:%d", i, i))
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples.Add(tup)
+			}
+			_, err = dataWriter.Write(ctx, "deleted", tuples, database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.DeleteTenant(ctx, "deleted")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// Simulate a deletion that stopped after the first batch
+			deleted, err := tenantDeleter.deleteBatch(ctx, "deleted", postgres.RelationTuplesTable)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(2)))
+
+			tenant, err := tenantReader.ReadTenant(ctx, "deleted")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetDeletion().GetDeletedRows()).Should(Equal(int64(2)))
+			Expect(tenant.GetDeletion().GetTable()).Should(Equal(postgres.RelationTuplesTable))
+
+			// Marking the tenant again keeps the recorded progress
+			tenant, err = tenantWriter.DeleteTenant(ctx, "deleted")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetDeletion().GetDeletedRows()).Should(Equal(int64(2)))
+
+			err = NewDeleter(db.(*PQDatabase.Postgres), BatchSize(2)).Run(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantReader.ReadTenant(ctx, "deleted")
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package deleter
+
+import (
+	"time"
+)
+
+// Option represents a function that configures a Deleter instance.
+type Option func(d *Deleter)
+
+// Interval is an option that sets the interval duration between checks for tenants to delete.
+func Interval(n time.Duration) Option {
+	return func(d *Deleter) {
+		d.interval = n
+	}
+}
+
+// BatchSize is an option that sets the maximum number of rows removed by a single statement.
+func BatchSize(n int) Option {
+	return func(d *Deleter) {
+		d.batchSize = n
+	}
+}
+
+// Timeout is an option that sets the timeout duration for a single batch.
+func Timeout(n time.Duration) Option {
+	return func(d *Deleter) {
+		d.timeout = n
+	}
+}
@@ -9,12 +9,18 @@ import (
 	"time"
 
 	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
 
 	"github.com/Permify/permify/internal/storage/postgres"
 	"github.com/Permify/permify/internal/storage/postgres/utils"
 	db "github.com/Permify/permify/pkg/database/postgres"
+	"github.com/Permify/permify/pkg/telemetry"
 )
 
+var meter = otel.Meter("storage.postgres.gc")
+
 // GC represents a Garbage Collector configuration for database cleanup.
 type GC struct {
 	// database is the database instance used for garbage collection.
@@ -25,6 +31,11 @@ type GC struct {
 	window time.Duration
 	// timeout is the maximum time allowed for a single GC run.
 	timeout time.Duration
+
+	// Metrics
+	runCounter         api.Int64Counter
+	deletedRowsCounter api.Int64Counter
+	durationHistogram  api.Int64Histogram
 }
 
 // NewGC creates a new GC instance with the provided configuration.
@@ -34,6 +45,10 @@ func NewGC(db *db.Postgres, opts ...Option) *GC {
 		window:   _defaultWindow,
 		timeout:  _defaultTimeout,
 		database: db,
+
+		runCounter:         telemetry.NewCounter(meter, "gc_runs", "Number of garbage collection runs"),
+		deletedRowsCounter: telemetry.NewCounter(meter, "gc_deleted_rows", "Number of rows deleted by garbage collection"),
+		durationHistogram:  telemetry.NewHistogram(meter, "gc_duration", "microseconds", "Duration of garbage collection runs in microseconds"),
 	}
 
 	// Custom options
@@ -65,13 +80,24 @@ func (gc *GC) Start(ctx context.Context) error {
 }
 
 // Run performs the garbage collection process.
-func (gc *GC) Run() error {
+func (gc *GC) Run() (err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), gc.timeout)
 	defer cancel()
 
+	// Record the status and duration of the run.
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "failure"
+		}
+		gc.runCounter.Add(context.Background(), 1, api.WithAttributes(attribute.String("status", status)))
+		gc.durationHistogram.Record(context.Background(), time.Since(start).Microseconds(), api.WithAttributes(attribute.String("status", status)))
+	}()
+
 	// Get the current time from the database timezone.
 	var dbNow time.Time
-	err := gc.database.WritePool.QueryRow(ctx, "SELECT NOW() AT TIME ZONE 'UTC'").Scan(&dbNow)
+	err = gc.database.WritePool.QueryRow(ctx, "SELECT NOW() AT TIME ZONE 'UTC'").Scan(&dbNow)
 	if err != nil {
 		slog.Error("Failed to get current time from the database:", slog.Any("error", err))
 		return err
@@ -143,8 +169,13 @@ func (gc *GC) deleteRecords(ctx context.Context, table string, lastTransactionID
 		return err
 	}
 
-	_, err = gc.database.WritePool.Exec(ctx, query, args...)
-	return err
+	tag, err := gc.database.WritePool.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	gc.deletedRowsCounter.Add(ctx, tag.RowsAffected(), api.WithAttributes(attribute.String("table", table)))
+	return nil
 }
 
 // deleteTransactions deletes transactions older than the provided lastTransactionID.
@@ -170,6 +201,11 @@ func (gc *GC) deleteTransactions(ctx context.Context, lastTransactionID uint64)
 	}
 
 	// Execute the DELETE query with the provided context.
-	_, err = gc.database.WritePool.Exec(ctx, query, args...)
-	return err
+	tag, err := gc.database.WritePool.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	gc.deletedRowsCounter.Add(ctx, tag.RowsAffected(), api.WithAttributes(attribute.String("table", postgres.TransactionsTable)))
+	return nil
 }
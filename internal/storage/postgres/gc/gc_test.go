@@ -211,7 +211,8 @@ func newSchema(tenant, model string) ([]storage.SchemaDefinition, string, error)
 		return nil, "", err
 	}
 
-	_, _, err = compiler.NewCompiler(false, sch).Compile()
+	c := compiler.NewCompiler(false, sch)
+	_, _, err = c.Compile()
 	if err != nil {
 		return nil, "", err
 	}
@@ -220,11 +221,15 @@ func newSchema(tenant, model string) ([]storage.SchemaDefinition, string, error)
 
 	cnf := make([]storage.SchemaDefinition, 0, len(sch.Statements))
 	for _, st := range sch.Statements {
+		serialized, err := c.Serialize(st)
+		if err != nil {
+			return nil, "", err
+		}
 		cnf = append(cnf, storage.SchemaDefinition{
 			TenantID:             tenant,
 			Version:              version,
 			Name:                 st.GetName(),
-			SerializedDefinition: []byte(st.String()),
+			SerializedDefinition: []byte(serialized),
 		})
 	}
 
@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE bundles ADD COLUMN IF NOT EXISTS version VARCHAR NOT NULL DEFAULT '';
+UPDATE bundles SET version = to_char(created_at, 'YYYYMMDDHH24MISSUS') WHERE version = '';
+ALTER TABLE bundles DROP CONSTRAINT IF EXISTS pk_bundle;
+ALTER TABLE bundles ADD CONSTRAINT pk_bundle PRIMARY KEY (name, tenant_id, version);
+CREATE INDEX IF NOT EXISTS idx_bundles_tenant_name_version ON bundles (tenant_id, name, version DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bundles_tenant_name_version;
+DELETE FROM bundles b USING bundles n
+WHERE b.tenant_id = n.tenant_id AND b.name = n.name AND b.version < n.version;
+ALTER TABLE bundles DROP CONSTRAINT IF EXISTS pk_bundle;
+ALTER TABLE bundles ADD CONSTRAINT pk_bundle PRIMARY KEY (name, tenant_id);
+ALTER TABLE bundles DROP COLUMN IF EXISTS version;
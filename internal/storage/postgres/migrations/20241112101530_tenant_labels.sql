@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS labels jsonb DEFAULT '{}'::jsonb NOT NULL;
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP NULL;
+CREATE INDEX IF NOT EXISTS idx_tenants_labels ON tenants USING GIN (labels);
+CREATE INDEX IF NOT EXISTS idx_tenants_deleted_at ON tenants (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tenants_deleted_at;
+DROP INDEX IF EXISTS idx_tenants_labels;
+ALTER TABLE tenants DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE tenants DROP COLUMN IF EXISTS labels;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS deletion_started_at TIMESTAMP NULL;
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS deletion_deleted_rows BIGINT DEFAULT 0 NOT NULL;
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS deletion_table VARCHAR DEFAULT '' NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_tenants_deletion_started_at ON tenants (deletion_started_at) WHERE deletion_started_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_tenant_id ON transactions (tenant_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_tenant_id;
+DROP INDEX IF EXISTS idx_tenants_deletion_started_at;
+ALTER TABLE tenants DROP COLUMN IF EXISTS deletion_table;
+ALTER TABLE tenants DROP COLUMN IF EXISTS deletion_deleted_rows;
+ALTER TABLE tenants DROP COLUMN IF EXISTS deletion_started_at;
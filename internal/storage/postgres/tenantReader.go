@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"log/slog"
 
 	"github.com/jackc/pgx/v5"
@@ -29,14 +31,58 @@ func NewTenantReader(database *db.Postgres) *TenantReader {
 	}
 }
 
-// ListTenants - Lists all Tenants
-func (r *TenantReader) ListTenants(ctx context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error) {
+// ReadTenant - Reads a single Tenant, including soft deleted ones
+func (r *TenantReader) ReadTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-reader.read-tenant")
+	defer span.End()
+
+	slog.DebugContext(ctx, "reading tenant", slog.Any("tenant_id", tenantID))
+
+	builder := r.database.Builder.Select("id, name, labels, created_at, deleted_at, deletion_started_at, deletion_deleted_rows, deletion_table").From(TenantsTable).Where(squirrel.Eq{"id": tenantID})
+
+	var query string
+	var args []interface{}
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
+
+	sd := storage.Tenant{}
+	err = r.database.ReadPool.QueryRow(ctx, query, args...).Scan(&sd.ID, &sd.Name, &sd.Labels, &sd.CreatedAt, &sd.DeletedAt, &sd.DeletionStartedAt, &sd.DeletedRows, &sd.DeletionTable)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+		}
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+
+	slog.DebugContext(ctx, "successfully read tenant", slog.Any("tenant_id", tenantID))
+
+	return sd.ToTenant(), nil
+}
+
+// ListTenants - Lists all Tenants matching the filter
+func (r *TenantReader) ListTenants(ctx context.Context, filter *base.TenantFilter, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error) {
 	ctx, span := tracer.Start(ctx, "tenant-reader.list-tenants")
 	defer span.End()
 
-	slog.DebugContext(ctx, "listing tenants with pagination", slog.Any("pagination", pagination))
+	slog.DebugContext(ctx, "listing tenants with pagination", slog.Any("filter", filter), slog.Any("pagination", pagination))
 
-	builder := r.database.Builder.Select("id, name, created_at").From(TenantsTable)
+	builder := r.database.Builder.Select("id, name, labels, created_at, deleted_at, deletion_started_at, deletion_deleted_rows, deletion_table").From(TenantsTable)
+	if !filter.GetIncludeDeleted() {
+		builder = builder.Where(squirrel.Eq{"deleted_at": nil})
+	}
+	if len(filter.GetLabels()) > 0 {
+		var labels []byte
+		labels, err = json.Marshal(filter.GetLabels())
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
+		}
+		builder = builder.Where(squirrel.Expr("labels @> ?::jsonb", string(labels)))
+	}
 	if pagination.Token() != "" {
 		var t database.ContinuousToken
 		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
@@ -69,7 +115,7 @@ func (r *TenantReader) ListTenants(ctx context.Context, pagination database.Pagi
 	tenants = make([]*base.Tenant, 0, pagination.PageSize()+1)
 	for rows.Next() {
 		sd := storage.Tenant{}
-		err = rows.Scan(&sd.ID, &sd.Name, &sd.CreatedAt)
+		err = rows.Scan(&sd.ID, &sd.Name, &sd.Labels, &sd.CreatedAt, &sd.DeletedAt, &sd.DeletionStartedAt, &sd.DeletedRows, &sd.DeletionTable)
 		if err != nil {
 			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/Permify/permify/internal/storage/postgres/instance"
 	"github.com/Permify/permify/pkg/database"
 	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
 var _ = Describe("TenantReader", func() {
@@ -56,14 +57,90 @@ var _ = Describe("TenantReader", func() {
 			_, err = tenantWriter.CreateTenant(ctx, "test_id_6", "test name 6")
 			Expect(err).ShouldNot(HaveOccurred())
 
-			col1, ct1, err := tenantReader.ListTenants(ctx, database.NewPagination(database.Size(3), database.Token("")))
+			col1, ct1, err := tenantReader.ListTenants(ctx, &base.TenantFilter{}, database.NewPagination(database.Size(3), database.Token("")))
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(len(col1)).Should(Equal(3))
 
-			col2, ct2, err := tenantReader.ListTenants(ctx, database.NewPagination(database.Size(4), database.Token(ct1.String())))
+			col2, ct2, err := tenantReader.ListTenants(ctx, &base.TenantFilter{}, database.NewPagination(database.Size(4), database.Token(ct1.String())))
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(len(col2)).Should(Equal(4))
 			Expect(ct2.String()).Should(Equal(""))
 		})
 	})
+
+	Context("Read Tenant", func() {
+		It("should read tenant", func() {
+			ctx := context.Background()
+
+			_, err := tenantWriter.CreateTenant(ctx, "test_id_1", "test name 1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tenant, err := tenantReader.ReadTenant(ctx, "test_id_1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetId()).Should(Equal("test_id_1"))
+			Expect(tenant.GetName()).Should(Equal("test name 1"))
+			Expect(tenant.GetDeletedAt()).Should(BeNil())
+		})
+
+		It("should read soft deleted tenant", func() {
+			ctx := context.Background()
+
+			_, err := tenantWriter.CreateTenant(ctx, "test_id_1", "test name 1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.SoftDeleteTenant(ctx, "test_id_1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tenant, err := tenantReader.ReadTenant(ctx, "test_id_1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetDeletedAt()).ShouldNot(BeNil())
+		})
+
+		It("should get tenant not found error", func() {
+			ctx := context.Background()
+
+			_, err := tenantReader.ReadTenant(ctx, "unknown")
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+		})
+	})
+
+	Context("List Tenants With Filter", func() {
+		It("should filter tenants by labels and deletion state", func() {
+			ctx := context.Background()
+
+			_, err := tenantWriter.CreateTenant(ctx, "test_id_1", "test name 1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.CreateTenant(ctx, "test_id_2", "test name 2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.CreateTenant(ctx, "test_id_3", "test name 3")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.UpdateTenant(ctx, "test_id_1", "", map[string]string{"plan": "enterprise", "region": "eu"}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.UpdateTenant(ctx, "test_id_2", "", map[string]string{"plan": "enterprise"}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.UpdateTenant(ctx, "test_id_3", "", map[string]string{"plan": "enterprise"}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.SoftDeleteTenant(ctx, "test_id_3")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			col1, _, err := tenantReader.ListTenants(ctx, &base.TenantFilter{Labels: map[string]string{"plan": "enterprise"}}, database.NewPagination(database.Size(10), database.Token("")))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(col1)).Should(Equal(2))
+
+			col2, _, err := tenantReader.ListTenants(ctx, &base.TenantFilter{Labels: map[string]string{"plan": "enterprise", "region": "eu"}}, database.NewPagination(database.Size(10), database.Token("")))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(col2)).Should(Equal(1))
+			Expect(col2[0].GetId()).Should(Equal("test_id_1"))
+
+			col3, _, err := tenantReader.ListTenants(ctx, &base.TenantFilter{Labels: map[string]string{"plan": "enterprise"}, IncludeDeleted: true}, database.NewPagination(database.Size(10), database.Token("")))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(col3)).Should(Equal(3))
+		})
+	})
 })
@@ -2,8 +2,8 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
-	"fmt"
 	"log/slog"
 	"strings"
 	"time"
@@ -14,6 +14,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/postgres/utils"
 	db "github.com/Permify/permify/pkg/database/postgres"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
@@ -62,76 +63,87 @@ func (w *TenantWriter) CreateTenant(ctx context.Context, id, name string) (resul
 	}, nil
 }
 
-// DeleteTenant - Deletes a Tenant
-func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (result *base.Tenant, err error) {
-	ctx, span := tracer.Start(ctx, "tenant-writer.delete-tenant")
+// UpdateTenant - Renames a Tenant and changes its labels
+func (w *TenantWriter) UpdateTenant(ctx context.Context, tenantID, name string, labels map[string]string, removeLabels []string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.update-tenant")
 	defer span.End()
 
-	slog.DebugContext(ctx, "deleting tenant", slog.Any("tenant_id", tenantID))
+	slog.DebugContext(ctx, "updating tenant", slog.Any("tenant_id", tenantID), slog.Any("name", name), slog.Any("labels", labels), slog.Any("remove_labels", removeLabels))
 
-	tx, err := w.database.WritePool.Begin(ctx)
-	if err != nil {
-		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	if labels == nil {
+		labels = map[string]string{}
 	}
-	defer tx.Rollback(ctx)
-
-	// Prepare batch operations for deleting tenant-related records from multiple tables
-	tables := []string{"bundles", "relation_tuples", "attributes", "schema_definitions", "transactions"}
-	batch := &pgx.Batch{}
-	var totalDeleted int64
-	for _, table := range tables {
-		query := fmt.Sprintf(utils.DeleteAllByTenantTemplate, table)
-		batch.Queue(query, tenantID)
-	}
-	batch.Queue(utils.DeleteTenantTemplate, tenantID)
-
-	// Execute the batch of delete queries
-	br := tx.SendBatch(ctx, batch)
-
-	for i := 0; i < len(tables); i++ {
-		tag, err := br.Exec()
-		if err != nil {
-			err = br.Close()
-			if err != nil {
-				return nil, err
-			}
-			err = tx.Commit(ctx)
-			if err != nil {
-				return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
-			}
-			return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
-		} else {
-			totalDeleted += tag.RowsAffected()
-		}
+	if removeLabels == nil {
+		removeLabels = []string{}
 	}
 
-	// Retrieve the tenant details after deletion
-	var name string
-	var createdAt time.Time
-	err = br.QueryRow().Scan(&name, &createdAt)
+	var encoded []byte
+	encoded, err = json.Marshal(labels)
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
+	}
 
+	tenant := storage.Tenant{ID: tenantID}
+	err = w.database.WritePool.QueryRow(ctx, utils.UpdateTenantTemplate, tenantID, name, string(encoded), removeLabels).Scan(&tenant.Name, &tenant.Labels, &tenant.CreatedAt)
 	if err != nil {
-		if totalDeleted > 0 {
-			name = fmt.Sprintf("Affected rows: %d", totalDeleted)
-		} else {
-			return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
 		}
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
 	}
 
-	err = br.Close()
+	slog.DebugContext(ctx, "successfully updated tenant", slog.Any("tenant_id", tenantID))
+
+	return tenant.ToTenant(), nil
+}
+
+// SoftDeleteTenant - Marks a Tenant as deleted, its data is kept until DeleteTenant is called
+func (w *TenantWriter) SoftDeleteTenant(ctx context.Context, tenantID string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.soft-delete-tenant")
+	defer span.End()
+
+	slog.DebugContext(ctx, "soft deleting tenant", slog.Any("tenant_id", tenantID))
+
+	tenant := storage.Tenant{ID: tenantID}
+	err = w.database.WritePool.QueryRow(ctx, utils.SoftDeleteTenantTemplate, tenantID).Scan(&tenant.Name, &tenant.Labels, &tenant.CreatedAt, &tenant.DeletedAt)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+		}
 		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
 	}
 
-	err = tx.Commit(ctx)
+	slog.DebugContext(ctx, "successfully soft deleted tenant", slog.Any("tenant_id", tenantID), slog.Any("deleted_at", tenant.DeletedAt))
+
+	return tenant.ToTenant(), nil
+}
+
+// DeleteTenant - Marks a Tenant for deletion. Its relationships, attributes, schemas, bundles and
+// transactions are removed in batches by the tenant deleter, which finally removes the tenant itself.
+func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.delete-tenant")
+	defer span.End()
+
+	slog.DebugContext(ctx, "marking tenant for deletion", slog.Any("tenant_id", tenantID))
+
+	tenant := storage.Tenant{ID: tenantID}
+	err = w.database.WritePool.QueryRow(ctx, utils.MarkTenantDeletionTemplate, tenantID).Scan(
+		&tenant.Name,
+		&tenant.Labels,
+		&tenant.CreatedAt,
+		&tenant.DeletedAt,
+		&tenant.DeletionStartedAt,
+		&tenant.DeletedRows,
+		&tenant.DeletionTable,
+	)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+		}
 		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
 	}
 
-	// Return the deleted tenant information
-	return &base.Tenant{
-		Id:        tenantID,
-		Name:      name,
-		CreatedAt: timestamppb.New(createdAt),
-	}, nil
+	slog.DebugContext(ctx, "successfully marked tenant for deletion", slog.Any("tenant_id", tenantID), slog.Any("deletion_started_at", tenant.DeletionStartedAt))
+
+	return tenant.ToTenant(), nil
 }
@@ -20,10 +20,14 @@ import (
 )
 
 const (
-	TransactionTemplate       = `INSERT INTO transactions (tenant_id) VALUES ($1) RETURNING id`
-	InsertTenantTemplate      = `INSERT INTO tenants (id, name) VALUES ($1, $2) RETURNING created_at`
-	DeleteTenantTemplate      = `DELETE FROM tenants WHERE id = $1 RETURNING name, created_at`
-	DeleteAllByTenantTemplate = `DELETE FROM %s WHERE tenant_id = $1`
+	TransactionTemplate            = `INSERT INTO transactions (tenant_id) VALUES ($1) RETURNING id`
+	InsertTenantTemplate           = `INSERT INTO tenants (id, name) VALUES ($1, $2) RETURNING created_at`
+	UpdateTenantTemplate           = `UPDATE tenants SET name = COALESCE(NULLIF($2::varchar, ''), name), labels = (labels || $3::jsonb) - $4::text[] WHERE id = $1 AND deleted_at IS NULL RETURNING name, labels, created_at`
+	SoftDeleteTenantTemplate       = `UPDATE tenants SET deleted_at = (now() AT TIME ZONE 'UTC') WHERE id = $1 AND deleted_at IS NULL RETURNING name, labels, created_at, deleted_at`
+	MarkTenantDeletionTemplate     = `UPDATE tenants SET deleted_at = COALESCE(deleted_at, (now() AT TIME ZONE 'UTC')), deletion_started_at = COALESCE(deletion_started_at, (now() AT TIME ZONE 'UTC')) WHERE id = $1 RETURNING name, labels, created_at, deleted_at, deletion_started_at, deletion_deleted_rows, deletion_table`
+	TenantDeletionProgressTemplate = `UPDATE tenants SET deletion_deleted_rows = deletion_deleted_rows + $2, deletion_table = $3 WHERE id = $1`
+	DeleteTenantTemplate           = `DELETE FROM tenants WHERE id = $1 AND deletion_started_at IS NOT NULL`
+	DeleteBatchByTenantTemplate    = `DELETE FROM %[1]s WHERE ctid IN (SELECT ctid FROM %[1]s WHERE tenant_id = $1 LIMIT $2)`
 )
 
 // SnapshotQuery adds conditions to a SELECT query for checking transaction visibility based on created and expired transaction IDs.
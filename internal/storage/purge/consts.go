@@ -0,0 +1,12 @@
+package purge
+
+import (
+	"time"
+)
+
+const (
+	_defaultInterval  = time.Hour
+	_defaultRetention = 0
+	_defaultTimeout   = 5 * time.Minute
+	_defaultPageSize  = 100
+)
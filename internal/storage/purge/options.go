@@ -0,0 +1,29 @@
+package purge
+
+import (
+	"time"
+)
+
+// Option represents a function that configures a Purger instance.
+type Option func(p *Purger)
+
+// Interval is an option that sets the interval duration between purge runs.
+func Interval(n time.Duration) Option {
+	return func(p *Purger) {
+		p.interval = n
+	}
+}
+
+// Retention is an option that sets how long soft deleted tenants are kept before they are purged.
+func Retention(n time.Duration) Option {
+	return func(p *Purger) {
+		p.retention = n
+	}
+}
+
+// Timeout is an option that sets the timeout duration for a single purge run.
+func Timeout(n time.Duration) Option {
+	return func(p *Purger) {
+		p.timeout = n
+	}
+}
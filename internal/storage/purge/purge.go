@@ -0,0 +1,116 @@
+package purge
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Purger permanently deletes soft deleted tenants once their retention has elapsed.
+type Purger struct {
+	// tr is used to find soft deleted tenants.
+	tr storage.TenantReader
+	// tw is used to delete the tenants together with all of their data.
+	tw storage.TenantWriter
+	// interval is the duration between purge runs.
+	interval time.Duration
+	// retention is how long a soft deleted tenant is kept before it is purged.
+	retention time.Duration
+	// timeout is the maximum time allowed for a single purge run.
+	timeout time.Duration
+}
+
+// NewPurger creates a new Purger instance with the provided configuration.
+func NewPurger(tr storage.TenantReader, tw storage.TenantWriter, opts ...Option) *Purger {
+	p := &Purger{
+		tr:        tr,
+		tw:        tw,
+		interval:  _defaultInterval,
+		retention: _defaultRetention,
+		timeout:   _defaultTimeout,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Start initiates the purge process periodically.
+func (p *Purger) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop() // Ensure the ticker is stopped when the function exits.
+
+	for {
+		select {
+		case <-ticker.C: // Periodically trigger the purge.
+			purged, err := p.Run(ctx)
+			if err != nil {
+				slog.Error("Tenant purge failed:", slog.Any("error", err))
+				continue
+			}
+			if len(purged) > 0 {
+				slog.Info("Tenant purge completed successfully", slog.Any("tenants", purged))
+			}
+		case <-ctx.Done():
+			return ctx.Err() // Return context error if cancellation is requested.
+		}
+	}
+}
+
+// Run deletes every soft deleted tenant whose retention has elapsed and returns their identifiers.
+func (p *Purger) Run(ctx context.Context) (purged []string, err error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	expired, err := p.expired(ctx, time.Now().Add(-p.retention))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range expired {
+		if _, err = p.tw.DeleteTenant(ctx, id); err != nil {
+			return purged, err
+		}
+		purged = append(purged, id)
+	}
+
+	return purged, nil
+}
+
+// expired collects the identifiers of the tenants that were soft deleted before the cutoff.
+// Tenants are collected before any of them is deleted so that pagination is not disturbed.
+func (p *Purger) expired(ctx context.Context, cutoff time.Time) (ids []string, err error) {
+	filter := &base.TenantFilter{IncludeDeleted: true}
+
+	token := ""
+	for {
+		var tenants []*base.Tenant
+		var ct database.EncodedContinuousToken
+		tenants, ct, err = p.tr.ListTenants(ctx, filter, database.NewPagination(database.Size(_defaultPageSize), database.Token(token)))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tenant := range tenants {
+			// Tenants that are already being deleted are skipped
+			if tenant.GetDeletion() != nil {
+				continue
+			}
+			if tenant.GetDeletedAt() != nil && !tenant.GetDeletedAt().AsTime().After(cutoff) {
+				ids = append(ids, tenant.GetId())
+			}
+		}
+
+		token = ct.String()
+		if token == "" {
+			return ids, nil
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package purge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rs/xid"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/memory"
+	"github.com/Permify/permify/internal/storage/memory/migrations"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	MMDatabase "github.com/Permify/permify/pkg/database/memory"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestPurge(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "purge-suite")
+}
+
+var _ = Describe("Purger", func() {
+	var db *MMDatabase.Memory
+	var ctx context.Context
+	var tenantReader storage.TenantReader
+	var tenantWriter storage.TenantWriter
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+
+		db, err = MMDatabase.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		tenantReader = memory.NewTenantReader(db)
+		tenantWriter = memory.NewTenantWriter(db)
+	})
+
+	AfterEach(func() {
+		err := db.Close()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Context("Run", func() {
+		It("should purge soft deleted tenants whose retention has elapsed", func() {
+			_, err := tenantWriter.CreateTenant(ctx, "active", "active tenant")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.CreateTenant(ctx, "deleted", "deleted tenant")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = tenantWriter.SoftDeleteTenant(ctx, "deleted")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			purged, err := NewPurger(tenantReader, tenantWriter, Retention(time.Hour)).Run(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(purged).Should(BeEmpty())
+
+			_, err = tenantReader.ReadTenant(ctx, "deleted")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			purged, err = NewPurger(tenantReader, tenantWriter, Retention(0)).Run(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(purged).Should(Equal([]string{"deleted"}))
+
+			_, err = tenantReader.ReadTenant(ctx, "deleted")
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+
+			_, err = tenantReader.ReadTenant(ctx, "active")
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should delete the relationships, attributes, schemas and bundles of the purged tenants", func() {
+			dataWriter := memory.NewDataWriter(db)
+			dataReader := memory.NewDataReader(db)
+			schemaWriter := memory.NewSchemaWriter(db)
+			schemaReader := memory.NewSchemaReader(db)
+			bundleWriter := memory.NewBundleWriter(db)
+			bundleReader := memory.NewBundleReader(db)
+
+			tup, err := tuple.Tuple("document:1#viewer@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			attr, err := attribute.Attribute("document:1$public|boolean:true")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, id := range []string{"active", "deleted"} {
+				_, err = tenantWriter.CreateTenant(ctx, id, id+" tenant")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = dataWriter.Write(ctx, id, database.NewTupleCollection(tup), database.NewAttributeCollection(attr))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				err = schemaWriter.WriteSchema(ctx, []storage.SchemaDefinition{
+					{TenantID: id, Name: "document", SerializedDefinition: []byte("entity document {}"), Version: xid.New().String()},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = bundleWriter.Write(ctx, []storage.Bundle{
+					{TenantID: id, Name: "document_created", DataBundle: &base.DataBundle{Name: "document_created"}},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			_, err = tenantWriter.SoftDeleteTenant(ctx, "deleted")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			purged, err := NewPurger(tenantReader, tenantWriter, Retention(0)).Run(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(purged).Should(Equal([]string{"deleted"}))
+
+			// the data of the purged tenant is gone and the data of the active tenant is kept
+			for id, length := range map[string]int{"deleted": 0, "active": 1} {
+				tuples, _, err := dataReader.ReadRelationships(ctx, id, &base.TupleFilter{Entity: &base.EntityFilter{Type: "document"}}, "", database.NewPagination(database.Size(10)))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(tuples.GetTuples()).Should(HaveLen(length))
+
+				attributes, _, err := dataReader.ReadAttributes(ctx, id, &base.AttributeFilter{Entity: &base.EntityFilter{Type: "document"}}, "", database.NewPagination(database.Size(10)))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(attributes.GetAttributes()).Should(HaveLen(length))
+
+				schemas, _, err := schemaReader.ListSchemas(ctx, id, database.NewPagination(database.Size(10)))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(schemas).Should(HaveLen(length))
+
+				bundles, _, err := bundleReader.List(ctx, id, "", database.NewPagination(database.Size(10)))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(bundles).Should(HaveLen(length))
+			}
+
+			_, err = schemaReader.HeadVersion(ctx, "deleted")
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+		})
+	})
+})
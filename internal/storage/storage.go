@@ -169,8 +169,10 @@ func (n *NoopSchemaWriter) WriteSchema(_ context.Context, _ []SchemaDefinition)
 
 // BundleReader - Reads data bundles from storage.
 type BundleReader interface {
-	// Read retrieves a data bundle based on tenant ID and name.
-	Read(ctx context.Context, tenantID, name string) (bundle *base.DataBundle, err error)
+	// Read retrieves a data bundle based on tenant ID, name and version. An empty version reads the latest one.
+	Read(ctx context.Context, tenantID, name, version string) (bundle *base.DataBundle, v string, err error)
+	// List lists the latest version of every bundle of a tenant, or all versions of a bundle if a name is given.
+	List(ctx context.Context, tenantID, name string, pagination database.Pagination) (bundles []*base.BundleList, ct database.EncodedContinuousToken, err error)
 }
 
 type NoopBundleReader struct{}
@@ -179,8 +181,12 @@ func NewNoopBundleReader() BundleReader {
 	return &NoopBundleReader{}
 }
 
-func (n *NoopBundleReader) Read(_ context.Context, _, _ string) (*base.DataBundle, error) {
-	return nil, nil
+func (n *NoopBundleReader) Read(_ context.Context, _, _, _ string) (*base.DataBundle, string, error) {
+	return nil, "", nil
+}
+
+func (n *NoopBundleReader) List(_ context.Context, _, _ string, _ database.Pagination) ([]*base.BundleList, database.EncodedContinuousToken, error) {
+	return []*base.BundleList{}, database.NewNoopContinuousToken().Encode(), nil
 }
 
 // BundleWriter - Manages writing and deletion of data bundles.
@@ -188,7 +194,7 @@ type BundleWriter interface {
 	// Write stores bundles in storage for a tenant.
 	Write(ctx context.Context, bundles []Bundle) (names []string, err error)
 
-	// Delete removes a bundle from storage for a tenant.
+	// Delete removes all versions of a bundle from storage for a tenant.
 	Delete(ctx context.Context, tenantID, name string) (err error)
 }
 
@@ -232,8 +238,10 @@ func (n *NoopWatcher) Watch(_ context.Context, _, _ string) (<-chan *base.DataCh
 
 // TenantReader - Reads tenants from the storage.
 type TenantReader interface {
-	// ListTenants reads tenants from the storage.
-	ListTenants(ctx context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error)
+	// ReadTenant reads a single tenant, including soft deleted ones, from the storage.
+	ReadTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error)
+	// ListTenants reads tenants matching the filter from the storage.
+	ListTenants(ctx context.Context, filter *base.TenantFilter, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error)
 }
 
 type NoopTenantReader struct{}
@@ -242,7 +250,11 @@ func NewNoopTenantReader() TenantReader {
 	return &NoopTenantReader{}
 }
 
-func (n *NoopTenantReader) ListTenants(_ context.Context, _ database.Pagination) ([]*base.Tenant, database.EncodedContinuousToken, error) {
+func (n *NoopTenantReader) ReadTenant(_ context.Context, _ string) (*base.Tenant, error) {
+	return &base.Tenant{}, nil
+}
+
+func (n *NoopTenantReader) ListTenants(_ context.Context, _ *base.TenantFilter, _ database.Pagination) ([]*base.Tenant, database.EncodedContinuousToken, error) {
 	return []*base.Tenant{}, database.NewNoopContinuousToken().Encode(), nil
 }
 
@@ -250,7 +262,11 @@ func (n *NoopTenantReader) ListTenants(_ context.Context, _ database.Pagination)
 type TenantWriter interface {
 	// CreateTenant writes tenant to the storage.
 	CreateTenant(ctx context.Context, id, name string) (tenant *base.Tenant, err error)
-	// DeleteTenant deletes tenant from the storage.
+	// UpdateTenant renames the tenant if name is not empty, sets the given labels and removes the labels listed in removeLabels.
+	UpdateTenant(ctx context.Context, tenantID, name string, labels map[string]string, removeLabels []string) (tenant *base.Tenant, err error)
+	// SoftDeleteTenant marks the tenant as deleted without removing any of its data.
+	SoftDeleteTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error)
+	// DeleteTenant deletes tenant and all of its tuples, attributes, schemas and bundles from the storage.
 	DeleteTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error)
 }
 
@@ -264,6 +280,14 @@ func (n *NoopTenantWriter) CreateTenant(_ context.Context, _, _ string) (*base.T
 	return &base.Tenant{}, nil
 }
 
+func (n *NoopTenantWriter) UpdateTenant(_ context.Context, _, _ string, _ map[string]string, _ []string) (*base.Tenant, error) {
+	return &base.Tenant{}, nil
+}
+
+func (n *NoopTenantWriter) SoftDeleteTenant(_ context.Context, _ string) (*base.Tenant, error) {
+	return &base.Tenant{}, nil
+}
+
 func (n *NoopTenantWriter) DeleteTenant(_ context.Context, _ string) (*base.Tenant, error) {
 	return &base.Tenant{}, nil
 }
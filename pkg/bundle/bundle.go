@@ -156,3 +156,47 @@ func Operation(arguments map[string]string, operation *base.Operation) (tb datab
 
 	return tb, ab, nil
 }
+
+// DryRun evaluates every operation of the bundle with the given arguments and returns the
+// resulting data changes in the order they would be applied, without touching the storage.
+func DryRun(arguments map[string]string, b *base.DataBundle) (changes []*base.DataChange, err error) {
+	changes = make([]*base.DataChange, 0)
+
+	for _, op := range b.GetOperations() {
+		tb, ab, err := Operation(arguments, op)
+		if err != nil {
+			return nil, err
+		}
+
+		// Writes are applied before deletes, mirroring the storage implementations.
+		for _, t := range tb.Write.GetTuples() {
+			changes = append(changes, &base.DataChange{
+				Operation: base.DataChange_OPERATION_CREATE,
+				Type:      &base.DataChange_Tuple{Tuple: t},
+			})
+		}
+
+		for _, a := range ab.Write.GetAttributes() {
+			changes = append(changes, &base.DataChange{
+				Operation: base.DataChange_OPERATION_CREATE,
+				Type:      &base.DataChange_Attribute{Attribute: a},
+			})
+		}
+
+		for _, t := range tb.Delete.GetTuples() {
+			changes = append(changes, &base.DataChange{
+				Operation: base.DataChange_OPERATION_DELETE,
+				Type:      &base.DataChange_Tuple{Tuple: t},
+			})
+		}
+
+		for _, a := range ab.Delete.GetAttributes() {
+			changes = append(changes, &base.DataChange{
+				Operation: base.DataChange_OPERATION_DELETE,
+				Type:      &base.DataChange_Attribute{Attribute: a},
+			})
+		}
+	}
+
+	return changes, nil
+}
@@ -199,4 +199,57 @@ var _ = Describe("bundle", func() {
 			}
 		})
 	})
+
+	Context("DryRun", func() {
+		It("should return the data changes of every operation in order", func() {
+			b := &base.DataBundle{
+				Name:      "organization_created",
+				Arguments: []string{"organizationID", "userID"},
+				Operations: []*base.Operation{
+					{
+						RelationshipsWrite: []string{
+							"organization:{{.organizationID}}#admin@user:{{.userID}}",
+						},
+						AttributesWrite: []string{
+							"organization:{{.organizationID}}$public|boolean:true",
+						},
+					},
+					{
+						RelationshipsDelete: []string{
+							"organization:{{.organizationID}}#member@user:{{.userID}}",
+						},
+					},
+				},
+			}
+
+			changes, err := DryRun(map[string]string{"organizationID": "1", "userID": "2"}, b)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(changes)).Should(Equal(3))
+
+			Expect(changes[0].GetOperation()).Should(Equal(base.DataChange_OPERATION_CREATE))
+			Expect(changes[0].GetTuple()).Should(Equal(&base.Tuple{
+				Entity:   &base.Entity{Type: "organization", Id: "1"},
+				Relation: "admin",
+				Subject:  &base.Subject{Type: "user", Id: "2"},
+			}))
+
+			Expect(changes[1].GetOperation()).Should(Equal(base.DataChange_OPERATION_CREATE))
+			Expect(changes[1].GetAttribute().GetEntity()).Should(Equal(&base.Entity{Type: "organization", Id: "1"}))
+			Expect(changes[1].GetAttribute().GetAttribute()).Should(Equal("public"))
+
+			Expect(changes[2].GetOperation()).Should(Equal(base.DataChange_OPERATION_DELETE))
+			Expect(changes[2].GetTuple().GetRelation()).Should(Equal("member"))
+		})
+
+		It("should fail on invalid operations", func() {
+			b := &base.DataBundle{
+				Operations: []*base.Operation{
+					{RelationshipsWrite: []string{"organization:{{.organizationID}}#admin"}},
+				},
+			}
+
+			_, err := DryRun(map[string]string{"organizationID": "1"}, b)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
 })
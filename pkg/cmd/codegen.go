@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/Permify/permify/pkg/cmd/flags"
+	"github.com/Permify/permify/pkg/codegen"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+)
+
+// NewCodegenCommand - creates a new codegen command
+func NewCodegenCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "codegen <file>",
+		Short: "generate typed client code for the entities, relations, permissions, attributes and rule context fields of a schema or of the schema of a shape file",
+		RunE:  generate(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	f := command.Flags()
+	f.String("lang", "go", fmt.Sprintf("the language of the generated code, one of %s", strings.Join(codegen.Languages, ", ")))
+	f.String("package", "permissions", "the package of the generated code")
+	f.String("base-import", codegen.DefaultBaseImport, "the import path of the protobuf messages of the Permify API, such as github.com/Permify/permify-go/generated/base/v1")
+	f.StringP("output", "o", "", "the file to write the generated code to instead of printing it")
+
+	// register flags for codegen
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterCodegenFlags(f)
+	}
+
+	return command
+}
+
+// generate - compiles the schema of the given file and generates the code for it
+func generate() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		sch, err := readSchema(args[0])
+		if err != nil {
+			return err
+		}
+
+		entities, rules, err := compiler.NewCompiler(true, sch).Compile()
+		if err != nil {
+			return err
+		}
+
+		code, err := codegen.Generate(viper.GetString("lang"), entities, rules, codegen.Options{
+			Package:    viper.GetString("package"),
+			BaseImport: viper.GetString("base-import"),
+		})
+		if err != nil {
+			return err
+		}
+
+		output := viper.GetString("output")
+		if output == "" {
+			_, err = cmd.OutOrStdout().Write(code)
+			return err
+		}
+
+		return os.WriteFile(output, code, 0o644)
+	}
+}
@@ -68,13 +68,14 @@ func NewConfigCommand() *cobra.Command {
 	f.StringSlice("tracer-headers", conf.Tracer.Headers, "allows setting custom headers for the tracer exporter in key-value pairs")
 	f.String("tracer-protocol", conf.Tracer.Protocol, "allows setting the communication protocol for the tracer exporter, with options http or grpc")
 	f.Bool("meter-enabled", conf.Meter.Enabled, "switch option for metric")
-	f.String("meter-exporter", conf.Meter.Exporter, "can be; otlp. (integrated metric tools)")
+	f.String("meter-exporter", conf.Meter.Exporter, "can be; otlp, prometheus. (integrated metric tools)")
 	f.String("meter-endpoint", conf.Meter.Endpoint, "export uri for metric data")
 	f.Bool("meter-insecure", conf.Meter.Insecure, "use https or http for metric data")
 	f.String("meter-urlpath", conf.Meter.URLPath, "allow to set url path for otlp exporter")
 	f.StringSlice("meter-headers", conf.Meter.Headers, "allows setting custom headers for the metric exporter in key-value pairs")
 	f.Int("meter-interval", conf.Meter.Interval, "allows to set metrics to be pushed in certain time interval")
 	f.String("meter-protocol", conf.Meter.Protocol, "allows setting the communication protocol for the meter exporter, with options http or grpc")
+	f.String("meter-port", conf.Meter.Port, "dedicated port for the /metrics endpoint of the prometheus exporter, the http server is used if empty")
 	f.Bool("service-circuit-breaker", conf.Service.CircuitBreaker, "switch option for service circuit breaker")
 	f.Bool("service-watch-enabled", conf.Service.Watch.Enabled, "switch option for watch service")
 	f.Int64("service-schema-cache-number-of-counters", conf.Service.Schema.Cache.NumberOfCounters, "schema service cache number of counters")
@@ -83,6 +84,8 @@ func NewConfigCommand() *cobra.Command {
 	f.Int("service-permission-concurrency-limit", conf.Service.Permission.ConcurrencyLimit, "concurrency limit")
 	f.Int64("service-permission-cache-number-of-counters", conf.Service.Permission.Cache.NumberOfCounters, "permission service cache number of counters")
 	f.String("service-permission-cache-max-cost", conf.Service.Permission.Cache.MaxCost, "permission service cache max cost")
+	f.Duration("service-tenancy-retention", conf.Service.Tenancy.Retention, "how long soft deleted tenants are kept before their data is purged, zero deletes tenants immediately")
+	f.Duration("service-tenancy-purge-interval", conf.Service.Tenancy.PurgeInterval, "interval for purging soft deleted tenants whose retention has elapsed")
 	f.String("database-engine", conf.Database.Engine, "data source. e.g. postgres, memory")
 	f.String("database-uri", conf.Database.URI, "uri of your data source to store relation tuples and schema")
 	f.String("database-writer-uri", conf.Database.Writer.URI, "writer uri of your data source to store relation tuples and schema")
@@ -99,6 +102,9 @@ func NewConfigCommand() *cobra.Command {
 	f.Duration("database-garbage-collection-interval", conf.Database.GarbageCollection.Interval, "interval for database garbage collection")
 	f.Duration("database-garbage-collection-timeout", conf.Database.GarbageCollection.Timeout, "timeout for database garbage collection")
 	f.Duration("database-garbage-collection-window", conf.Database.GarbageCollection.Window, "window for database garbage collection")
+	f.Duration("database-tenant-deletion-interval", conf.Database.TenantDeletion.Interval, "interval for checking tenants whose data is waiting to be deleted")
+	f.Int("database-tenant-deletion-batch-size", conf.Database.TenantDeletion.BatchSize, "maximum number of rows removed by a single statement while deleting a tenant")
+	f.String("seed", conf.Database.Seed, "shape file whose schema, relationships and attributes are written to the tenant t1 of the memory database at startup")
 	f.Bool("distributed-enabled", conf.Distributed.Enabled, "enable distributed")
 	f.String("distributed-address", conf.Distributed.Address, "distributed address")
 	f.String("distributed-port", conf.Distributed.Port, "distributed port")
@@ -193,6 +199,7 @@ func conf() func(cmd *cobra.Command, args []string) error {
 			[]string{"meter.headers", fmt.Sprintf("%v", cfg.Meter.Headers), getKeyOrigin(cmd, "meter-headers", "PERMIFY_METER_HEADERS")},
 			[]string{"meter.protocol", cfg.Meter.Protocol, getKeyOrigin(cmd, "meter-protocol", "PERMIFY_METER_PROTOCOL")},
 			[]string{"meter.interval", fmt.Sprintf("%v", cfg.Meter.Interval), getKeyOrigin(cmd, "meter-interval", "PERMIFY_METER_INTERVAL")},
+			[]string{"meter.port", cfg.Meter.Port, getKeyOrigin(cmd, "meter-port", "PERMIFY_METER_PORT")},
 			// SERVICE
 			[]string{"service.circuit_breaker", fmt.Sprintf("%v", cfg.Service.CircuitBreaker), getKeyOrigin(cmd, "service-circuit-breaker", "PERMIFY_SERVICE_CIRCUIT_BREAKER")},
 			[]string{"service.schema.cache.number_of_counters", fmt.Sprintf("%v", cfg.Service.Schema.Cache.NumberOfCounters), getKeyOrigin(cmd, "service-schema-cache-number-of-counters", "PERMIFY_SERVICE_WATCH_ENABLED")},
@@ -201,6 +208,8 @@ func conf() func(cmd *cobra.Command, args []string) error {
 			[]string{"service.permission.concurrency_limit", fmt.Sprintf("%v", cfg.Service.Permission.ConcurrencyLimit), getKeyOrigin(cmd, "service-permission-concurrency-limit", "PERMIFY_SERVICE_PERMISSION_CONCURRENCY_LIMIT")},
 			[]string{"service.permission.cache.number_of_counters", fmt.Sprintf("%v", cfg.Service.Permission.Cache.NumberOfCounters), getKeyOrigin(cmd, "service-permission-cache-number-of-counters", "PERMIFY_SERVICE_PERMISSION_CACHE_NUMBER_OF_COUNTERS")},
 			[]string{"service.permission.cache.max_cost", fmt.Sprintf("%v", cfg.Service.Permission.Cache.MaxCost), getKeyOrigin(cmd, "service-permission-cache-max-cost", "PERMIFY_SERVICE_PERMISSION_CACHE_MAX_COST")},
+			[]string{"service.tenancy.retention", fmt.Sprintf("%v", cfg.Service.Tenancy.Retention), getKeyOrigin(cmd, "service-tenancy-retention", "PERMIFY_SERVICE_TENANCY_RETENTION")},
+			[]string{"service.tenancy.purge_interval", fmt.Sprintf("%v", cfg.Service.Tenancy.PurgeInterval), getKeyOrigin(cmd, "service-tenancy-purge-interval", "PERMIFY_SERVICE_TENANCY_PURGE_INTERVAL")},
 			// DATABASE
 			[]string{"database.engine", cfg.Database.Engine, getKeyOrigin(cmd, "database-engine", "PERMIFY_DATABASE_ENGINE")},
 			[]string{"database.uri", HideSecret(cfg.Database.URI), getKeyOrigin(cmd, "database-uri", "PERMIFY_DATABASE_URI")},
@@ -218,6 +227,9 @@ func conf() func(cmd *cobra.Command, args []string) error {
 			[]string{"database.garbage_collection.interval", fmt.Sprintf("%v", cfg.Database.GarbageCollection.Interval), getKeyOrigin(cmd, "database-garbage-collection-interval", "PERMIFY_DATABASE_GARBAGE_COLLECTION_INTERVAL")},
 			[]string{"database.garbage_collection.timeout", fmt.Sprintf("%v", cfg.Database.GarbageCollection.Timeout), getKeyOrigin(cmd, "database-garbage-collection-timeout", "PERMIFY_DATABASE_GARBAGE_COLLECTION_TIMEOUT")},
 			[]string{"database.garbage_collection.window", fmt.Sprintf("%v", cfg.Database.GarbageCollection.Window), getKeyOrigin(cmd, "database-garbage-collection-window", "PERMIFY_DATABASE_GARBAGE_COLLECTION_WINDOW")},
+			[]string{"database.tenant_deletion.interval", fmt.Sprintf("%v", cfg.Database.TenantDeletion.Interval), getKeyOrigin(cmd, "database-tenant-deletion-interval", "PERMIFY_DATABASE_TENANT_DELETION_INTERVAL")},
+			[]string{"database.tenant_deletion.batch_size", fmt.Sprintf("%v", cfg.Database.TenantDeletion.BatchSize), getKeyOrigin(cmd, "database-tenant-deletion-batch-size", "PERMIFY_DATABASE_TENANT_DELETION_BATCH_SIZE")},
+			[]string{"database.seed", cfg.Database.Seed, getKeyOrigin(cmd, "seed", "PERMIFY_DATABASE_SEED")},
 			// DISTRIBUTED
 			[]string{"distributed.enabled", fmt.Sprintf("%v", cfg.Distributed.Enabled), getKeyOrigin(cmd, "distributed-enabled", "PERMIFY_DISTRIBUTED_ENABLED")},
 			[]string{"distributed.address", cfg.Distributed.Address, getKeyOrigin(cmd, "distributed-address", "PERMIFY_DISTRIBUTED_ADDRESS")},
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"sort"
 
 	"github.com/gookit/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/Permify/permify/internal/engines"
 	"github.com/Permify/permify/pkg/cmd/flags"
 	cov "github.com/Permify/permify/pkg/development/coverage"
 	"github.com/Permify/permify/pkg/development/file"
@@ -28,6 +30,7 @@ func NewCoverageCommand() *cobra.Command {
 	f.Int("coverage-relationships", 0, "the min coverage for relationships")
 	f.Int("coverage-attributes", 0, "the min coverage for attributes")
 	f.Int("coverage-assertions", 0, "the min coverage for assertions")
+	f.Int("coverage-branches", 0, "the min coverage for the branches of the permissions evaluated true and false by the checks")
 
 	// register flags for coverage
 	command.PreRun = func(cmd *cobra.Command, args []string) {
@@ -50,6 +53,7 @@ func coverage() func(cmd *cobra.Command, args []string) error {
 		coverageRelationships := viper.GetInt("coverage-relationships")
 		coverageAttributes := viper.GetInt("coverage-attributes")
 		coverageAssertions := viper.GetInt("coverage-assertions")
+		coverageBranches := viper.GetInt("coverage-branches")
 
 		if err != nil {
 			return err
@@ -70,16 +74,25 @@ func coverage() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		loader := schema.NewSchemaLoader()
-		loaded, err := loader.LoadSchema(s.Schema)
+		// load the schema together with the files it imports, relative to the shape file, and merge them into a
+		// single schema
+		loader := schema.NewLocalSchemaLoader()
+		modules, err := loader.LoadEmbeddedModules(args[0], s.Schema, nil)
 		if err != nil {
 			return err
 		}
 
-		s.Schema = loaded
+		sch, err := schema.Parse(modules...)
+		if err != nil {
+			return err
+		}
+
+		s.Schema = sch.String()
 
 		color.Notice.Println("initiating validation... 🚀")
-		validator := validate()
+		// record the branches of the permissions evaluated by the checks
+		recorder := cov.NewBranchRecorder()
+		validator := validate(engines.CheckBranchRecorder(recorder))
 		err = validator(cmd, args)
 		if err != nil {
 			color.Danger.Println("failed to validate given file\n")
@@ -89,7 +102,7 @@ func coverage() func(cmd *cobra.Command, args []string) error {
 
 		color.Notice.Println("initiating coverage analysis... 🚀")
 
-		schemaCoverageInfo := cov.Run(*s)
+		schemaCoverageInfo := cov.RunWithBranches(*s, recorder)
 
 		DisplayCoverageInfo(schemaCoverageInfo)
 
@@ -113,6 +126,13 @@ func coverage() func(cmd *cobra.Command, args []string) error {
 			color.Danger.Println("FAILED")
 			os.Exit(1)
 		}
+
+		if schemaCoverageInfo.TotalBranchesCoverage < coverageBranches {
+			color.Danger.Printf("branches coverage < %d%%\n", coverageBranches)
+			// print FAILED with color danger
+			color.Danger.Println("FAILED")
+			os.Exit(1)
+		}
 		return nil
 	}
 }
@@ -139,6 +159,15 @@ func DisplayCoverageInfo(schemaCoverageInfo cov.SchemaCoverageInfo) {
 			}
 		}
 
+		fmt.Printf("  uncovered branches:\n")
+
+		for _, key := range sortedKeys(entityCoverageInfo.UncoveredBranches) {
+			fmt.Printf("    %s:\n", key)
+			for _, v := range entityCoverageInfo.UncoveredBranches[key] {
+				fmt.Printf("    	%v\n", v)
+			}
+		}
+
 		fmt.Printf("  coverage relationships percentage:")
 
 		if entityCoverageInfo.CoverageRelationshipsPercent <= 50 {
@@ -165,5 +194,27 @@ func DisplayCoverageInfo(schemaCoverageInfo cov.SchemaCoverageInfo) {
 				color.Success.Printf(" %d%%\n", value)
 			}
 		}
+
+		fmt.Printf("  coverage branches percentage: \n")
+
+		for _, key := range sortedKeys(entityCoverageInfo.CoverageBranchesPercent) {
+			value := entityCoverageInfo.CoverageBranchesPercent[key]
+			fmt.Printf("    %s:", key)
+			if value <= 50 {
+				color.Danger.Printf(" %d%%\n", value)
+			} else {
+				color.Success.Printf(" %d%%\n", value)
+			}
+		}
+	}
+}
+
+// sortedKeys - returns the keys of the map in order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
 	}
+	sort.Strings(keys)
+	return keys
 }
@@ -0,0 +1,25 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterCodegenFlags registers codegen flags.
+func RegisterCodegenFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("lang", flags.Lookup("lang")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("package", flags.Lookup("package")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("base-import", flags.Lookup("base-import")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("output", flags.Lookup("output")); err != nil {
+		panic(err)
+	}
+}
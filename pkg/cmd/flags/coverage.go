@@ -18,4 +18,8 @@ func RegisterCoverageFlags(flags *pflag.FlagSet) {
 	if err := viper.BindPFlag("coverage-assertions", flags.Lookup("coverage-assertions")); err != nil {
 		panic(err)
 	}
+
+	if err := viper.BindPFlag("coverage-branches", flags.Lookup("coverage-branches")); err != nil {
+		panic(err)
+	}
 }
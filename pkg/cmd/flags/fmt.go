@@ -0,0 +1,17 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterFmtFlags registers fmt flags.
+func RegisterFmtFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("check", flags.Lookup("check")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("write", flags.Lookup("write")); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,29 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterFuzzFlags registers fuzz flags.
+func RegisterFuzzFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("seed", flags.Lookup("seed")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("iterations", flags.Lookup("iterations")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("entities", flags.Lookup("entities")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("density", flags.Lookup("density")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("output", flags.Lookup("output")); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,60 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterGraphFlags registers graph flags.
+func RegisterGraphFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("format", flags.Lookup("format")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("focus", flags.Lookup("focus")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("output", flags.Lookup("output")); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterGraphDataFlags registers graph data flags.
+func RegisterGraphDataFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("database-engine", flags.Lookup("database-engine")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("database-uri", flags.Lookup("database-uri")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("tenant-id", flags.Lookup("tenant-id")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("entity", flags.Lookup("entity")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("depth", flags.Lookup("depth")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("snap-token", flags.Lookup("snap-token")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("schema-version", flags.Lookup("schema-version")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("format", flags.Lookup("format")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("output", flags.Lookup("output")); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,21 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterLintFlags registers lint flags.
+func RegisterLintFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("disable", flags.Lookup("disable")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("max-depth", flags.Lookup("max-depth")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("naming-convention", flags.Lookup("naming-convention")); err != nil {
+		panic(err)
+	}
+}
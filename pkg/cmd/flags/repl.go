@@ -0,0 +1,13 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterReplFlags registers repl flags.
+func RegisterReplFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("history-file", flags.Lookup("history-file")); err != nil {
+		panic(err)
+	}
+}
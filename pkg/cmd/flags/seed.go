@@ -0,0 +1,29 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterSeedFlags registers seed flags.
+func RegisterSeedFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("endpoint", flags.Lookup("endpoint")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("tenant-id", flags.Lookup("tenant-id")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("batch-size", flags.Lookup("batch-size")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("token", flags.Lookup("token")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("tls-cert-path", flags.Lookup("tls-cert-path")); err != nil {
+		panic(err)
+	}
+}
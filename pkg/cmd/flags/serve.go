@@ -372,6 +372,13 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 		panic(err)
 	}
 
+	if err = viper.BindPFlag("meter.port", flags.Lookup("meter-port")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("meter.port", "PERMIFY_METER_PORT"); err != nil {
+		panic(err)
+	}
+
 	// SERVICE
 	if err = viper.BindPFlag("service.circuit_breaker", flags.Lookup("service-circuit-breaker")); err != nil {
 		panic(err)
@@ -429,6 +436,20 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 		panic(err)
 	}
 
+	if err = viper.BindPFlag("service.tenancy.retention", flags.Lookup("service-tenancy-retention")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.tenancy.retention", "PERMIFY_SERVICE_TENANCY_RETENTION"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.tenancy.purge_interval", flags.Lookup("service-tenancy-purge-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.tenancy.purge_interval", "PERMIFY_SERVICE_TENANCY_PURGE_INTERVAL"); err != nil {
+		panic(err)
+	}
+
 	// DATABASE
 	if err = viper.BindPFlag("database.engine", flags.Lookup("database-engine")); err != nil {
 		panic(err)
@@ -542,6 +563,27 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 		panic(err)
 	}
 
+	if err = viper.BindPFlag("database.tenant_deletion.interval", flags.Lookup("database-tenant-deletion-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.tenant_deletion.interval", "PERMIFY_DATABASE_TENANT_DELETION_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("database.tenant_deletion.batch_size", flags.Lookup("database-tenant-deletion-batch-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.tenant_deletion.batch_size", "PERMIFY_DATABASE_TENANT_DELETION_BATCH_SIZE"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("database.seed", flags.Lookup("seed")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.seed", "PERMIFY_DATABASE_SEED"); err != nil {
+		panic(err)
+	}
+
 	// DISTRIBUTED
 	if err = viper.BindPFlag("distributed.enabled", flags.Lookup("distributed-enabled")); err != nil {
 		panic(err)
@@ -0,0 +1,21 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterValidateFlags registers validate flags.
+func RegisterValidateFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("junit-report", flags.Lookup("junit-report")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("json-report", flags.Lookup("json-report")); err != nil {
+		panic(err)
+	}
+
+	if err := viper.BindPFlag("parallel", flags.Lookup("parallel")); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/Permify/permify/pkg/cmd/flags"
+	"github.com/Permify/permify/pkg/dsl/formatter"
+)
+
+// NewFormatCommand - creates a new fmt command
+func NewFormatCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "fmt [files...]",
+		Short: "format schema files, reads from stdin if no file is given",
+		RunE:  format(),
+	}
+
+	f := command.Flags()
+	f.Bool("check", false, "list the files that are not formatted and exit with a non-zero status if there are any")
+	f.Bool("write", false, "write the formatted schema back to the files instead of printing it")
+
+	// register flags for fmt
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterFmtFlags(f)
+	}
+
+	return command
+}
+
+// format - formats the given schema files
+func format() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		check := viper.GetBool("check")
+		write := viper.GetBool("write")
+
+		if check && write {
+			return errors.New("--check and --write cannot be used together")
+		}
+
+		// format the standard input if no file is given
+		if len(args) == 0 {
+			if write {
+				return errors.New("--write requires at least one file")
+			}
+
+			input, err := io.ReadAll(cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			formatted, err := formatter.Format(string(input))
+			if err != nil {
+				return err
+			}
+
+			if check {
+				if formatted != string(input) {
+					color.Danger.Println("<stdin> is not formatted")
+					os.Exit(1)
+				}
+				return nil
+			}
+
+			fmt.Fprint(cmd.OutOrStdout(), formatted)
+			return nil
+		}
+
+		unformatted := 0
+		for _, name := range args {
+			input, err := os.ReadFile(name)
+			if err != nil {
+				return err
+			}
+
+			formatted, err := formatter.Format(string(input))
+			if err != nil {
+				return fmt.Errorf("%s:%w", name, err)
+			}
+
+			switch {
+			case check:
+				if formatted != string(input) {
+					unformatted++
+					fmt.Fprintln(cmd.OutOrStdout(), name)
+				}
+			case write:
+				if formatted == string(input) {
+					continue
+				}
+				info, err := os.Stat(name)
+				if err != nil {
+					return err
+				}
+				if err = os.WriteFile(name, []byte(formatted), info.Mode()); err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), name)
+			default:
+				fmt.Fprint(cmd.OutOrStdout(), formatted)
+			}
+		}
+
+		if unformatted > 0 {
+			color.Danger.Printf("%d file(s) are not formatted\n", unformatted)
+			os.Exit(1)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Permify/permify/pkg/cmd/flags"
+	"github.com/Permify/permify/pkg/development/fuzz"
+)
+
+// NewFuzzCommand - creates a new fuzz command
+func NewFuzzCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "fuzz <file>",
+		Short: "check that the engines agree with each other on random relationships and attributes generated from a schema or the schema of a shape file",
+		RunE:  fuzzSchema(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	f := command.Flags()
+	f.Int64("seed", 0, "the seed of the random data, a random seed is used if it is 0")
+	f.Int("iterations", 20, "the number of the random data sets to check")
+	f.Int("entities", 3, "the number of the entities generated of each entity type")
+	f.Float64("density", 0.3, "the probability of each possible relationship and attribute to be generated, between 0 and 1")
+	f.StringP("output", "o", "", "the file to write the minimal shape file of an inconsistency to instead of printing it")
+
+	// register flags for fuzz
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterFuzzFlags(f)
+	}
+
+	return command
+}
+
+// fuzzSchema - fuzzes the schema of the given file, the minimal shape file of an inconsistency is written or printed
+func fuzzSchema() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		sch, err := readSchema(args[0])
+		if err != nil {
+			return err
+		}
+
+		seed := viper.GetInt64("seed")
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+
+		color.Notice.Printf("fuzzing with seed %d... 🚀\n", seed)
+
+		result, err := fuzz.Run(context.Background(), flattenSchema(sch), fuzz.Options{
+			Seed:       seed,
+			Iterations: viper.GetInt("iterations"),
+			Entities:   viper.GetInt("entities"),
+			Density:    viper.GetFloat64("density"),
+		})
+		if err != nil {
+			return err
+		}
+
+		color.Notice.Printf("checked %d queries, skipped %d with errors of the check engine or the rules\n", result.Queries, result.Skipped)
+
+		inconsistency := result.Inconsistency
+		if inconsistency == nil {
+			color.Success.Println("SUCCESS")
+			return nil
+		}
+
+		color.Danger.Printf("fail: %s -> Check: %s %s: %s\n", inconsistency.Query, inconsistency.Expected, inconsistency.Engine, inconsistency.Actual)
+
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err = encoder.Encode(inconsistency.Shape); err != nil {
+			return err
+		}
+		out := buf.Bytes()
+
+		if output := viper.GetString("output"); output != "" {
+			if err = os.WriteFile(output, out, 0o644); err != nil {
+				return err
+			}
+			color.Notice.Printf("minimal shape file written to %s\n", output)
+		} else {
+			fmt.Printf("\n%s\n", out)
+		}
+
+		color.Danger.Println("FAILED")
+		os.Exit(1)
+
+		return nil
+	}
+}
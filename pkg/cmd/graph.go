@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/pkg/cmd/flags"
+	"github.com/Permify/permify/pkg/development/graph"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/schema"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// NewGraphCommand - creates a new graph command
+func NewGraphCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "graph <file>",
+		Short: "render the graph of the entities, relations, attributes, permissions and rules of a schema or of the schema of a shape file",
+		RunE:  renderGraph(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	f := command.Flags()
+	f.String("format", "dot", fmt.Sprintf("the format of the graph, one of %s", strings.Join(graph.Formats, ", ")))
+	f.String("focus", "", "only render an entity, or a relation, permission or attribute such as document#view, and what it depends on")
+	f.StringP("output", "o", "", "the file to write the graph to instead of printing it")
+
+	// register flags for graph
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterGraphFlags(f)
+	}
+
+	command.AddCommand(NewGraphDataCommand())
+
+	return command
+}
+
+// NewGraphDataCommand - creates a new graph data command
+func NewGraphDataCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "data",
+		Short: "render the graph of the relationships stored in a database around an entity",
+		RunE:  renderDataGraph(),
+		Args:  cobra.NoArgs,
+	}
+
+	f := command.Flags()
+	f.String("database-engine", "postgres", "database engine")
+	f.String("database-uri", "", "database URI")
+	f.String("tenant-id", "t1", "the tenant whose relationships are walked")
+	f.String("entity", "", "the entity the graph starts from, such as document:1")
+	f.Int("depth", 3, "the number of relationships walked from the entity")
+	f.String("snap-token", "", "the snap token the relationships are read at, the latest snapshot if it is empty")
+	f.String("schema-version", "", "the version of the schema, the latest version if it is empty")
+	f.String("format", "dot", fmt.Sprintf("the format of the graph, one of %s", strings.Join(graph.Formats, ", ")))
+	f.StringP("output", "o", "", "the file to write the graph to instead of printing it")
+
+	// register flags for graph data
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterGraphDataFlags(f)
+	}
+
+	return command
+}
+
+// renderGraph - compiles the schema of the given file and renders its graph
+func renderGraph() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		sch, err := readSchema(args[0])
+		if err != nil {
+			return err
+		}
+
+		entities, rules, err := compiler.NewCompiler(true, sch).Compile()
+		if err != nil {
+			return err
+		}
+
+		g, err := graph.NewBuilder(schema.Schema(entities, rules)).SchemaToGraph()
+		if err != nil {
+			return err
+		}
+
+		if focus := viper.GetString("focus"); focus != "" {
+			if g, err = g.Focus(focus); err != nil {
+				return err
+			}
+		}
+
+		return writeGraph(cmd, g)
+	}
+}
+
+// renderDataGraph - walks the relationships around the given entity in the database and renders their graph
+func renderDataGraph() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		entity, err := tuple.E(viper.GetString("entity"))
+		if err != nil {
+			return err
+		}
+
+		conf := config.DefaultConfig().Database
+		conf.Engine = viper.GetString("database-engine")
+		conf.URI = viper.GetString("database-uri")
+
+		db, err := factories.DatabaseFactory(conf)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		ctx := context.Background()
+		tenantID := viper.GetString("tenant-id")
+		dataReader := factories.DataReaderFactory(db)
+		schemaReader := factories.SchemaReaderFactory(db)
+
+		snap := viper.GetString("snap-token")
+		if snap == "" {
+			st, err := dataReader.HeadSnapshot(ctx, tenantID)
+			if err != nil {
+				return err
+			}
+			snap = st.Encode().String()
+		}
+
+		version := viper.GetString("schema-version")
+		if version == "" {
+			if version, err = schemaReader.HeadVersion(ctx, tenantID); err != nil {
+				return err
+			}
+		}
+
+		sch, err := schemaReader.ReadSchema(ctx, tenantID, version)
+		if err != nil {
+			return err
+		}
+
+		g, err := graph.NewDataBuilder(dataReader, sch).RelationshipsToGraph(ctx, tenantID, snap, entity, viper.GetInt("depth"))
+		if err != nil {
+			return err
+		}
+
+		return writeGraph(cmd, g)
+	}
+}
+
+// writeGraph - renders the graph in the format of the format flag and writes it to the output file or prints it
+func writeGraph(cmd *cobra.Command, g graph.Graph) error {
+	out, err := g.Render(viper.GetString("format"))
+	if err != nil {
+		return err
+	}
+
+	output := viper.GetString("output")
+	if output == "" {
+		_, err = cmd.OutOrStdout().Write(out)
+		return err
+	}
+
+	return os.WriteFile(output, out, 0o644)
+}
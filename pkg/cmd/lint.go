@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	internalSchema "github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/pkg/cmd/flags"
+	"github.com/Permify/permify/pkg/development"
+	"github.com/Permify/permify/pkg/development/file"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/schema"
+)
+
+// NewLintCommand - creates a new lint command
+func NewLintCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "lint <file>",
+		Short: "report the problems of a schema or of the schema of a shape file that do not prevent it from compiling",
+		RunE:  lint(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	rules := make([]string, 0, len(internalSchema.LintRules))
+	for _, rule := range internalSchema.LintRules {
+		rules = append(rules, string(rule))
+	}
+
+	f := command.Flags()
+	f.StringSlice("disable", []string{}, fmt.Sprintf("the rules to disable, one of %s", strings.Join(rules, ", ")))
+	f.Int("max-depth", internalSchema.DefaultMaxTupleToUserSetDepth, "the max number of tuple to user set relations a permission may follow")
+	f.String("naming-convention", internalSchema.DefaultNamingConvention.String(), "the pattern the names of entities, relations, attributes, permissions and rules must match")
+
+	// register flags for lint
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterLintFlags(f)
+	}
+
+	return command
+}
+
+// lint - lints the schema of the given file. Shape files (.yaml, .yml) are linted together with their
+// scenarios, so that the permissions that are never asserted are reported as well.
+func lint() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		naming, err := regexp.Compile(viper.GetString("naming-convention"))
+		if err != nil {
+			return fmt.Errorf("invalid naming convention: %w", err)
+		}
+
+		opts := []internalSchema.LinterOption{
+			internalSchema.MaxTupleToUserSetDepth(viper.GetInt("max-depth")),
+			internalSchema.NamingConvention(naming),
+		}
+
+		for _, rule := range viper.GetStringSlice("disable") {
+			if !isLintRule(rule) {
+				return fmt.Errorf("unknown lint rule: %s", rule)
+			}
+			opts = append(opts, internalSchema.DisableLintRules(internalSchema.LintRule(rule)))
+		}
+
+		input := args[0]
+
+		// decode the shape file and collect its assertions, the imports of its schema are relative to the shape file
+		name := ""
+		if ext := filepath.Ext(input); ext == ".yaml" || ext == ".yml" {
+			name = input
+
+			u, err := url.Parse(input)
+			if err != nil {
+				return err
+			}
+
+			decoder, err := file.NewDecoderFromURL(u)
+			if err != nil {
+				return err
+			}
+
+			s := &file.Shape{}
+			if err = decoder.Decode(s); err != nil {
+				return err
+			}
+
+			input = s.Schema
+			opts = append(opts, internalSchema.AssertedPermissions(development.AssertedPermissions(s)...))
+		}
+
+		modules, err := schema.NewLocalSchemaLoader().LoadEmbeddedModules(name, input, nil)
+		if err != nil {
+			return err
+		}
+
+		sch, err := schema.Parse(modules...)
+		if err != nil {
+			return err
+		}
+
+		c := compiler.NewCompiler(true, sch)
+		entities, rules, err := c.Compile()
+		if err != nil {
+			return err
+		}
+
+		opts = append(opts, internalSchema.RuleCalls(c.RuleCalls()))
+		warnings := internalSchema.NewLinter(internalSchema.NewSchemaFromEntityAndRuleDefinitions(entities, rules), opts...).Lint()
+		if len(warnings) == 0 {
+			color.Success.Println("no warnings")
+			return nil
+		}
+
+		for _, w := range warnings {
+			color.Warn.Printf("warning: %s [%s]\n", w.GetMessage(), w.GetRule())
+		}
+		color.Danger.Printf("%d warning(s)\n", len(warnings))
+		os.Exit(1)
+
+		return nil
+	}
+}
+
+// isLintRule returns true if the given name is a rule of the linter.
+func isLintRule(name string) bool {
+	for _, rule := range internalSchema.LintRules {
+		if string(rule) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/pkg/lsp"
+)
+
+// NewLSPCommand creates a new cobra.Command to run the language server of the schema language.
+// The server speaks the Language Server Protocol over stdin and stdout, so that it can be started by editors.
+func NewLSPCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lsp",
+		Short: "Runs the language server for Permify schema files over stdio.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return lsp.NewServer("permify", internal.Version).Run(os.Stdin, os.Stdout)
+		},
+	}
+}
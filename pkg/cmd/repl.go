@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+
+	"github.com/Permify/permify/pkg/cmd/flags"
+	"github.com/Permify/permify/pkg/development/repl"
+)
+
+// NewReplCommand - creates a new repl command
+func NewReplCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "repl [file]",
+		Short: "start an interactive session on an in-memory container to write relationships and attributes and check, lookup, expand and explain permissions of a schema or a shape file",
+		RunE:  runRepl(),
+		Args:  cobra.MaximumNArgs(1),
+	}
+
+	f := command.Flags()
+	f.String("history-file", "", "the file the commands of the sessions are kept in, ~/.permify_history if it is empty")
+
+	// register flags for repl
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterReplFlags(f)
+	}
+
+	return command
+}
+
+// runRepl - reads the commands of the session from the terminal, or line by line if the input is not a terminal,
+// and evaluates them until the exit command or the end of the input
+func runRepl() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		var out io.Writer = cmd.OutOrStdout()
+		readLine := lineReader(bufio.NewScanner(cmd.InOrStdin()))
+
+		// on a terminal, the lines are edited with the history of the session and the completion on tab
+		var terminal *term.Terminal
+		if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) {
+			state, err := term.MakeRaw(fd)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = term.Restore(fd, state)
+			}()
+
+			terminal = term.NewTerminal(struct {
+				io.Reader
+				io.Writer
+			}{os.Stdin, os.Stdout}, "permify> ")
+			out, readLine = terminal, terminal.ReadLine
+		}
+
+		r := repl.New(out, readShape)
+		if terminal != nil {
+			terminal.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+				if key != '\t' {
+					return "", 0, false
+				}
+				completed := r.CompleteLine(line[:pos])
+				return completed + line[pos:], len(completed), true
+			}
+		}
+
+		history, err := historyFile()
+		if err != nil {
+			return err
+		}
+		if content, err := os.ReadFile(history); err == nil {
+			for _, line := range strings.Split(string(content), "\n") {
+				if line != "" {
+					r.AddHistory(line)
+				}
+			}
+		}
+
+		if len(args) == 1 {
+			if err := r.Eval(ctx, "load "+args[0]); err != nil {
+				fmt.Fprintln(out, color.Danger.Sprint(err))
+			}
+		}
+		fmt.Fprintln(out, "type help for the commands, tab completes them")
+
+		for {
+			line, err := readLine()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			err = r.Eval(ctx, line)
+			if errors.Is(err, repl.ErrExit) {
+				return nil
+			}
+			if err != nil {
+				fmt.Fprintln(out, color.Danger.Sprint(err))
+			}
+
+			if line = strings.TrimSpace(line); line != "" {
+				appendHistory(history, line)
+			}
+		}
+	}
+}
+
+// lineReader - returns the reader of the lines of the scanner, io.EOF is returned at the end of the input
+func lineReader(scanner *bufio.Scanner) func() (string, error) {
+	return func() (string, error) {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return "", err
+			}
+			return "", io.EOF
+		}
+		return scanner.Text(), nil
+	}
+}
+
+// historyFile - returns the file the commands of the sessions are kept in
+func historyFile() (string, error) {
+	if history := viper.GetString("history-file"); history != "" {
+		return history, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".permify_history"), nil
+}
+
+// appendHistory - appends the line to the history file, the session goes on without the history if it can not be
+// written
+func appendHistory(history, line string) {
+	f, err := os.OpenFile(history, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	_, _ = fmt.Fprintln(f, line)
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Permify/permify/pkg/cmd/flags"
+	"github.com/Permify/permify/pkg/development/seed"
+)
+
+// NewSeedCommand - creates a new seed command
+func NewSeedCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "seed <file>",
+		Short: "write the schema, relationships and attributes of a shape file to a tenant of a running server",
+		RunE:  seedShape(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	f := command.Flags()
+	f.String("endpoint", "localhost:3478", "the address of the grpc server")
+	f.String("tenant-id", "t1", "the tenant the shape is written to, it is created if it does not exist")
+	f.Int("batch-size", seed.MaxBatchSize, fmt.Sprintf("the number of relationships and of attributes written by each request, at most %d", seed.MaxBatchSize))
+	f.String("token", "", "the preshared key or the token sent as the bearer token of the requests")
+	f.String("tls-cert-path", "", "the certificate of the grpc server, the connection is not encrypted if it is empty")
+
+	// register flags for seed
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterSeedFlags(f)
+	}
+
+	return command
+}
+
+// seedShape - writes the shape file of the argument to the tenant of the server and prints the schema version and the
+// snap token
+func seedShape() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		shape, err := readShape(args[0])
+		if err != nil {
+			return err
+		}
+
+		creds := insecure.NewCredentials()
+		if path := viper.GetString("tls-cert-path"); path != "" {
+			creds, err = credentials.NewClientTLSFromFile(path, "")
+			if err != nil {
+				return err
+			}
+		}
+
+		conn, err := grpc.Dial(viper.GetString("endpoint"), grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		ctx := context.Background()
+		if token := viper.GetString("token"); token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		}
+
+		tenantID := viper.GetString("tenant-id")
+		result, err := seed.Seed(ctx, seed.NewGRPCClient(conn), tenantID, shape, viper.GetInt("batch-size"))
+		if err != nil {
+			return err
+		}
+
+		printSeedResult(tenantID, result)
+		return nil
+	}
+}
+
+// printSeedResult - prints the tenant, the schema version and the snap token of a seeded shape
+func printSeedResult(tenantID string, result seed.Result) {
+	if result.TenantCreated {
+		color.Notice.Printf("tenant %s created\n", tenantID)
+	}
+	color.Success.Printf("seeded %d relationships and %d attributes into tenant %s ✓\n", result.Relationships, result.Attributes, tenantID)
+	fmt.Printf("schema version: %s\n", result.SchemaVersion)
+	if result.SnapToken != "" {
+		fmt.Printf("snap token: %s\n", result.SnapToken)
+	}
+}
@@ -23,10 +23,14 @@ import (
 	"github.com/Permify/permify/internal/invoke"
 	cacheDecorator "github.com/Permify/permify/internal/storage/decorators/cache"
 	cbDecorator "github.com/Permify/permify/internal/storage/decorators/circuitBreaker"
+	metricsDecorator "github.com/Permify/permify/internal/storage/decorators/metrics"
 	sfDecorator "github.com/Permify/permify/internal/storage/decorators/singleflight"
+	"github.com/Permify/permify/internal/storage/postgres/deleter"
 	"github.com/Permify/permify/internal/storage/postgres/gc"
+	"github.com/Permify/permify/internal/storage/purge"
 	"github.com/Permify/permify/pkg/cmd/flags"
 	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
+	"github.com/Permify/permify/pkg/development/seed"
 
 	"go.opentelemetry.io/otel/sdk/trace"
 	"golang.org/x/sync/errgroup"
@@ -102,13 +106,14 @@ func NewServeCommand() *cobra.Command {
 	f.StringSlice("tracer-headers", conf.Tracer.Headers, "allows setting custom headers for the tracer exporter in key-value pairs")
 	f.String("tracer-protocol", conf.Tracer.Protocol, "allows setting the communication protocol for the tracer exporter, with options http or grpc")
 	f.Bool("meter-enabled", conf.Meter.Enabled, "switch option for metric")
-	f.String("meter-exporter", conf.Meter.Exporter, "can be; otlp. (integrated metric tools)")
+	f.String("meter-exporter", conf.Meter.Exporter, "can be; otlp, prometheus. (integrated metric tools)")
 	f.String("meter-endpoint", conf.Meter.Endpoint, "export uri for metric data")
 	f.Bool("meter-insecure", conf.Meter.Insecure, "use https or http for metric data")
 	f.String("meter-urlpath", conf.Meter.URLPath, "allow to set url path for otlp exporter")
 	f.StringSlice("meter-headers", conf.Meter.Headers, "allows setting custom headers for the metric exporter in key-value pairs")
 	f.Int("meter-interval", conf.Meter.Interval, "allows to set metrics to be pushed in certain time interval")
 	f.String("meter-protocol", conf.Meter.Protocol, "allows setting the communication protocol for the meter exporter, with options http or grpc")
+	f.String("meter-port", conf.Meter.Port, "dedicated port for the /metrics endpoint of the prometheus exporter, the http server is used if empty")
 	f.Bool("service-circuit-breaker", conf.Service.CircuitBreaker, "switch option for service circuit breaker")
 	f.Bool("service-watch-enabled", conf.Service.Watch.Enabled, "switch option for watch service")
 	f.Int64("service-schema-cache-number-of-counters", conf.Service.Schema.Cache.NumberOfCounters, "schema service cache number of counters")
@@ -117,6 +122,8 @@ func NewServeCommand() *cobra.Command {
 	f.Int("service-permission-concurrency-limit", conf.Service.Permission.ConcurrencyLimit, "concurrency limit")
 	f.Int64("service-permission-cache-number-of-counters", conf.Service.Permission.Cache.NumberOfCounters, "permission service cache number of counters")
 	f.String("service-permission-cache-max-cost", conf.Service.Permission.Cache.MaxCost, "permission service cache max cost")
+	f.Duration("service-tenancy-retention", conf.Service.Tenancy.Retention, "how long soft deleted tenants are kept before their data is purged, zero deletes tenants immediately")
+	f.Duration("service-tenancy-purge-interval", conf.Service.Tenancy.PurgeInterval, "interval for purging soft deleted tenants whose retention has elapsed")
 	f.String("database-engine", conf.Database.Engine, "data source. e.g. postgres, memory")
 	f.String("database-uri", conf.Database.URI, "uri of your data source to store relation tuples and schema")
 	f.String("database-writer-uri", conf.Database.Writer.URI, "writer uri of your data source to store relation tuples and schema")
@@ -133,6 +140,9 @@ func NewServeCommand() *cobra.Command {
 	f.Duration("database-garbage-collection-interval", conf.Database.GarbageCollection.Interval, "interval for database garbage collection")
 	f.Duration("database-garbage-collection-timeout", conf.Database.GarbageCollection.Timeout, "timeout for database garbage collection")
 	f.Duration("database-garbage-collection-window", conf.Database.GarbageCollection.Window, "window for database garbage collection")
+	f.Duration("database-tenant-deletion-interval", conf.Database.TenantDeletion.Interval, "interval for checking tenants whose data is waiting to be deleted")
+	f.Int("database-tenant-deletion-batch-size", conf.Database.TenantDeletion.BatchSize, "maximum number of rows removed by a single statement while deleting a tenant")
+	f.String("seed", conf.Database.Seed, "shape file whose schema, relationships and attributes are written to the tenant t1 of the memory database at startup")
 	f.Bool("distributed-enabled", conf.Distributed.Enabled, "enable distributed")
 	f.String("distributed-address", conf.Distributed.Address, "distributed address")
 	f.String("distributed-port", conf.Distributed.Port, "distributed port")
@@ -339,6 +349,21 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			}()
 		}
 
+		// Tenant deletion, resumes deletions interrupted by a restart
+		if cfg.Database.Engine != "memory" {
+			tenantDeleter := deleter.NewDeleter(
+				db.(*PQDatabase.Postgres),
+				deleter.Interval(cfg.Database.TenantDeletion.Interval),
+				deleter.BatchSize(cfg.Database.TenantDeletion.BatchSize),
+			)
+
+			go func() {
+				if err := tenantDeleter.Start(ctx); err != nil {
+					slog.Error(err.Error())
+				}
+			}()
+		}
+
 		// Meter
 		if cfg.Meter.Enabled {
 			headers := map[string]string{}
@@ -350,21 +375,22 @@ func serve() func(cmd *cobra.Command, args []string) error {
 				headers[h[0]] = h[1]
 			}
 
-			var exporter metric.Exporter
-			exporter, err = meterexporters.ExporterFactory(
+			var reader metric.Reader
+			reader, err = meterexporters.ReaderFactory(
 				cfg.Meter.Exporter,
 				cfg.Meter.Endpoint,
 				cfg.Meter.Insecure,
 				cfg.Meter.URLPath,
 				headers,
 				cfg.Meter.Protocol,
+				time.Duration(cfg.Meter.Interval)*time.Second,
 			)
 
 			if err != nil {
 				slog.Error(err.Error())
 			}
 
-			shutdown := telemetry.NewMeter(exporter, time.Duration(cfg.Meter.Interval)*time.Second)
+			shutdown := telemetry.NewMeter(reader)
 
 			defer func() {
 				if err = shutdown(ctx); err != nil {
@@ -404,6 +430,11 @@ func serve() func(cmd *cobra.Command, args []string) error {
 		tenantReader := factories.TenantReaderFactory(db)
 		tenantWriter := factories.TenantWriterFactory(db)
 
+		// Record duration, returned rows and errors of the storage calls using decorators
+		dataReader = metricsDecorator.NewDataReader(dataReader, db.GetEngineType())
+		dataWriter = metricsDecorator.NewDataWriter(dataWriter, db.GetEngineType())
+		schemaReader = metricsDecorator.NewSchemaReader(schemaReader, db.GetEngineType())
+
 		// Add caching to the schema reader using a decorator
 		schemaReader = cacheDecorator.NewSchemaReader(schemaReader, schemaCache)
 
@@ -537,6 +568,54 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			watcher,
 		)
 
+		// Seed the memory database with the shape file before the servers start listening
+		if cfg.Database.Seed != "" {
+			if cfg.Database.Engine != "memory" {
+				return errors.New("seed is only supported by the memory database engine")
+			}
+
+			shape, err := readShape(cfg.Database.Seed)
+			if err != nil {
+				slog.Error("failed to read seed file", slog.Any("error", err))
+				return err
+			}
+
+			result, err := seed.Seed(ctx, seed.NewServerClient(
+				servers.NewTenancyServer(tenantReader, tenantWriter, cfg.Service.Tenancy.Retention),
+				servers.NewSchemaServer(schemaWriter, schemaReader),
+				servers.NewDataServer(dataReader, dataWriter, bundleReader, schemaReader),
+			), "t1", shape, seed.MaxBatchSize)
+			if err != nil {
+				slog.Error("failed to seed database", slog.Any("error", err))
+				return err
+			}
+
+			slog.Info("🌱 database seeded",
+				slog.String("file", cfg.Database.Seed),
+				slog.Int("relationships", result.Relationships),
+				slog.Int("attributes", result.Attributes),
+				slog.String("schema_version", result.SchemaVersion),
+				slog.String("snap_token", result.SnapToken))
+		}
+
+		// Purge soft deleted tenants once their retention has elapsed
+		if cfg.Service.Tenancy.Retention > 0 {
+			slog.Info("🗑️ starting soft deleted tenant purge...")
+
+			purger := purge.NewPurger(
+				tenantReader,
+				tenantWriter,
+				purge.Interval(cfg.Service.Tenancy.PurgeInterval),
+				purge.Retention(cfg.Service.Tenancy.Retention),
+			)
+
+			go func() {
+				if err := purger.Start(ctx); err != nil {
+					slog.Error(err.Error())
+				}
+			}()
+		}
+
 		// Create an error group with the provided context
 		var g *errgroup.Group
 		g, ctx = errgroup.WithContext(ctx)
@@ -550,6 +629,8 @@ func serve() func(cmd *cobra.Command, args []string) error {
 				&cfg.Distributed,
 				&cfg.Authn,
 				&cfg.Profiler,
+				&cfg.Service.Tenancy,
+				&cfg.Meter,
 				localInvoker,
 			)
 		})
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/Permify/permify/pkg/development/file"
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/schema"
+)
+
+// readShape - reads a shape file, or a schema file as the schema of a shape, the imported files of the schema are
+// included in it, the imports of the schema of a shape file are relative to the shape file
+func readShape(input string) (*file.Shape, error) {
+	s := &file.Shape{Schema: input}
+	name := ""
+	if ext := filepath.Ext(input); ext == ".yaml" || ext == ".yml" {
+		name = input
+
+		u, err := url.Parse(input)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder, err := file.NewDecoderFromURL(u)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = decoder.Decode(s); err != nil {
+			return nil, err
+		}
+	}
+
+	modules, err := schema.NewLocalSchemaLoader().LoadEmbeddedModules(name, s.Schema, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sch, err := schema.Parse(modules...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Schema = flattenSchema(sch)
+	return s, nil
+}
+
+// readSchema - loads and parses the schema, or the schema of the shape file, together with the files it imports
+func readSchema(input string) (*ast.Schema, error) {
+	// take the schema of the shape file, its imports are relative to the shape file
+	name := ""
+	if ext := filepath.Ext(input); ext == ".yaml" || ext == ".yml" {
+		name = input
+
+		u, err := url.Parse(input)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder, err := file.NewDecoderFromURL(u)
+		if err != nil {
+			return nil, err
+		}
+
+		s := &file.Shape{}
+		if err = decoder.Decode(s); err != nil {
+			return nil, err
+		}
+
+		input = s.Schema
+	}
+
+	modules, err := schema.NewLocalSchemaLoader().LoadEmbeddedModules(name, input, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.Parse(modules...)
+}
+
+// flattenSchema - returns the schema as a single file, the statements of the imported files included, without the
+// trailing spaces and the repeated empty lines of the serialized statements
+func flattenSchema(sch *ast.Schema) string {
+	var lines []string
+	for _, st := range sch.Statements {
+		for _, line := range strings.Split(st.String(), "\n") {
+			line = strings.TrimRight(line, " ")
+			if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+				continue
+			}
+			lines = append(lines, line)
+		}
+		if len(lines) > 0 && lines[len(lines)-1] != "" {
+			lines = append(lines, "")
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n")) + "\n"
+}
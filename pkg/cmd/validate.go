@@ -3,25 +3,33 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
+	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
+	"time"
 
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/gookit/color"
 	"github.com/rs/xid"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/Permify/permify/internal/engines"
 	"github.com/Permify/permify/internal/storage"
 	serverValidation "github.com/Permify/permify/internal/validation"
 	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/cmd/flags"
 	"github.com/Permify/permify/pkg/database"
 	"github.com/Permify/permify/pkg/development"
 	"github.com/Permify/permify/pkg/development/file"
+	"github.com/Permify/permify/pkg/development/report"
 	"github.com/Permify/permify/pkg/dsl/compiler"
-	"github.com/Permify/permify/pkg/dsl/parser"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/schema"
 	"github.com/Permify/permify/pkg/token"
@@ -31,10 +39,20 @@ import (
 // NewValidateCommand - creates a new validate command
 func NewValidateCommand() *cobra.Command {
 	command := &cobra.Command{
-		Use:   "validate <file>",
-		Short: "validate authorization model with assertions",
+		Use:   "validate <file>...",
+		Short: "validate authorization model with assertions, the files can be paths, URLs, directories or glob patterns of shape files",
 		RunE:  validate(),
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	f := command.Flags()
+	f.String("junit-report", "", "write a JUnit XML report of the assertions to the given file")
+	f.String("json-report", "", "write a JSON report of the assertions to the given file")
+	f.Int("parallel", runtime.NumCPU(), "the max number of files, and of scenarios of each file, validated at the same time")
+
+	// register flags for validate
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterValidateFlags(f)
 	}
 
 	return command
@@ -61,8 +79,9 @@ func (l *ErrList) Print() {
 	color.Danger.Println("FAILED")
 }
 
-// validate returns a function that validates authorization model with assertions
-func validate() func(cmd *cobra.Command, args []string) error {
+// validate returns a function that validates authorization model with assertions,
+// the options configure the check engine of the development container
+func validate(opts ...engines.CheckOption) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		// create an empty error list
 		list := &ErrList{
@@ -72,392 +91,747 @@ func validate() func(cmd *cobra.Command, args []string) error {
 		// create a new context
 		ctx := context.Background()
 
-		// create a new development container
-		dev := development.NewContainer()
-
-		// parse the url from the first argument
-		u, err := url.Parse(args[0])
+		// expand the directories and the glob patterns into the shape files
+		files, err := shapeFiles(args)
 		if err != nil {
 			return err
 		}
 
-		// create a new decoder from the url
-		decoder, err := file.NewDecoderFromURL(u)
-		if err != nil {
-			return err
+		parallel := viper.GetInt("parallel")
+		if parallel <= 0 {
+			parallel = runtime.NumCPU()
 		}
 
-		// create a new shape
-		s := &file.Shape{}
+		// validate the files, each in its own development container
+		r := report.Report{Suites: make([]report.Suite, len(files))}
 
-		// decode the schema from the decoder
-		err = decoder.Decode(s)
-		if err != nil {
-			return err
+		g := new(errgroup.Group)
+		g.SetLimit(parallel)
+		for i, name := range files {
+			g.Go(func() error {
+				r.Suites[i] = validateFile(ctx, name, parallel, opts...)
+				return nil
+			})
 		}
+		_ = g.Wait()
 
-		// if debug is true, print schema is creating with color blue
-		color.Notice.Println("schema is creating... 🚀")
+		// print the results in the order of the files
+		for _, suite := range r.Suites {
+			printSuite(suite, len(files) > 1)
 
-		loader := schema.NewSchemaLoader()
-		loaded, err := loader.LoadSchema(s.Schema)
-		if err != nil {
-			return err
+			prefix := ""
+			if len(files) > 1 {
+				prefix = suite.Name + ": "
+			}
+			if suite.Error != "" {
+				list.Add(prefix + suite.Error)
+			}
+			for _, c := range suite.Cases() {
+				switch {
+				case c.Passed:
+				case c.Error != "":
+					list.Add(prefix + c.Error)
+				default:
+					list.Add(fmt.Sprintf("%s%s -> %s", prefix, c.Name, c.Message()))
+				}
+			}
 		}
 
-		sch, err := parser.NewParser(loaded).Parse()
-		if err != nil {
-			return err
+		// write the reports
+		if path := viper.GetString("junit-report"); path != "" {
+			if err = writeReport(path, r.JUnit); err != nil {
+				return err
+			}
+		}
+		if path := viper.GetString("json-report"); path != "" {
+			if err = writeReport(path, r.JSON); err != nil {
+				return err
+			}
 		}
 
-		_, _, err = compiler.NewCompiler(true, sch).Compile()
-		if err != nil {
-			return err
+		// If the error list is not empty, there were some errors during processing.
+		if len(list.Errors) != 0 {
+			// Print the errors collected during processing.
+			list.Print()
+			// Exit the program with a status of 1 to indicate an error.
+			os.Exit(1)
 		}
 
-		version := xid.New().String()
+		// If there are no errors, print the success messages.
+		color.Notice.Println("schema successfully created")
+		color.Notice.Println("relationships successfully created")
+		color.Notice.Println("assertions successfully passed")
+
+		// Final success message to indicate everything completed successfully.
+		color.Success.Println("SUCCESS")
+
+		return nil
+	}
+}
+
+// shapeFiles - returns the shape files of the arguments. URLs and paths of files are returned as they are, directories
+// are replaced with the .yaml and .yml files in them and glob patterns with the files matching them.
+func shapeFiles(args []string) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		if u, err := url.Parse(arg); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+			files = append(files, arg)
+			continue
+		}
 
-		cnf := make([]storage.SchemaDefinition, 0, len(sch.Statements))
-		for _, st := range sch.Statements {
-			cnf = append(cnf, storage.SchemaDefinition{
-				TenantID:             "t1",
-				Version:              version,
-				Name:                 st.GetName(),
-				SerializedDefinition: []byte(st.String()),
+		if info, err := os.Stat(arg); err == nil && info.IsDir() {
+			var found []string
+			err = filepath.WalkDir(arg, func(path string, d os.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if ext := filepath.Ext(path); !d.IsDir() && (ext == ".yaml" || ext == ".yml") {
+					found = append(found, path)
+				}
+				return nil
 			})
+			if err != nil {
+				return nil, err
+			}
+			if len(found) == 0 {
+				return nil, fmt.Errorf("no shape files found in %s", arg)
+			}
+			files = append(files, found...)
+			continue
 		}
 
-		// write the schema
-		err = dev.Container.SW.WriteSchema(ctx, cnf)
-		if err != nil {
-			list.Add(err.Error())
-			color.Danger.Printf("fail: %s\n", validationError(err.Error()))
-			if len(list.Errors) != 0 {
-				list.Print()
-				os.Exit(1)
+		if strings.ContainsAny(arg, "*?[") {
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				return nil, err
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no shape files match %s", arg)
 			}
+			files = append(files, matches...)
+			continue
 		}
 
-		// if there are no errors and debug is true, print success with color success
-		if len(list.Errors) == 0 {
-			color.Success.Println("  success")
+		files = append(files, arg)
+	}
+	return files, nil
+}
+
+// writeReport - writes a report to the file at the given path
+func writeReport(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err = write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// validateFile - validates the shape file in a new development container, the scenarios are run in parallel
+func validateFile(ctx context.Context, name string, parallel int, opts ...engines.CheckOption) (suite report.Suite) {
+	start := time.Now()
+	suite.Name = name
+	defer func() {
+		suite.Time = time.Since(start).Seconds()
+	}()
+
+	// create a new development container
+	dev := development.NewContainer(opts...)
+
+	// parse the url of the file
+	u, err := url.Parse(name)
+	if err != nil {
+		suite.Error = err.Error()
+		return
+	}
+
+	// create a new decoder from the url
+	decoder, err := file.NewDecoderFromURL(u)
+	if err != nil {
+		suite.Error = err.Error()
+		return
+	}
+
+	// create a new shape and decode the file into it
+	s := &file.Shape{}
+	if err = decoder.Decode(s); err != nil {
+		suite.Error = err.Error()
+		return
+	}
+
+	// load the schema together with the files it imports, relative to the shape file
+	loader := schema.NewLocalSchemaLoader()
+	modules, err := loader.LoadEmbeddedModules(name, s.Schema, nil)
+	if err != nil {
+		suite.Error = err.Error()
+		return
+	}
+
+	sch, err := schema.Parse(modules...)
+	if err != nil {
+		suite.Error = err.Error()
+		return
+	}
+
+	c := compiler.NewCompiler(true, sch)
+	_, _, err = c.Compile()
+	if err != nil {
+		suite.Error = err.Error()
+		return
+	}
+
+	version := xid.New().String()
+
+	cnf := make([]storage.SchemaDefinition, 0, len(sch.Statements))
+	for _, st := range sch.Statements {
+		serialized, err := c.Serialize(st)
+		if err != nil {
+			suite.Error = err.Error()
+			return
 		}
+		cnf = append(cnf, storage.SchemaDefinition{
+			TenantID:             "t1",
+			Version:              version,
+			Name:                 st.GetName(),
+			SerializedDefinition: []byte(serialized),
+		})
+	}
 
-		// if debug is true, print relationships are creating with color blue
-		color.Notice.Println("relationships are creating... 🚀")
+	// write the schema
+	schemaCase := run(report.Case{Kind: report.KindSchema, Name: "schema"}, func(c *report.Case) (bool, error) {
+		return true, dev.Container.SW.WriteSchema(ctx, cnf)
+	})
+	suite.Setup = append(suite.Setup, schemaCase)
+	if !schemaCase.Passed {
+		return
+	}
 
-		// Iterate over all relationships in the subject
-		for _, t := range s.Relationships {
-			// Convert each relationship to a Tuple
-			var tup *base.Tuple
-			tup, err = tuple.Tuple(t)
-			// If an error occurs during the conversion, add the error message to the list and continue to the next iteration
+	// write the relationships
+	for _, t := range s.Relationships {
+		suite.Setup = append(suite.Setup, run(report.Case{Kind: report.KindRelationship, Name: t}, func(c *report.Case) (bool, error) {
+			// Convert the relationship to a Tuple
+			tup, err := tuple.Tuple(t)
 			if err != nil {
-				list.Add(err.Error())
-				continue
+				return false, err
 			}
 
 			// Retrieve the entity definition associated with the tuple's entity type
 			definition, _, err := dev.Container.SR.ReadEntityDefinition(ctx, "t1", tup.GetEntity().GetType(), version)
-			// If an error occurs while reading the entity definition, return the error
 			if err != nil {
-				return err
+				return false, err
 			}
 
 			// Validate the tuple using the entity definition
-			err = serverValidation.ValidateTuple(definition, tup)
-			// If an error occurs during validation, return the error
-			if err != nil {
-				return err
+			if err = serverValidation.ValidateTuple(definition, tup); err != nil {
+				return false, err
 			}
 
 			// Write the validated tuple to the database
 			_, err = dev.Container.DW.Write(ctx, "t1", database.NewTupleCollection(tup), database.NewAttributeCollection())
-			// If an error occurs while writing to the database, add an error message to the list, log the error and continue to the next iteration
-			if err != nil {
-				list.Add(fmt.Sprintf("%s failed %s", t, err.Error()))
-				color.Danger.Println(fmt.Sprintf("fail: %s failed %s", t, validationError(err.Error())))
-				continue
-			}
-
-			// If the tuple was successfully written to the database, log a success message
-			color.Success.Println(fmt.Sprintf("  success: %s ", t))
-		}
-
-		// if debug is true, print attributes are creating with color blue
-		color.Notice.Println("attributes are creating... 🚀")
+			return true, err
+		}))
+	}
 
-		// Iterate over all attributes in the subject
-		for _, a := range s.Attributes {
-			// Convert each attribute to an Attribute
-			var attr *base.Attribute
-			attr, err = attribute.Attribute(a)
-			// If an error occurs during the conversion, add the error message to the list and continue to the next iteration
+	// write the attributes
+	for _, a := range s.Attributes {
+		suite.Setup = append(suite.Setup, run(report.Case{Kind: report.KindAttribute, Name: a}, func(c *report.Case) (bool, error) {
+			// Convert the attribute to an Attribute
+			attr, err := attribute.Attribute(a)
 			if err != nil {
-				list.Add(err.Error())
-				continue
+				return false, err
 			}
 
 			// Retrieve the entity definition associated with the attribute's entity type
 			definition, _, err := dev.Container.SR.ReadEntityDefinition(ctx, "t1", attr.GetEntity().GetType(), version)
-			// If an error occurs while reading the entity definition, return the error
 			if err != nil {
-				return err
+				return false, err
 			}
 
 			// Validate the attribute using the entity definition
-			err = serverValidation.ValidateAttribute(definition, attr)
-			// If an error occurs during validation, return the error
-			if err != nil {
-				return err
+			if err = serverValidation.ValidateAttribute(definition, attr); err != nil {
+				return false, err
 			}
 
 			// Write the validated attribute to the database
 			_, err = dev.Container.DW.Write(ctx, "t1", database.NewTupleCollection(), database.NewAttributeCollection(attr))
-			// If an error occurs while writing to the database, add an error message to the list, log the error and continue to the next iteration
-			if err != nil {
-				list.Add(fmt.Sprintf("%s failed %s", a, err.Error()))
-				color.Danger.Println(fmt.Sprintf("fail: %s failed %s", a, validationError(err.Error())))
-				continue
-			}
+			return true, err
+		}))
+	}
+
+	// run the scenarios, they only read the data so they can run at the same time
+	suite.Scenarios = make([]report.Scenario, len(s.Scenarios))
+
+	g := new(errgroup.Group)
+	g.SetLimit(parallel)
+	for i, scenario := range s.Scenarios {
+		g.Go(func() error {
+			suite.Scenarios[i] = validateScenario(ctx, dev, version, scenario)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return suite
+}
 
-			// If the attribute was successfully written to the database, log a success message
-			color.Success.Println(fmt.Sprintf("  success: %s ", a))
+// validateScenario - evaluates the assertions of the checks, the entity filters and the subject filters of the scenario
+func validateScenario(ctx context.Context, dev *development.Development, version string, scenario file.Scenario) report.Scenario {
+	result := report.Scenario{
+		Name:        scenario.Name,
+		Description: scenario.Description,
+		Cases:       []report.Case{},
+	}
+
+	// Iterate over all checks in the scenario
+	for _, check := range scenario.Checks {
+		// Extract entity from the check
+		entity, err := tuple.E(check.Entity)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindCheck, check.Entity, err))
+			continue
 		}
 
-		// if debug is true, print checking assertions with color blue
-		color.Notice.Println("checking scenarios... 🚀")
+		// Extract entity-attribute-relation from the check's subject
+		ear, err := tuple.EAR(check.Subject)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindCheck, check.Subject, err))
+			continue
+		}
 
-		// Check Assertions
-		for sn, scenario := range s.Scenarios {
-			color.Notice.Printf("%v.scenario: %s - %s\n", sn+1, scenario.Name, scenario.Description)
+		// Define the subject based on the extracted entity-attribute-relation
+		subject := &base.Subject{
+			Type:     ear.GetEntity().GetType(),
+			Id:       ear.GetEntity().GetId(),
+			Relation: ear.GetRelation(),
+		}
 
-			// Start log output for checks
-			color.Notice.Println("  checks:")
+		cont, err := Context(check.Context)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindCheck, check.Entity, err))
+			continue
+		}
 
-			// Iterate over all checks in the scenario
-			for _, check := range scenario.Checks {
-				// Extract entity from the check
-				entity, err := tuple.E(check.Entity)
-				if err != nil {
-					list.Add(err.Error())
-					continue
-				}
+		depth := check.Depth
+		if depth == 0 {
+			depth = 100
+		}
 
-				// Extract entity-attribute-relation from the check's subject
-				ear, err := tuple.EAR(check.Subject)
-				if err != nil {
-					list.Add(err.Error())
-					continue
-				}
+		// evaluate performs a permission check based on the context, entity, permission, and subject
+		evaluate := func(permission string) (*base.PermissionCheckResponse, error) {
+			return dev.Container.Invoker.Check(ctx, &base.PermissionCheckRequest{
+				TenantId: "t1",
+				Context:  cont,
+				Metadata: &base.PermissionCheckRequestMetadata{
+					SchemaVersion: version,
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					Depth:         depth,
+				},
+				Entity:     entity,
+				Permission: permission,
+				Subject:    subject,
+			})
+		}
 
-				// Define the subject based on the extracted entity-attribute-relation
-				subject := &base.Subject{
-					Type:     ear.GetEntity().GetType(),
-					Id:       ear.GetEntity().GetId(),
-					Relation: ear.GetRelation(),
-				}
+		// Iterate over all assertions in the check, in the order of the permissions
+		for _, permission := range sortedKeys(check.Assertions) {
+			expected := base.CheckResult_CHECK_RESULT_ALLOWED
+			if !check.Assertions[permission] {
+				expected = base.CheckResult_CHECK_RESULT_DENIED
+			}
 
-				cont, err := Context(check.Context)
+			result.Cases = append(result.Cases, run(report.Case{
+				Kind:       report.KindCheck,
+				Name:       tuple.SubjectToString(subject) + " " + permission + " " + tuple.EntityToString(entity),
+				Entity:     tuple.EntityToString(entity),
+				Subject:    tuple.SubjectToString(subject),
+				Permission: permission,
+				Expected:   checkResult(expected),
+			}, func(c *report.Case) (bool, error) {
+				res, err := evaluate(permission)
 				if err != nil {
-					list.Add(err.Error())
-					continue
+					return false, err
 				}
 
-				// Iterate over all assertions in the check
-				for permission, expected := range check.Assertions {
-					// Set expected result based on the assertion
-					exp := base.CheckResult_CHECK_RESULT_ALLOWED
-					if !expected {
-						exp = base.CheckResult_CHECK_RESULT_DENIED
-					}
-
-					// Perform a permission check based on the context, entity, permission, and subject
-					res, err := dev.Container.Invoker.Check(ctx, &base.PermissionCheckRequest{
-						TenantId: "t1",
-						Context:  cont,
-						Metadata: &base.PermissionCheckRequestMetadata{
-							SchemaVersion: version,
-							SnapToken:     token.NewNoopToken().Encode().String(),
-							Depth:         100,
-						},
-						Entity:     entity,
-						Permission: permission,
-						Subject:    subject,
-					})
-					if err != nil {
-						list.Add(err.Error())
-						continue
-					}
-
-					// Formulate the query string for log output
-					query := tuple.SubjectToString(subject) + " " + permission + " " + tuple.EntityToString(entity)
-
-					// If the check result matches the expected result, log a success message
-					if res.Can == exp {
-						color.Success.Print("    success:")
-						fmt.Printf(" %s \n", query)
-					} else {
-						// If the check result does not match the expected result, log a failure message
-						color.Danger.Printf("    fail: %s ->", query)
-						if res.Can == base.CheckResult_CHECK_RESULT_ALLOWED {
-							color.Danger.Println("  expected: DENIED actual: ALLOWED ")
-							list.Add(fmt.Sprintf("%s -> expected: DENIED actual: ALLOWED ", query))
-						} else {
-							color.Danger.Println("  expected: ALLOWED actual: DENIED ")
-							list.Add(fmt.Sprintf("%s -> expected: ALLOWED actual: DENIED ", query))
-						}
-					}
+				c.Actual = checkResult(res.GetCan())
+				return res.GetCan() == expected, nil
+			}))
+		}
+
+		// Iterate over all expected errors of the check, in the order of the permissions
+		for _, permission := range sortedKeys(check.Errors) {
+			result.Cases = append(result.Cases, run(report.Case{
+				Kind:       report.KindCheck,
+				Name:       tuple.SubjectToString(subject) + " " + permission + " " + tuple.EntityToString(entity),
+				Entity:     tuple.EntityToString(entity),
+				Subject:    tuple.SubjectToString(subject),
+				Permission: permission,
+				Expected:   check.Errors[permission],
+			}, func(c *report.Case) (bool, error) {
+				res, err := evaluate(permission)
+				if err == nil {
+					c.Actual = checkResult(res.GetCan())
+					return false, nil
 				}
-			}
 
-			// Start of the entity filter processing.
-			color.Notice.Println("  entity_filters:")
+				c.Actual = err.Error()
+				return strings.Contains(err.Error(), check.Errors[permission]), nil
+			}))
+		}
+	}
 
-			// Iterate over each entity filter in the scenario.
-			for _, filter := range scenario.EntityFilters {
+	// Iterate over each entity filter in the scenario.
+	for _, filter := range scenario.EntityFilters {
+		// Convert the subject from the filter into a base.Subject.
+		ear, err := tuple.EAR(filter.Subject)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindEntityFilter, filter.Subject, err))
+			continue
+		}
 
-				// Convert the subject from the filter into a base.Subject.
-				ear, err := tuple.EAR(filter.Subject)
-				if err != nil {
-					// If an error occurs, add it to the list and continue to the next filter.
-					list.Add(err.Error())
-					continue
-				}
+		// Create a new base.Subject from the Entity-Attribute-Relation (EAR).
+		subject := &base.Subject{
+			Type:     ear.GetEntity().GetType(),
+			Id:       ear.GetEntity().GetId(),
+			Relation: ear.GetRelation(),
+		}
 
-				// Create a new base.Subject from the Entity-Attribute-Relation (EAR).
-				subject := &base.Subject{
-					Type:     ear.GetEntity().GetType(),
-					Id:       ear.GetEntity().GetId(),
-					Relation: ear.GetRelation(),
-				}
+		// Convert the filter context into a base.Context.
+		cont, err := Context(filter.Context)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindEntityFilter, filter.EntityType, err))
+			continue
+		}
 
-				// Convert the filter context into a base.Context.
-				cont, err := Context(filter.Context)
-				if err != nil {
-					// If an error occurs, add it to the list and continue to the next filter.
-					list.Add(err.Error())
-					continue
-				}
+		// lookup performs a permission lookup for the entity.
+		lookup := func(permission string) ([]string, error) {
+			res, err := dev.Container.Invoker.LookupEntity(ctx, &base.PermissionLookupEntityRequest{
+				TenantId: "t1",
+				Context:  cont,
+				Metadata: &base.PermissionLookupEntityRequestMetadata{
+					SchemaVersion: version,
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					Depth:         100,
+				},
+				EntityType: filter.EntityType,
+				Permission: permission,
+				Subject:    subject,
+			})
+			return res.GetEntityIds(), err
+		}
 
-				// Iterate over each assertion in the filter.
-				for permission, expected := range filter.Assertions {
-					// Perform a permission lookup for the entity.
-					res, err := dev.Container.Invoker.LookupEntity(ctx, &base.PermissionLookupEntityRequest{
-						TenantId: "t1",
-						Context:  cont,
-						Metadata: &base.PermissionLookupEntityRequestMetadata{
-							SchemaVersion: version,
-							SnapToken:     token.NewNoopToken().Encode().String(),
-							Depth:         100,
-						},
-						EntityType: filter.EntityType,
-						Permission: permission,
-						Subject:    subject,
-					})
-					if err != nil {
-						// If an error occurs, add it to the list and continue to the next assertion.
-						list.Add(err.Error())
-						continue
-					}
-
-					// Format the subject, permission, and entity type as a string for logging.
-					query := tuple.SubjectToString(subject) + " " + permission + " " + filter.EntityType
-
-					// Check if the actual result matches the expected result.
-					if isSameArray(res.GetEntityIds(), expected) {
-						// If the results match, log a success message.
-						color.Success.Print("    success:")
-						fmt.Printf(" %v\n", query)
-					} else {
-						// If the results don't match, log a failure message with the expected and actual results.
-						color.Danger.Printf("    fail: %s -> expected: %+v actual: %+v\n", query, expected, res.GetEntityIds())
-						list.Add(fmt.Sprintf("%s -> expected: %+v actual: %+v", query, expected, res.GetEntityIds()))
-					}
-				}
+		result.Cases = append(result.Cases, filterCases(report.Case{
+			Kind:    report.KindEntityFilter,
+			Entity:  filter.EntityType,
+			Subject: tuple.SubjectToString(subject),
+		}, func(permission string) string {
+			return tuple.SubjectToString(subject) + " " + permission + " " + filter.EntityType
+		}, filter.Assertions, filter.Contains, filter.Excludes, lookup)...)
+	}
+
+	// Iterate over each subject filter in the scenario.
+	for _, filter := range scenario.SubjectFilters {
+		// Convert the subject reference from the filter into a relation reference.
+		subjectReference := tuple.RelationReference(filter.SubjectReference)
+
+		// Convert the entity from the filter into a base.Entity.
+		entity, err := tuple.E(filter.Entity)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindSubjectFilter, filter.Entity, err))
+			continue
+		}
+
+		// Convert the filter context into a base.Context.
+		cont, err := Context(filter.Context)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindSubjectFilter, filter.Entity, err))
+			continue
+		}
+
+		// lookup performs a permission lookup for the subject.
+		lookup := func(permission string) ([]string, error) {
+			res, err := dev.Container.Invoker.LookupSubject(ctx, &base.PermissionLookupSubjectRequest{
+				TenantId: "t1",
+				Context:  cont,
+				Metadata: &base.PermissionLookupSubjectRequestMetadata{
+					SchemaVersion: version,
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					Depth:         100,
+				},
+				SubjectReference: subjectReference,
+				Permission:       permission,
+				Entity:           entity,
+			})
+			return res.GetSubjectIds(), err
+		}
+
+		result.Cases = append(result.Cases, filterCases(report.Case{
+			Kind:    report.KindSubjectFilter,
+			Entity:  tuple.EntityToString(entity),
+			Subject: filter.SubjectReference,
+		}, func(permission string) string {
+			return tuple.EntityToString(entity) + " " + permission + " " + filter.SubjectReference
+		}, filter.Assertions, filter.Contains, filter.Excludes, lookup)...)
+	}
+
+	// Iterate over each subject permission in the scenario.
+	for _, sp := range scenario.SubjectPermissions {
+		entity, err := tuple.E(sp.Entity)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindSubjectPermission, sp.Entity, err))
+			continue
+		}
+
+		ear, err := tuple.EAR(sp.Subject)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindSubjectPermission, sp.Subject, err))
+			continue
+		}
+
+		subject := &base.Subject{
+			Type:     ear.GetEntity().GetType(),
+			Id:       ear.GetEntity().GetId(),
+			Relation: ear.GetRelation(),
+		}
+
+		cont, err := Context(sp.Context)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindSubjectPermission, sp.Entity, err))
+			continue
+		}
+
+		result.Cases = append(result.Cases, run(report.Case{
+			Kind:     report.KindSubjectPermission,
+			Name:     tuple.SubjectToString(subject) + " " + tuple.EntityToString(entity),
+			Entity:   tuple.EntityToString(entity),
+			Subject:  tuple.SubjectToString(subject),
+			Expected: "{" + development.SubjectPermissionResults(sp.Assertions) + "}",
+		}, func(c *report.Case) (bool, error) {
+			// Check the permissions of the subject on the entity together.
+			res, err := dev.Container.Invoker.SubjectPermission(ctx, &base.PermissionSubjectPermissionRequest{
+				TenantId: "t1",
+				Context:  cont,
+				Metadata: &base.PermissionSubjectPermissionRequestMetadata{
+					SchemaVersion:  version,
+					SnapToken:      token.NewNoopToken().Encode().String(),
+					OnlyPermission: sp.OnlyPermission,
+					Depth:          100,
+				},
+				Entity:  entity,
+				Subject: subject,
+			})
+			if err != nil {
+				return false, err
 			}
 
-			// Print a message indicating the start of the subject filter processing.
-			color.Notice.Println("  subject_filters:")
+			c.Actual = "{" + development.SubjectPermissionResults(development.CheckResults(res.GetResults())) + "}"
+			return c.Actual == c.Expected, nil
+		}))
+	}
 
-			// Iterate over each subject filter in the scenario.
-			for _, filter := range scenario.SubjectFilters {
+	// Iterate over each expand in the scenario.
+	for _, ex := range scenario.Expands {
+		entity, err := tuple.E(ex.Entity)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindExpand, ex.Entity, err))
+			continue
+		}
 
-				// Convert the subject reference from the filter into a relation reference.
-				subjectReference := tuple.RelationReference(filter.SubjectReference)
+		cont, err := Context(ex.Context)
+		if err != nil {
+			result.Cases = append(result.Cases, failed(report.KindExpand, ex.Entity, err))
+			continue
+		}
 
-				// Convert the entity from the filter into a base.Entity.
-				var entity *base.Entity
-				entity, err = tuple.E(filter.Entity)
-				if err != nil {
-					// If an error occurs, add it to the list and continue to the next filter.
-					list.Add(err.Error())
-					continue
-				}
+		result.Cases = append(result.Cases, run(report.Case{
+			Kind:       report.KindExpand,
+			Name:       tuple.EntityToString(entity) + "#" + ex.Permission,
+			Entity:     tuple.EntityToString(entity),
+			Permission: ex.Permission,
+			Expected:   ex.Tree,
+		}, func(c *report.Case) (bool, error) {
+			// Expand the permission of the entity and compare its tree with the snapshot.
+			res, err := dev.Container.Invoker.Expand(ctx, &base.PermissionExpandRequest{
+				TenantId: "t1",
+				Context:  cont,
+				Metadata: &base.PermissionExpandRequestMetadata{
+					SchemaVersion: version,
+					SnapToken:     token.NewNoopToken().Encode().String(),
+				},
+				Entity:     entity,
+				Permission: ex.Permission,
+			})
+			if err != nil {
+				return false, err
+			}
+
+			c.Actual = development.ExpandTree(res.GetTree())
+			return development.SameTree(c.Expected, c.Actual), nil
+		}))
+	}
 
-				// Convert the filter context into a base.Context.
-				cont, err := Context(filter.Context)
+	return result
+}
+
+// filterCases - evaluates the assertions of a filter in the order of the permissions. The exact results are followed
+// by the results expected to contain and to exclude some ids, the lookup returns the ids of a permission and the
+// query describes the filter of a permission.
+func filterCases(
+	filter report.Case,
+	query func(permission string) string,
+	assertions, contains, excludes map[string][]string,
+	lookup func(permission string) ([]string, error),
+) (cases []report.Case) {
+	for _, assertion := range []struct {
+		expected map[string][]string
+		format   string
+		match    func(actual, expected []string) bool
+	}{
+		{expected: assertions, format: "%+v", match: isSameArray},
+		{expected: contains, format: "contains %+v", match: func(actual, expected []string) bool {
+			return len(development.Missing(actual, expected)) == 0
+		}},
+		{expected: excludes, format: "excludes %+v", match: func(actual, expected []string) bool {
+			return len(development.Present(actual, expected)) == 0
+		}},
+	} {
+		for _, permission := range sortedKeys(assertion.expected) {
+			expected := assertion.expected[permission]
+
+			c := filter
+			c.Name = query(permission)
+			c.Permission = permission
+			c.Expected = fmt.Sprintf(assertion.format, expected)
+
+			cases = append(cases, run(c, func(c *report.Case) (bool, error) {
+				ids, err := lookup(permission)
 				if err != nil {
-					// If an error occurs, add it to the list and continue to the next filter.
-					list.Add(err.Error())
-					continue
+					return false, err
 				}
 
-				// Iterate over each assertion in the filter.
-				for permission, expected := range filter.Assertions {
-					// Perform a permission lookup for the subject.
-					res, err := dev.Container.Invoker.LookupSubject(ctx, &base.PermissionLookupSubjectRequest{
-						TenantId: "t1",
-						Context:  cont,
-						Metadata: &base.PermissionLookupSubjectRequestMetadata{
-							SchemaVersion: version,
-							SnapToken:     token.NewNoopToken().Encode().String(),
-							Depth:         100,
-						},
-						SubjectReference: subjectReference,
-						Permission:       permission,
-						Entity:           entity,
-					})
-					if err != nil {
-						// If an error occurs, add it to the list and continue to the next assertion.
-						list.Add(err.Error())
-						continue
-					}
-
-					// Format the entity, permission, and subject reference as a string for logging.
-					query := tuple.EntityToString(entity) + " " + permission + " " + filter.SubjectReference
-
-					// Check if the actual result matches the expected result.
-					if isSameArray(res.GetSubjectIds(), expected) {
-						// If the results match, log a success message.
-						color.Success.Print("    success:")
-						fmt.Printf(" %v\n", query)
-					} else {
-						// If the results don't match, log a failure message with the expected and actual results.
-						color.Danger.Printf("    fail: %s -> expected: %+v actual: %+v\n", query, expected, res.GetSubjectIds())
-						list.Add(fmt.Sprintf("%s -> expected: %+v actual: %+v", query, expected, res.GetSubjectIds()))
-					}
-				}
-			}
+				c.Actual = fmt.Sprintf("%+v", ids)
+				return assertion.match(ids, expected), nil
+			}))
 		}
+	}
+	return cases
+}
 
-		// If the error list is not empty, there were some errors during processing.
-		if len(list.Errors) != 0 {
-			// Print the errors collected during processing.
-			list.Print()
-			// Exit the program with a status of 1 to indicate an error.
-			os.Exit(1)
+// run - runs the assertion of the case, which sets the actual result of the case and returns whether it is the
+// expected one, and records the outcome and the duration of the case
+func run(c report.Case, assert func(c *report.Case) (bool, error)) report.Case {
+	start := time.Now()
+	passed, err := assert(&c)
+	c.Time = time.Since(start).Seconds()
+	if err != nil {
+		c.Error = err.Error()
+		return c
+	}
+	c.Passed = passed
+	return c
+}
+
+// failed - returns a case of an assertion that could not be evaluated
+func failed(kind, name string, err error) report.Case {
+	return report.Case{Kind: kind, Name: name, Error: err.Error()}
+}
+
+// checkResult - returns the check result as it is printed
+func checkResult(result base.CheckResult) string {
+	if result == base.CheckResult_CHECK_RESULT_ALLOWED {
+		return "ALLOWED"
+	}
+	return "DENIED"
+}
+
+// printSuite - prints the results of the validation of a file, the name of the file is printed if there are others
+func printSuite(suite report.Suite, named bool) {
+	if named {
+		color.Notice.Printf("validating %s... 🚀\n", suite.Name)
+	}
+
+	if suite.Error != "" {
+		color.Danger.Printf("fail: %s\n", validationError(suite.Error))
+		return
+	}
+
+	kinds := map[string][]report.Case{}
+	for _, c := range suite.Setup {
+		kinds[c.Kind] = append(kinds[c.Kind], c)
+	}
+
+	// if debug is true, print schema is creating with color blue
+	color.Notice.Println("schema is creating... 🚀")
+	for _, c := range kinds[report.KindSchema] {
+		if !c.Passed {
+			color.Danger.Printf("fail: %s\n", validationError(c.Error))
+			return
 		}
+		color.Success.Println("  success")
+	}
 
-		// If there are no errors, print the success messages.
-		color.Notice.Println("schema successfully created")
-		color.Notice.Println("relationships successfully created")
-		color.Notice.Println("assertions successfully passed")
+	// if debug is true, print relationships and attributes are creating with color blue
+	for _, setup := range []struct {
+		kind    string
+		message string
+	}{
+		{kind: report.KindRelationship, message: "relationships are creating... 🚀"},
+		{kind: report.KindAttribute, message: "attributes are creating... 🚀"},
+	} {
+		color.Notice.Println(setup.message)
+		for _, c := range kinds[setup.kind] {
+			if c.Passed {
+				color.Success.Println(fmt.Sprintf("  success: %s ", c.Name))
+			} else {
+				color.Danger.Println(fmt.Sprintf("fail: %s failed %s", c.Name, validationError(c.Error)))
+			}
+		}
+	}
 
-		// Final success message to indicate everything completed successfully.
-		color.Success.Println("SUCCESS")
+	// if debug is true, print checking assertions with color blue
+	color.Notice.Println("checking scenarios... 🚀")
+
+	for sn, scenario := range suite.Scenarios {
+		color.Notice.Printf("%v.scenario: %s - %s\n", sn+1, scenario.Name, scenario.Description)
+
+		for _, section := range []struct {
+			kind     string
+			message  string
+			optional bool
+		}{
+			{kind: report.KindCheck, message: "  checks:"},
+			{kind: report.KindEntityFilter, message: "  entity_filters:"},
+			{kind: report.KindSubjectFilter, message: "  subject_filters:"},
+			{kind: report.KindSubjectPermission, message: "  subject_permissions:", optional: true},
+			{kind: report.KindExpand, message: "  expands:", optional: true},
+		} {
+			var cases []report.Case
+			for _, c := range scenario.Cases {
+				if c.Kind == section.kind {
+					cases = append(cases, c)
+				}
+			}
 
-		return nil
+			// the optional sections are only printed if the scenario has them
+			if section.optional && len(cases) == 0 {
+				continue
+			}
+
+			color.Notice.Println(section.message)
+			for _, c := range cases {
+				switch {
+				case c.Passed:
+					color.Success.Print("    success:")
+					fmt.Printf(" %s \n", c.Name)
+				case c.Error != "":
+					color.Danger.Printf("    fail: %s -> %s\n", c.Name, validationError(c.Error))
+				default:
+					color.Danger.Printf("    fail: %s -> %s\n", c.Name, c.Message())
+				}
+			}
+		}
 	}
 }
 
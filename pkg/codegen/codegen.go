@@ -0,0 +1,135 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/ast"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Languages - the languages code can be generated for
+var Languages = []string{"go"}
+
+// Generate - generates the code of the given language for the entities and the rules of a compiled schema
+func Generate(lang string, entities []*base.EntityDefinition, rules []*base.RuleDefinition, opts Options) ([]byte, error) {
+	switch lang {
+	case "go":
+		return Go(entities, rules, opts)
+	default:
+		return nil, fmt.Errorf("unsupported language: %s, must be one of %s", lang, strings.Join(Languages, ", "))
+	}
+}
+
+// Options - options of the generated code
+type Options struct {
+	// Package is the name of the package of the generated code.
+	Package string
+	// BaseImport is the import path of the generated protobuf messages of the Permify API.
+	BaseImport string
+}
+
+// DefaultBaseImport - the import path of the protobuf messages of this module
+const DefaultBaseImport = "github.com/Permify/permify/pkg/pb/base/v1"
+
+// ContextField - a field of the context data read by the rules, such as ip in context.data.ip
+type ContextField struct {
+	Name  string
+	Rules []string
+}
+
+// ContextFields - returns the fields of the context data the rules read, sorted by name
+func ContextFields(rules []*base.RuleDefinition) []ContextField {
+	fields := map[string][]string{}
+
+	for _, rule := range sortedRules(rules) {
+		if rule.GetExpression() == nil {
+			continue
+		}
+
+		seen := map[string]bool{}
+		ast.PreOrderVisit(cel.CheckedExprToAst(rule.GetExpression()).NativeRep().Expr(), ast.NewExprVisitor(func(e ast.Expr) {
+			// matches context.data.<field>, now() is expanded to timestamp(context.data.now) when compiled
+			if e.Kind() != ast.SelectKind {
+				return
+			}
+			data := e.AsSelect().Operand()
+			if data.Kind() != ast.SelectKind || data.AsSelect().FieldName() != "data" {
+				return
+			}
+			context := data.AsSelect().Operand()
+			if context.Kind() != ast.IdentKind || context.AsIdent() != "context" {
+				return
+			}
+
+			name := e.AsSelect().FieldName()
+			if !seen[name] {
+				seen[name] = true
+				fields[name] = append(fields[name], rule.GetName())
+			}
+		}))
+	}
+
+	result := make([]ContextField, 0, len(fields))
+	for name, rules := range fields {
+		result = append(result, ContextField{Name: name, Rules: rules})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// sortedEntities - returns the entities sorted by name
+func sortedEntities(entities []*base.EntityDefinition) []*base.EntityDefinition {
+	sorted := append([]*base.EntityDefinition{}, entities...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetName() < sorted[j].GetName()
+	})
+	return sorted
+}
+
+// sortedRules - returns the rules sorted by name
+func sortedRules(rules []*base.RuleDefinition) []*base.RuleDefinition {
+	sorted := append([]*base.RuleDefinition{}, rules...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetName() < sorted[j].GetName()
+	})
+	return sorted
+}
+
+// sortedKeys - returns the keys of the map in order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// initialisms - the words that are written in upper case in Go identifiers
+var initialisms = map[string]bool{
+	"api": true, "cidr": true, "dns": true, "html": true, "http": true, "https": true, "id": true, "ip": true,
+	"json": true, "sql": true, "ssh": true, "uid": true, "uri": true, "url": true, "uuid": true, "xml": true,
+}
+
+// exported - converts a name of the schema, such as is_public, to an exported Go identifier, such as IsPublic
+func exported(name string) string {
+	var sb strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		if word == "" {
+			continue
+		}
+		if initialisms[strings.ToLower(word)] {
+			sb.WriteString(strings.ToUpper(word))
+			continue
+		}
+		sb.WriteString(strings.ToUpper(word[:1]))
+		sb.WriteString(word[1:])
+	}
+	return sb.String()
+}
@@ -0,0 +1,141 @@
+package codegen
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	dslParser "github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestCodegen(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "codegen suite")
+}
+
+// compile compiles the schema.
+func compile(schema string) ([]*base.EntityDefinition, []*base.RuleDefinition) {
+	sch, err := dslParser.NewParser(schema).Parse()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	entities, rules, err := compiler.NewCompiler(true, sch).Compile()
+	Expect(err).ShouldNot(HaveOccurred())
+	return entities, rules
+}
+
+var _ = Describe("codegen", func() {
+	Context("Go", func() {
+		It("Case 1: entities, relations, permissions and attributes", func() {
+			entities, rules := compile(`
+			entity user {}
+
+			entity organization {
+				relation member @user
+			}
+
+			entity repository {
+				relation parent @organization
+				relation owner @user @organization#member
+
+				attribute is_public boolean
+				attribute ip_ranges string[]
+
+				permission edit = owner or parent.member
+				permission view = is_public or check_ip(ip_ranges)
+			}
+
+			rule check_ip(ip_ranges string[]) {
+				inCidr(context.data.ip, ip_ranges) && now() > timestamp("2024-01-01T00:00:00Z")
+			}
+			`)
+
+			code, err := Generate("go", entities, rules, Options{Package: "acl"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = parser.ParseFile(token.NewFileSet(), "acl.go", code, parser.AllErrors)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, declaration := range []string{
+				"package acl",
+				`base "github.com/Permify/permify/pkg/pb/base/v1"`,
+				"// RepositoryRelationOwner is the owner relation of repository, @user @organization#member.",
+				"func Repository(id string) *base.Entity {",
+				"func UserSubject(id string) *base.Subject {",
+				"func OrganizationMemberSubject(id string) *base.Subject {",
+				"func RepositoryOwnerTuple(id string, subject *base.Subject) *base.Tuple {",
+				"func RepositoryIsPublicAttribute(id string, value bool) (*base.Attribute, error) {",
+				"anypb.New(&base.StringArrayValue{Data: value})",
+				"func CheckRepositoryView(tenantID, id string, subject *base.Subject) *base.PermissionCheckRequest {",
+				"func NewContext(data map[string]interface{}) (*base.Context, error) {",
+			} {
+				Expect(string(code)).Should(ContainSubstring(declaration))
+			}
+
+			for name, value := range map[string]string{
+				"EntityRepository":            "repository",
+				"RepositoryRelationOwner":     "owner",
+				"RepositoryPermissionEdit":    "edit",
+				"RepositoryAttributeIPRanges": "ip_ranges",
+				"ContextIP":                   "ip",
+				"ContextNow":                  "now",
+			} {
+				Expect(string(code)).Should(MatchRegexp(`%s\s+= "%s"`, name, value))
+			}
+		})
+
+		It("Case 2: colliding identifiers", func() {
+			entities, rules := compile(`
+			entity user {
+				relation relation_owner @user
+			}
+
+			entity user_relation {
+				relation owner @user
+			}
+			`)
+
+			_, err := Generate("go", entities, rules, Options{})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("user#relation_owner and user_relation#owner are both generated as UserRelationRelationOwner"))
+		})
+
+		It("Case 3: unsupported language", func() {
+			_, err := Generate("cobol", nil, nil, Options{})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("unsupported language: cobol, must be one of go"))
+		})
+	})
+
+	Context("ContextFields", func() {
+		It("Case 1: fields of the context data read by the rules", func() {
+			_, rules := compile(`
+			entity user {}
+
+			entity document {
+				attribute level integer
+
+				permission view = min_level(level) and in_hours(level)
+			}
+
+			rule min_level(level integer) {
+				level >= context.data.min_level && context.data.tags.exists(t, t == "x")
+			}
+
+			rule in_hours(level integer) {
+				now().getHours() < 18 && level < context.data.min_level
+			}
+			`)
+
+			Expect(ContextFields(rules)).Should(Equal([]ContextField{
+				{Name: "min_level", Rules: []string{"in_hours", "min_level"}},
+				{Name: "now", Rules: []string{"in_hours"}},
+				{Name: "tags", Rules: []string{"min_level"}},
+			}))
+		})
+	})
+})
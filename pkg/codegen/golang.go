@@ -0,0 +1,283 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// goValueTypes - the Go types and the value messages of the attribute types
+var goValueTypes = map[base.AttributeType][2]string{
+	base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN:       {"bool", "BooleanValue"},
+	base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN_ARRAY: {"[]bool", "BooleanArrayValue"},
+	base.AttributeType_ATTRIBUTE_TYPE_STRING:        {"string", "StringValue"},
+	base.AttributeType_ATTRIBUTE_TYPE_STRING_ARRAY:  {"[]string", "StringArrayValue"},
+	base.AttributeType_ATTRIBUTE_TYPE_INTEGER:       {"int32", "IntegerValue"},
+	base.AttributeType_ATTRIBUTE_TYPE_INTEGER_ARRAY: {"[]int32", "IntegerArrayValue"},
+	base.AttributeType_ATTRIBUTE_TYPE_DOUBLE:        {"float64", "DoubleValue"},
+	base.AttributeType_ATTRIBUTE_TYPE_DOUBLE_ARRAY:  {"[]float64", "DoubleArrayValue"},
+}
+
+type (
+	goFile struct {
+		Package       string
+		BaseImport    string
+		HasAttributes bool
+		Entities      []goEntity
+		Subjects      []goSubject
+		Context       []goContextField
+	}
+
+	goEntity struct {
+		Name        string
+		Ident       string
+		Relations   []goRelation
+		Permissions []goStatement
+		Attributes  []goAttribute
+	}
+
+	goStatement struct {
+		Name  string
+		Ident string
+	}
+
+	goRelation struct {
+		goStatement
+		Types string
+	}
+
+	goAttribute struct {
+		goStatement
+		GoType    string
+		ValueType string
+	}
+
+	goSubject struct {
+		Ident    string
+		Entity   string
+		Type     string
+		Relation string
+	}
+
+	goContextField struct {
+		Name  string
+		Ident string
+		Rules string
+	}
+)
+
+// Go - generates Go code with the constants and the constructors of the entities, relations, permissions, attributes
+// and the context fields of the rules of a compiled schema
+func Go(entities []*base.EntityDefinition, rules []*base.RuleDefinition, opts Options) ([]byte, error) {
+	file := goFile{
+		Package:    opts.Package,
+		BaseImport: opts.BaseImport,
+	}
+	if file.Package == "" {
+		file.Package = "permissions"
+	}
+	if file.BaseImport == "" {
+		file.BaseImport = DefaultBaseImport
+	}
+
+	// identifiers maps the declared identifiers to the names they are generated for, to report the collisions
+	identifiers := map[string]string{}
+	declare := func(source string, idents ...string) error {
+		for _, ident := range idents {
+			if other, ok := identifiers[ident]; ok {
+				return fmt.Errorf("%s and %s are both generated as %s", other, source, ident)
+			}
+			identifiers[ident] = source
+		}
+		return nil
+	}
+
+	if err := declare("the generated code", "DefaultDepth", "NewContext"); err != nil {
+		return nil, err
+	}
+
+	subjects := map[string]bool{}
+	for _, en := range sortedEntities(entities) {
+		entity := goEntity{Name: en.GetName(), Ident: exported(en.GetName())}
+		if err := declare(entity.Name, "Entity"+entity.Ident, entity.Ident, entity.Ident+"Subject"); err != nil {
+			return nil, err
+		}
+
+		for _, name := range sortedKeys(en.GetRelations()) {
+			relation := goRelation{goStatement: goStatement{Name: name, Ident: exported(name)}}
+
+			types := make([]string, 0, len(en.GetRelations()[name].GetRelationReferences()))
+			for _, ref := range en.GetRelations()[name].GetRelationReferences() {
+				if ref.GetRelation() == "" {
+					types = append(types, "@"+ref.GetType())
+					continue
+				}
+				types = append(types, fmt.Sprintf("@%s#%s", ref.GetType(), ref.GetRelation()))
+				subjects[ref.GetType()+"#"+ref.GetRelation()] = true
+			}
+			relation.Types = strings.Join(types, " ")
+
+			if err := declare(entity.Name+"#"+name, entity.Ident+"Relation"+relation.Ident, entity.Ident+relation.Ident+"Tuple"); err != nil {
+				return nil, err
+			}
+			entity.Relations = append(entity.Relations, relation)
+		}
+
+		for _, name := range sortedKeys(en.GetPermissions()) {
+			permission := goStatement{Name: name, Ident: exported(name)}
+			if err := declare(entity.Name+"#"+name, entity.Ident+"Permission"+permission.Ident, "Check"+entity.Ident+permission.Ident); err != nil {
+				return nil, err
+			}
+			entity.Permissions = append(entity.Permissions, permission)
+		}
+
+		for _, name := range sortedKeys(en.GetAttributes()) {
+			types, ok := goValueTypes[en.GetAttributes()[name].GetType()]
+			if !ok {
+				return nil, fmt.Errorf("unsupported type of attribute %s#%s: %s", entity.Name, name, en.GetAttributes()[name].GetType())
+			}
+			attribute := goAttribute{goStatement: goStatement{Name: name, Ident: exported(name)}, GoType: types[0], ValueType: types[1]}
+			if err := declare(entity.Name+"#"+name, entity.Ident+"Attribute"+attribute.Ident, entity.Ident+attribute.Ident+"Attribute"); err != nil {
+				return nil, err
+			}
+			entity.Attributes = append(entity.Attributes, attribute)
+			file.HasAttributes = true
+		}
+
+		file.Entities = append(file.Entities, entity)
+	}
+
+	// the subjects with relations, such as organization#member, get their own constructors
+	for _, key := range sortedKeys(subjects) {
+		typ, relation, _ := strings.Cut(key, "#")
+		subject := goSubject{Ident: exported(typ) + exported(relation) + "Subject", Entity: exported(typ), Type: typ, Relation: relation}
+		if err := declare(key, subject.Ident); err != nil {
+			return nil, err
+		}
+		file.Subjects = append(file.Subjects, subject)
+	}
+
+	for _, field := range ContextFields(rules) {
+		f := goContextField{Name: field.Name, Ident: "Context" + exported(field.Name), Rules: strings.Join(field.Rules, ", ")}
+		if err := declare("context.data."+field.Name, f.Ident); err != nil {
+			return nil, err
+		}
+		file.Context = append(file.Context, f)
+	}
+
+	var buf bytes.Buffer
+	if err := goTemplate.Execute(&buf, file); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// goTemplate - the template of the generated Go code
+var goTemplate = template.Must(template.New("go").Parse(`// Code generated by permify codegen. DO NOT EDIT.
+
+// Package {{.Package}} contains the names of the entities, relations, permissions, attributes and the context fields
+// of a Permify schema, together with the constructors of the messages of the Permify API using them.
+package {{.Package}}
+
+import (
+	{{- if .HasAttributes}}
+	"google.golang.org/protobuf/types/known/anypb"
+	{{- end}}
+	"google.golang.org/protobuf/types/known/structpb"
+
+	base "{{.BaseImport}}"
+)
+
+// DefaultDepth is the depth of the permission check requests.
+const DefaultDepth = 20
+
+// Entity types.
+const (
+{{- range .Entities}}
+	Entity{{.Ident}} = "{{.Name}}"
+{{- end}}
+)
+{{range $e := .Entities}}
+{{- if or .Relations .Permissions .Attributes}}
+// Relations, permissions and attributes of {{.Name}}.
+const (
+{{- range .Relations}}
+	// {{$e.Ident}}Relation{{.Ident}} is the {{.Name}} relation of {{$e.Name}}, {{.Types}}.
+	{{$e.Ident}}Relation{{.Ident}} = "{{.Name}}"
+{{- end}}
+{{- range .Permissions}}
+	{{$e.Ident}}Permission{{.Ident}} = "{{.Name}}"
+{{- end}}
+{{- range .Attributes}}
+	{{$e.Ident}}Attribute{{.Ident}} = "{{.Name}}"
+{{- end}}
+)
+{{end}}
+// {{.Ident}} returns the {{.Name}} with the given id.
+func {{.Ident}}(id string) *base.Entity {
+	return &base.Entity{Type: Entity{{.Ident}}, Id: id}
+}
+
+// {{.Ident}}Subject returns the {{.Name}} with the given id as a subject.
+func {{.Ident}}Subject(id string) *base.Subject {
+	return &base.Subject{Type: Entity{{.Ident}}, Id: id}
+}
+{{range .Relations}}
+// {{$e.Ident}}{{.Ident}}Tuple returns the tuple relating the subject to the {{$e.Name}} with the given id as {{.Name}}.
+func {{$e.Ident}}{{.Ident}}Tuple(id string, subject *base.Subject) *base.Tuple {
+	return &base.Tuple{Entity: {{$e.Ident}}(id), Relation: {{$e.Ident}}Relation{{.Ident}}, Subject: subject}
+}
+{{end}}
+{{- range .Attributes}}
+// {{$e.Ident}}{{.Ident}}Attribute returns the {{.Name}} attribute of the {{$e.Name}} with the given id.
+func {{$e.Ident}}{{.Ident}}Attribute(id string, value {{.GoType}}) (*base.Attribute, error) {
+	v, err := anypb.New(&base.{{.ValueType}}{Data: value})
+	if err != nil {
+		return nil, err
+	}
+	return &base.Attribute{Entity: {{$e.Ident}}(id), Attribute: {{$e.Ident}}Attribute{{.Ident}}, Value: v}, nil
+}
+{{end}}
+{{- range .Permissions}}
+// Check{{$e.Ident}}{{.Ident}} returns the request checking whether the subject has the {{.Name}} permission on the
+// {{$e.Name}} with the given id.
+func Check{{$e.Ident}}{{.Ident}}(tenantID, id string, subject *base.Subject) *base.PermissionCheckRequest {
+	return &base.PermissionCheckRequest{
+		TenantId:   tenantID,
+		Metadata:   &base.PermissionCheckRequestMetadata{Depth: DefaultDepth},
+		Entity:     {{$e.Ident}}(id),
+		Permission: {{$e.Ident}}Permission{{.Ident}},
+		Subject:    subject,
+	}
+}
+{{end}}
+{{- end}}
+{{- range .Subjects}}
+// {{.Ident}} returns the {{.Relation}} relation of the {{.Type}} with the given id as a subject.
+func {{.Ident}}(id string) *base.Subject {
+	return &base.Subject{Type: Entity{{.Entity}}, Id: id, Relation: "{{.Relation}}"}
+}
+{{end}}
+{{- if .Context}}
+// Fields of the context data read by the rules.
+const (
+{{- range .Context}}
+	// {{.Ident}} is read by {{.Rules}}.
+	{{.Ident}} = "{{.Name}}"
+{{- end}}
+)
+{{end}}
+// NewContext returns the context of a request with the given data, keyed by the context fields.
+func NewContext(data map[string]interface{}) (*base.Context, error) {
+	d, err := structpb.NewStruct(data)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Context{Data: d}, nil
+}
+`))
@@ -36,6 +36,11 @@ func (i *TupleIterator) GetNext() *base.Tuple {
 	return nil
 }
 
+// Len - Number of remaining tuples
+func (i *TupleIterator) Len() int {
+	return len(i.tuples) - i.index
+}
+
 // UniqueTupleIterator combines two TupleIterators and ensures that only unique Tuples are returned.
 // Uniqueness is based on the Tuple's pointer address.
 type UniqueTupleIterator struct {
@@ -226,3 +231,8 @@ func (u *AttributeIterator) GetNext() *base.Attribute {
 	}
 	return nil
 }
+
+// Len - Number of remaining attributes
+func (u *AttributeIterator) Len() int {
+	return len(u.attributes) - u.index
+}
@@ -44,6 +44,11 @@ func TestTupleIterator(t *testing.T) {
 	// Create a tuple iterator
 	tupleIterator := tupleCollection.CreateTupleIterator()
 
+	// Test Len() method
+	if tupleIterator.Len() != 3 {
+		t.Errorf("Expected 3 for Len(), but got %d", tupleIterator.Len())
+	}
+
 	// Test HasNext() and GetNext() methods
 	if !tupleIterator.HasNext() {
 		t.Error("Expected true for HasNext(), but got false")
@@ -51,6 +56,9 @@ func TestTupleIterator(t *testing.T) {
 	if tupleIterator.GetNext() != tuple1 {
 		t.Error("Expected tuple1 for GetNext(), but got something else")
 	}
+	if tupleIterator.Len() != 2 {
+		t.Errorf("Expected 2 for Len(), but got %d", tupleIterator.Len())
+	}
 	if !tupleIterator.HasNext() {
 		t.Error("Expected true for HasNext(), but got false")
 	}
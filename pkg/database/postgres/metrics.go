@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
+)
+
+var meter = otel.Meter("database.postgres")
+
+// registerPoolMetrics - Registers gauges reporting the statistics of the read and write pools on every collection
+func registerPoolMetrics(pools map[string]*pgxpool.Pool) (api.Registration, error) {
+	acquiredConns, err := meter.Int64ObservableGauge("pool_acquired_connections", api.WithDescription("Number of currently acquired connections in the pool"))
+	if err != nil {
+		return nil, err
+	}
+	idleConns, err := meter.Int64ObservableGauge("pool_idle_connections", api.WithDescription("Number of currently idle connections in the pool"))
+	if err != nil {
+		return nil, err
+	}
+	totalConns, err := meter.Int64ObservableGauge("pool_total_connections", api.WithDescription("Total number of connections currently in the pool"))
+	if err != nil {
+		return nil, err
+	}
+	maxConns, err := meter.Int64ObservableGauge("pool_max_connections", api.WithDescription("Maximum size of the pool"))
+	if err != nil {
+		return nil, err
+	}
+	acquireCount, err := meter.Int64ObservableCounter("pool_acquire_count", api.WithDescription("Cumulative count of successful acquires from the pool"))
+	if err != nil {
+		return nil, err
+	}
+	emptyAcquireCount, err := meter.Int64ObservableCounter("pool_empty_acquire_count", api.WithDescription("Cumulative count of acquires that waited for a connection because the pool was empty"))
+	if err != nil {
+		return nil, err
+	}
+	canceledAcquireCount, err := meter.Int64ObservableCounter("pool_canceled_acquire_count", api.WithDescription("Cumulative count of acquires canceled by a context"))
+	if err != nil {
+		return nil, err
+	}
+	acquireDuration, err := meter.Int64ObservableCounter("pool_acquire_duration", api.WithUnit("microseconds"), api.WithDescription("Total duration of all successful acquires from the pool in microseconds"))
+	if err != nil {
+		return nil, err
+	}
+
+	return meter.RegisterCallback(func(_ context.Context, o api.Observer) error {
+		for name, pool := range pools {
+			stat := pool.Stat()
+			attrs := api.WithAttributes(attribute.String("pool", name))
+
+			o.ObserveInt64(acquiredConns, int64(stat.AcquiredConns()), attrs)
+			o.ObserveInt64(idleConns, int64(stat.IdleConns()), attrs)
+			o.ObserveInt64(totalConns, int64(stat.TotalConns()), attrs)
+			o.ObserveInt64(maxConns, int64(stat.MaxConns()), attrs)
+			o.ObserveInt64(acquireCount, stat.AcquireCount(), attrs)
+			o.ObserveInt64(emptyAcquireCount, stat.EmptyAcquireCount(), attrs)
+			o.ObserveInt64(canceledAcquireCount, stat.CanceledAcquireCount(), attrs)
+			o.ObserveInt64(acquireDuration, stat.AcquireDuration().Microseconds(), attrs)
+		}
+		return nil
+	}, acquiredConns, idleConns, totalConns, maxConns, acquireCount, emptyAcquireCount, canceledAcquireCount, acquireDuration)
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/Masterminds/squirrel"
+
+	api "go.opentelemetry.io/otel/metric"
 )
 
 // Postgres - Structure for Postresql instance
@@ -25,6 +27,8 @@ type Postgres struct {
 	WritePool *pgxpool.Pool
 
 	Builder squirrel.StatementBuilderType
+	// poolMetrics is the registration of the pool statistics gauges
+	poolMetrics api.Registration
 	// options
 	maxDataPerWrite       int
 	maxRetries            int
@@ -114,6 +118,17 @@ func newDB(writerUri, readerUri string, opts ...Option) (*Postgres, error) {
 		return nil, err
 	}
 
+	// Report the statistics of both pools as metrics.
+	pg.poolMetrics, err = registerPoolMetrics(map[string]*pgxpool.Pool{
+		"write": pg.WritePool,
+		"read":  pg.ReadPool,
+	})
+	if err != nil {
+		pg.WritePool.Close()
+		pg.ReadPool.Close()
+		return nil, err
+	}
+
 	return pg, nil
 }
 
@@ -136,6 +151,11 @@ func (p *Postgres) GetEngineType() string {
 
 // Close - Close postgresql instance
 func (p *Postgres) Close() error {
+	if p.poolMetrics != nil {
+		if err := p.poolMetrics.Unregister(); err != nil {
+			slog.Error("failed to unregister pool metrics", slog.Any("error", err))
+		}
+	}
 	p.ReadPool.Close()
 	p.WritePool.Close()
 	return nil
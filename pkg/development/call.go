@@ -0,0 +1,192 @@
+package development
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/pkg/development/coverage"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// method - A method of the development container, called with a JSON request and returning the JSON result
+type method func(ctx context.Context, c *Development, request []byte) ([]byte, []Error)
+
+// methods - the methods of Call by their names
+var methods = map[string]method{
+	"check": permissionMethod(invoke.Invoker.Check, func(request *v1.PermissionCheckRequest) {
+		request.TenantId = tenantID(request.GetTenantId())
+		if request.Metadata == nil {
+			request.Metadata = &v1.PermissionCheckRequestMetadata{}
+		}
+		request.Metadata.SnapToken = snapToken(request.Metadata.GetSnapToken())
+		request.Metadata.Depth = depth(request.Metadata.GetDepth())
+	}),
+	"expand": permissionMethod(invoke.Invoker.Expand, func(request *v1.PermissionExpandRequest) {
+		request.TenantId = tenantID(request.GetTenantId())
+		if request.Metadata == nil {
+			request.Metadata = &v1.PermissionExpandRequestMetadata{}
+		}
+		request.Metadata.SnapToken = snapToken(request.Metadata.GetSnapToken())
+	}),
+	"lookupEntity": permissionMethod(invoke.Invoker.LookupEntity, func(request *v1.PermissionLookupEntityRequest) {
+		request.TenantId = tenantID(request.GetTenantId())
+		if request.Metadata == nil {
+			request.Metadata = &v1.PermissionLookupEntityRequestMetadata{}
+		}
+		request.Metadata.SnapToken = snapToken(request.Metadata.GetSnapToken())
+		request.Metadata.Depth = depth(request.Metadata.GetDepth())
+	}),
+	"lookupSubject": permissionMethod(invoke.Invoker.LookupSubject, func(request *v1.PermissionLookupSubjectRequest) {
+		request.TenantId = tenantID(request.GetTenantId())
+		if request.Metadata == nil {
+			request.Metadata = &v1.PermissionLookupSubjectRequestMetadata{}
+		}
+		request.Metadata.SnapToken = snapToken(request.Metadata.GetSnapToken())
+		request.Metadata.Depth = depth(request.Metadata.GetDepth())
+	}),
+	"subjectPermission": permissionMethod(invoke.Invoker.SubjectPermission, func(request *v1.PermissionSubjectPermissionRequest) {
+		request.TenantId = tenantID(request.GetTenantId())
+		if request.Metadata == nil {
+			request.Metadata = &v1.PermissionSubjectPermissionRequestMetadata{}
+		}
+		request.Metadata.SnapToken = snapToken(request.Metadata.GetSnapToken())
+		request.Metadata.Depth = depth(request.Metadata.GetDepth())
+	}),
+	"coverage": func(ctx context.Context, c *Development, _ []byte) ([]byte, []Error) {
+		// the branches are recorded by the checks of the scenarios, on a new container with a branch recorder
+		recorder := coverage.NewBranchRecorder()
+		if errors := NewContainer(engines.CheckBranchRecorder(recorder)).RunWithShape(ctx, c.shape); hasSchemaError(errors) {
+			return nil, errors
+		}
+		return marshal(coverage.RunWithBranches(*c.shape, recorder))
+	},
+	"lint": func(_ context.Context, c *Development, _ []byte) ([]byte, []Error) {
+		warnings, errors := Lint(c.shape)
+		if len(errors) > 0 {
+			return nil, errors
+		}
+		return marshal(warnings)
+	},
+}
+
+// Methods - Returns the names of the methods of Call
+func Methods() []string {
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Call - Calls the method of the container with the JSON request against the shape loaded by Run or RunWithShape.
+// The permission methods, check, expand, lookupEntity, lookupSubject and subjectPermission, take their requests of the
+// API and return their responses, with the tenant t1, the latest snapshot and a depth of 100 if they are not set.
+// coverage and lint take no request and return the coverage and the lint warnings of the shape. The errors of the
+// schema have the positions they are at.
+func (c *Development) Call(ctx context.Context, name string, request []byte) ([]byte, []Error) {
+	m, ok := methods[name]
+	if !ok {
+		return nil, []Error{{
+			Type:    "call",
+			Key:     name,
+			Message: fmt.Sprintf("unknown method, must be one of %s", strings.Join(Methods(), ", ")),
+		}}
+	}
+
+	if c.shape == nil {
+		return nil, []Error{{
+			Type:    "call",
+			Key:     name,
+			Message: "no shape is loaded, run a shape first",
+		}}
+	}
+
+	return m(ctx, c, request)
+}
+
+// permissionMethod - Returns the method decoding the request of the API, setting its defaults, validating it and
+// encoding the response of the invoker
+func permissionMethod[T interface {
+	proto.Message
+	Validate() error
+}, R proto.Message](call func(invoke.Invoker, context.Context, T) (R, error), defaults func(T)) method {
+	return func(ctx context.Context, c *Development, request []byte) ([]byte, []Error) {
+		var req T
+		req = req.ProtoReflect().Type().New().Interface().(T)
+
+		if len(request) > 0 {
+			if err := protojson.Unmarshal(request, req); err != nil {
+				return nil, []Error{{Type: "request", Key: "", Message: err.Error()}}
+			}
+		}
+
+		defaults(req)
+		if err := req.Validate(); err != nil {
+			return nil, []Error{{Type: "request", Key: "", Message: err.Error()}}
+		}
+
+		res, err := call(c.Container.Invoker, ctx, req)
+		if err != nil {
+			return nil, []Error{{Type: "call", Key: "", Message: err.Error()}}
+		}
+
+		out, err := protojson.Marshal(res)
+		if err != nil {
+			return nil, []Error{{Type: "call", Key: "", Message: err.Error()}}
+		}
+		return out, nil
+	}
+}
+
+// marshal - Returns the JSON of the result
+func marshal(result any) ([]byte, []Error) {
+	out, err := json.Marshal(result)
+	if err != nil {
+		return nil, []Error{{Type: "call", Key: "", Message: err.Error()}}
+	}
+	return out, nil
+}
+
+// hasSchemaError - Returns whether the shape could not be loaded because of its schema
+func hasSchemaError(errors []Error) bool {
+	for _, e := range errors {
+		if e.Type == "schema" {
+			return true
+		}
+	}
+	return false
+}
+
+// tenantID - Returns the tenant, t1 if it is empty
+func tenantID(tenant string) string {
+	if tenant == "" {
+		return "t1"
+	}
+	return tenant
+}
+
+// snapToken - Returns the snap token, the token of the latest snapshot if it is empty
+func snapToken(snap string) string {
+	if snap == "" {
+		return token.NewNoopToken().Encode().String()
+	}
+	return snap
+}
+
+// depth - Returns the depth, 100 if it is not set
+func depth(d int32) int32 {
+	if d == 0 {
+		return 100
+	}
+	return d
+}
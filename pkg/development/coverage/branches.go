@@ -0,0 +1,172 @@
+package coverage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// BranchRecorder - Collects the results of the children of the permissions evaluated by the check engine, such as
+// each operand of a union, intersection or exclusion, a tuple to user set or a rule call. It is passed to the check
+// engine with engines.CheckBranchRecorder.
+type BranchRecorder struct {
+	mu sync.Mutex
+	// results holds the outcomes of the children by their paths, by the permissions
+	results map[string]map[string]*outcomes
+}
+
+// outcomes - The results a child is evaluated to
+type outcomes struct {
+	allowed bool
+	denied  bool
+}
+
+// NewBranchRecorder - Creates a new branch recorder
+func NewBranchRecorder() *BranchRecorder {
+	return &BranchRecorder{
+		results: map[string]map[string]*outcomes{},
+	}
+}
+
+// RecordBranch - Records the result of the child at the given path of the permission of the entity type
+func (r *BranchRecorder) RecordBranch(entityType, permission string, path []int, allowed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := fmt.Sprintf("%s#%s", entityType, permission)
+	if _, ok := r.results[key]; !ok {
+		r.results[key] = map[string]*outcomes{}
+	}
+
+	p := pathKey(path)
+	if _, ok := r.results[key][p]; !ok {
+		r.results[key][p] = &outcomes{}
+	}
+
+	if allowed {
+		r.results[key][p].allowed = true
+	} else {
+		r.results[key][p].denied = true
+	}
+}
+
+// outcomes - Returns the recorded results of the child at the given path of the permission
+func (r *BranchRecorder) outcomes(key string, path []int) outcomes {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if o, ok := r.results[key][pathKey(path)]; ok {
+		return *o
+	}
+	return outcomes{}
+}
+
+// Branch - A child of a permission, identified by the indexes of the children leading to it
+type Branch struct {
+	Path       []int
+	Expression string
+}
+
+// branches - Returns the child of the permission and all of its descendants, parents before their children
+func branches(child *base.Child, path []int) []Branch {
+	result := []Branch{{Path: path, Expression: expression(child, false)}}
+	for i, c := range child.GetRewrite().GetChildren() {
+		result = append(result, branches(c, append(append(make([]int, 0, len(path)+1), path...), i))...)
+	}
+	return result
+}
+
+// branchCoverage - Calculates the branch coverage of the permissions of the entity, every branch is covered once it
+// is evaluated both to true and to false
+func branchCoverage(entity *base.EntityDefinition, recorder *BranchRecorder) (uncovered map[string][]string, percents map[string]int) {
+	uncovered = map[string][]string{}
+	percents = map[string]int{}
+
+	for name, permission := range entity.GetPermissions() {
+		key := fmt.Sprintf("%s#%s", entity.GetName(), name)
+
+		bs := branches(permission.GetChild(), []int{})
+		outcomesTotal, outcomesCovered := 2*len(bs), 0
+
+		for _, b := range bs {
+			o := recorder.outcomes(key, b.Path)
+
+			var missing []string
+			if o.allowed {
+				outcomesCovered++
+			} else {
+				missing = append(missing, "true")
+			}
+			if o.denied {
+				outcomesCovered++
+			} else {
+				missing = append(missing, "false")
+			}
+
+			if len(missing) > 0 {
+				uncovered[name] = append(uncovered[name], fmt.Sprintf("%s (never %s)", b.Expression, strings.Join(missing, " or ")))
+			}
+		}
+
+		percents[name] = (outcomesCovered * 100) / outcomesTotal
+	}
+
+	return uncovered, percents
+}
+
+// expression - Returns the child in the schema language, nested rewrites are put in parentheses
+func expression(child *base.Child, nested bool) string {
+	if rewrite := child.GetRewrite(); rewrite != nil {
+		operator := " or "
+		switch rewrite.GetRewriteOperation() {
+		case base.Rewrite_OPERATION_INTERSECTION:
+			operator = " and "
+		case base.Rewrite_OPERATION_EXCLUSION:
+			operator = " not "
+		}
+
+		operands := make([]string, 0, len(rewrite.GetChildren()))
+		for _, c := range rewrite.GetChildren() {
+			operands = append(operands, expression(c, true))
+		}
+
+		if nested {
+			return "(" + strings.Join(operands, operator) + ")"
+		}
+		return strings.Join(operands, operator)
+	}
+
+	leaf := child.GetLeaf()
+	switch {
+	case leaf.GetComputedUserSet() != nil:
+		return leaf.GetComputedUserSet().GetRelation()
+	case leaf.GetTupleToUserSet() != nil:
+		return leaf.GetTupleToUserSet().GetTupleSet().GetRelation() + "." + leaf.GetTupleToUserSet().GetComputed().GetRelation()
+	case leaf.GetComputedAttribute() != nil:
+		return leaf.GetComputedAttribute().GetName()
+	case leaf.GetCall() != nil:
+		arguments := make([]string, 0, len(leaf.GetCall().GetArguments()))
+		for _, argument := range leaf.GetCall().GetArguments() {
+			if related := argument.GetTupleToComputedAttribute(); related != nil {
+				arguments = append(arguments, related.GetTupleSet().GetRelation()+"."+related.GetComputed().GetName())
+				continue
+			}
+			arguments = append(arguments, argument.GetComputedAttribute().GetName())
+		}
+		return fmt.Sprintf("%s(%s)", leaf.GetCall().GetRuleName(), strings.Join(arguments, ", "))
+	default:
+		return ""
+	}
+}
+
+// pathKey - Returns the path as a string, such as 0.1
+func pathKey(path []int) string {
+	parts := make([]string, len(path))
+	for i, index := range path {
+		parts[i] = strconv.Itoa(index)
+	}
+	return strings.Join(parts, ".")
+}
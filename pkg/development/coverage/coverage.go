@@ -8,7 +8,6 @@ import (
 	"github.com/Permify/permify/pkg/attribute"
 	"github.com/Permify/permify/pkg/development/file"
 	"github.com/Permify/permify/pkg/dsl/compiler"
-	"github.com/Permify/permify/pkg/dsl/parser"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/tuple"
 )
@@ -19,6 +18,7 @@ type SchemaCoverageInfo struct {
 	TotalRelationshipsCoverage int
 	TotalAttributesCoverage    int
 	TotalAssertionsCoverage    int
+	TotalBranchesCoverage      int
 }
 
 // EntityCoverageInfo - Entity coverage info
@@ -33,6 +33,9 @@ type EntityCoverageInfo struct {
 
 	UncoveredAssertions       map[string][]string
 	CoverageAssertionsPercent map[string]int
+
+	UncoveredBranches       map[string][]string
+	CoverageBranchesPercent map[string]int
 }
 
 // SchemaCoverage
@@ -78,8 +81,20 @@ type SchemaCoverage struct {
 	Assertions    []string
 }
 
+// Run - Calculates the coverage of the schema by the relationships, attributes and assertions of the shape
 func Run(shape file.Shape) SchemaCoverageInfo {
-	p, err := parser.NewParser(shape.Schema).Parse()
+	return run(shape, nil)
+}
+
+// RunWithBranches - Calculates the coverage like Run, together with the coverage of the branches of the permissions
+// by the checks recorded while the scenarios of the shape were run
+func RunWithBranches(shape file.Shape, recorder *BranchRecorder) SchemaCoverageInfo {
+	return run(shape, recorder)
+}
+
+// run - Calculates the coverage of the schema, the branches are calculated only if a recorder is given
+func run(shape file.Shape, recorder *BranchRecorder) SchemaCoverageInfo {
+	p, err := shape.ParseSchema()
 	if err != nil {
 		return SchemaCoverageInfo{}
 	}
@@ -97,7 +112,7 @@ func Run(shape file.Shape) SchemaCoverageInfo {
 	}
 
 	// Iterate through the schema coverage references
-	for _, ref := range refs {
+	for i, ref := range refs {
 		// Initialize EntityCoverageInfo for the current entity
 		entityCoverageInfo := EntityCoverageInfo{
 			EntityName:                   ref.EntityName,
@@ -105,6 +120,8 @@ func Run(shape file.Shape) SchemaCoverageInfo {
 			UncoveredAttributes:          []string{},
 			CoverageAssertionsPercent:    map[string]int{},
 			UncoveredAssertions:          map[string][]string{},
+			CoverageBranchesPercent:      map[string]int{},
+			UncoveredBranches:            map[string][]string{},
 			CoverageRelationshipsPercent: 0,
 			CoverageAttributesPercent:    0,
 		}
@@ -153,6 +170,11 @@ func Run(shape file.Shape) SchemaCoverageInfo {
 			)
 		}
 
+		// Calculate branches coverage for each permission
+		if recorder != nil {
+			entityCoverageInfo.UncoveredBranches, entityCoverageInfo.CoverageBranchesPercent = branchCoverage(definitions[i], recorder)
+		}
+
 		schemaCoverageInfo.EntityCoverageInfo = append(schemaCoverageInfo.EntityCoverageInfo, entityCoverageInfo)
 	}
 
@@ -161,6 +183,7 @@ func Run(shape file.Shape) SchemaCoverageInfo {
 	schemaCoverageInfo.TotalRelationshipsCoverage = relationshipsCoverage
 	schemaCoverageInfo.TotalAttributesCoverage = attributesCoverage
 	schemaCoverageInfo.TotalAssertionsCoverage = assertionsCoverage
+	schemaCoverageInfo.TotalBranchesCoverage = calculateTotalBranchesCoverage(schemaCoverageInfo.EntityCoverageInfo)
 
 	return schemaCoverageInfo
 }
@@ -210,6 +233,25 @@ func calculateTotalCoverage(entities []EntityCoverageInfo) (int, int, int) {
 	return totalRelationshipsCoverage, totalAttributesCoverage, totalAssertionsCoverage
 }
 
+// calculateTotalBranchesCoverage - Calculate total branches coverage as the average of the coverages of the permissions
+func calculateTotalBranchesCoverage(entities []EntityCoverageInfo) int {
+	totalPermissions := 0
+	totalCoveredBranches := 0
+
+	for _, entity := range entities {
+		for _, branchesPercent := range entity.CoverageBranchesPercent {
+			totalPermissions++
+			totalCoveredBranches += branchesPercent
+		}
+	}
+
+	if totalPermissions == 0 {
+		return 100
+	}
+
+	return totalCoveredBranches / totalPermissions
+}
+
 // References - Get references for a given entity
 func references(entity *base.EntityDefinition) (coverage SchemaCoverage) {
 	// Set the entity name in the coverage struct
@@ -321,6 +363,13 @@ func assertions(en string, checks []file.Check, filters []file.EntityFilter) []s
 			// Append the formatted permission string to the asrts slice
 			asrts = append(asrts, fmt.Sprintf("%s#%s", assertion.EntityType, permission))
 		}
+
+		// The permissions of the contained and the excluded entities are asserted as well
+		for _, partial := range []map[string][]string{assertion.Contains, assertion.Excludes} {
+			for permission := range partial {
+				asrts = append(asrts, fmt.Sprintf("%s#%s", assertion.EntityType, permission))
+			}
+		}
 	}
 
 	// Return the asrts slice containing the collected assertions
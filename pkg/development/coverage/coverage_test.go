@@ -1,12 +1,16 @@
-package coverage
+package coverage_test
 
 import (
+	"context"
 	"sort"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/pkg/development"
+	"github.com/Permify/permify/pkg/development/coverage"
 	"github.com/Permify/permify/pkg/development/file"
 )
 
@@ -19,7 +23,7 @@ func TestCoverage(t *testing.T) {
 var _ = Describe("coverage", func() {
 	Context("Run", func() {
 		It("Case 1: Github Simplified", func() {
-			sci := Run(file.Shape{
+			sci := coverage.Run(file.Shape{
 				Schema: `
 		entity user {}
 		
@@ -117,7 +121,7 @@ var _ = Describe("coverage", func() {
 		})
 
 		It("Case 2: Google Docs Simplified", func() {
-			sci := Run(file.Shape{
+			sci := coverage.Run(file.Shape{
 				Schema: `
 		entity user {}
 		
@@ -248,7 +252,7 @@ var _ = Describe("coverage", func() {
 		})
 
 		It("Case 3: Facebook Groups", func() {
-			sci := Run(file.Shape{
+			sci := coverage.Run(file.Shape{
 				Schema: `
     entity user {}
 
@@ -506,6 +510,80 @@ var _ = Describe("coverage", func() {
 			Expect(sci.EntityCoverageInfo[8].CoverageAssertionsPercent["scenario 1"]).Should(Equal(100))
 		})
 	})
+
+	Context("RunWithBranches", func() {
+		It("Case 1: Exclusion", func() {
+			shape := file.Shape{
+				Schema: `
+		entity user {}
+
+		entity organization {
+		   relation admin @user
+		}
+
+		entity repository {
+		   relation parent @organization
+		   relation owner @user
+		   relation banned @user
+
+		   attribute is_public boolean
+
+		   permission edit = (owner or parent.admin) not banned
+		   permission view = is_public(is_public) or edit
+		}
+
+		rule is_public(is_public boolean) {
+		   is_public
+		}
+		`,
+				Relationships: []string{
+					"repository:1#owner@user:1",
+					"repository:1#owner@user:2",
+					"repository:1#banned@user:2",
+				},
+				Scenarios: []file.Scenario{
+					{
+						Name: "scenario 1",
+						Checks: []file.Check{
+							{
+								Entity:     "repository:1",
+								Subject:    "user:1",
+								Assertions: map[string]bool{"edit": true},
+							},
+							{
+								Entity:     "repository:1",
+								Subject:    "user:2",
+								Assertions: map[string]bool{"edit": false},
+							},
+						},
+					},
+				},
+			}
+
+			recorder := coverage.NewBranchRecorder()
+			Expect(development.NewContainer(engines.CheckBranchRecorder(recorder)).RunWithShape(context.Background(), &shape)).Should(BeEmpty())
+
+			sci := coverage.RunWithBranches(shape, recorder)
+
+			Expect(sci.EntityCoverageInfo[2].EntityName).Should(Equal("repository"))
+			Expect(sci.EntityCoverageInfo[2].UncoveredBranches["edit"]).Should(Equal([]string{
+				"owner or parent.admin (never false)",
+				"owner (never false)",
+				"parent.admin (never true)",
+			}))
+			Expect(sci.EntityCoverageInfo[2].CoverageBranchesPercent["edit"]).Should(Equal(70))
+			Expect(sci.EntityCoverageInfo[2].UncoveredBranches["view"]).Should(Equal([]string{
+				"is_public(is_public) or edit (never true or false)",
+				"is_public(is_public) (never true or false)",
+				"edit (never true or false)",
+			}))
+			Expect(sci.EntityCoverageInfo[2].CoverageBranchesPercent["view"]).Should(Equal(0))
+			Expect(sci.TotalBranchesCoverage).Should(Equal(35))
+
+			// the branches are not calculated without a recorder
+			Expect(coverage.Run(shape).EntityCoverageInfo[2].CoverageBranchesPercent).Should(BeEmpty())
+		})
+	})
 })
 
 // isSameArray - check if two arrays are the same
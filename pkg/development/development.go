@@ -2,6 +2,7 @@ package development
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -25,7 +26,7 @@ import (
 	"github.com/Permify/permify/pkg/database"
 	"github.com/Permify/permify/pkg/development/file"
 	"github.com/Permify/permify/pkg/dsl/compiler"
-	"github.com/Permify/permify/pkg/dsl/parser"
+	dslToken "github.com/Permify/permify/pkg/dsl/token"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/token"
 	"github.com/Permify/permify/pkg/tuple"
@@ -33,9 +34,13 @@ import (
 
 type Development struct {
 	Container *servers.Container
+
+	// shape is the shape last loaded by RunWithShape, the methods of Call query it
+	shape *file.Shape
 }
 
-func NewContainer() *Development {
+// NewContainer - creates a development container on an in-memory database, the options configure its check engine
+func NewContainer(opts ...engines.CheckOption) *Development {
 	var err error
 
 	// Create a new in-memory database using the factories package
@@ -60,7 +65,7 @@ func NewContainer() *Development {
 	tenantWriter := factories.TenantWriterFactory(db)
 
 	// Create instances of engines
-	checkEngine := engines.NewCheckEngine(schemaReader, dataReader)
+	checkEngine := engines.NewCheckEngine(schemaReader, dataReader, opts...)
 	expandEngine := engines.NewExpandEngine(schemaReader, dataReader)
 	lookupEngine := engines.NewLookupEngine(checkEngine, schemaReader, dataReader)
 	subjectPermissionEngine := engines.NewSubjectPermission(checkEngine, schemaReader)
@@ -109,6 +114,29 @@ type Error struct {
 	Type    string `json:"type"`
 	Key     any    `json:"key"`
 	Message string `json:"message"`
+	// File, Line and Column are the position of the error in the schema, for the errors of the schema with a
+	// position. File is the shape file, or empty if the shape is not read from a file, for the errors of the schema
+	// itself, it is the imported file of the error otherwise.
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+// schemaError - Returns the error of the schema with its position, if the parser or the compiler reported one
+func schemaError(err error) Error {
+	e := Error{
+		Type:    "schema",
+		Key:     "",
+		Message: err.Error(),
+	}
+
+	var positioned *dslToken.Error
+	if errors.As(err, &positioned) {
+		e.File = positioned.PositionInfo.File
+		e.Line = positioned.PositionInfo.LinePosition
+		e.Column = positioned.PositionInfo.Column()
+	}
+	return e
 }
 
 func (c *Development) Run(ctx context.Context, shape map[string]interface{}) (errors []Error) {
@@ -138,26 +166,23 @@ func (c *Development) Run(ctx context.Context, shape map[string]interface{}) (er
 	return c.RunWithShape(ctx, s)
 }
 
+// RunWithShape - Writes the schema, the relationships and the attributes of the shape and runs its scenarios, the
+// imports of the schema are relative to the shape file
 func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (errors []Error) {
-	// Parse the schema using the parser library
-	sch, err := parser.NewParser(shape.Schema).Parse()
+	c.shape = shape
+
+	// Parse the schema together with the files it imports, relative to the shape file
+	sch, err := shape.ParseSchema()
 	if err != nil {
-		errors = append(errors, Error{
-			Type:    "schema",
-			Key:     "",
-			Message: err.Error(),
-		})
+		errors = append(errors, schemaError(err))
 		return
 	}
 
 	// Compile the parsed schema
-	_, _, err = compiler.NewCompiler(true, sch).Compile()
+	comp := compiler.NewCompiler(true, sch)
+	_, _, err = comp.Compile()
 	if err != nil {
-		errors = append(errors, Error{
-			Type:    "schema",
-			Key:     "",
-			Message: err.Error(),
-		})
+		errors = append(errors, schemaError(err))
 		return
 	}
 
@@ -167,11 +192,16 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 	// Create a slice of SchemaDefinitions, one for each statement in the schema
 	cnf := make([]storage.SchemaDefinition, 0, len(sch.Statements))
 	for _, st := range sch.Statements {
+		serialized, err := comp.Serialize(st)
+		if err != nil {
+			errors = append(errors, schemaError(err))
+			return
+		}
 		cnf = append(cnf, storage.SchemaDefinition{
 			TenantID:             "t1",
 			Version:              version,
 			Name:                 st.GetName(),
-			SerializedDefinition: []byte(st.String()),
+			SerializedDefinition: []byte(serialized),
 		})
 	}
 
@@ -321,6 +351,11 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 				Relation: ear.GetRelation(),
 			}
 
+			depth := check.Depth
+			if depth == 0 {
+				depth = 100
+			}
+
 			// Each Assertion in the current check is processed
 			for permission, expected := range check.Assertions {
 				exp := v1.CheckResult_CHECK_RESULT_ALLOWED
@@ -334,7 +369,7 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 					Metadata: &v1.PermissionCheckRequestMetadata{
 						SchemaVersion: version,
 						SnapToken:     token.NewNoopToken().Encode().String(),
-						Depth:         100,
+						Depth:         depth,
 					},
 					Context:    cont,
 					Entity:     entity,
@@ -377,6 +412,39 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 					})
 				}
 			}
+
+			// Each expected error in the current check is processed
+			for permission, expected := range check.Errors {
+				// A Permission Check is made, it is expected to fail with the error
+				_, err := c.Container.Invoker.Check(ctx, &v1.PermissionCheckRequest{
+					TenantId: "t1",
+					Metadata: &v1.PermissionCheckRequestMetadata{
+						SchemaVersion: version,
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						Depth:         depth,
+					},
+					Context:    cont,
+					Entity:     entity,
+					Permission: permission,
+					Subject:    subject,
+				})
+
+				query := tuple.SubjectToString(subject) + " " + permission + " " + tuple.EntityToString(entity)
+
+				// Check if the permission check failed with the expected error
+				if err == nil || !strings.Contains(err.Error(), expected) {
+					actualStr := "no error"
+					if err != nil {
+						actualStr = err.Error()
+					}
+
+					errors = append(errors, Error{
+						Type:    "scenarios",
+						Key:     i,
+						Message: fmt.Sprintf("Query: %s, Expected: %s, Actual: %s", query, expected, actualStr),
+					})
+				}
+			}
 		}
 
 		// Each EntityFilter in the current scenario is processed
@@ -407,10 +475,8 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 				Relation: ear.GetRelation(),
 			}
 
-			// Each Assertion in the current filter is processed
-
-			for permission, expected := range filter.Assertions {
-				// Perform a lookup for the entity with the given subject and permission
+			// lookup performs a lookup for the entities with the given subject and permission
+			lookup := func(permission string) ([]string, error) {
 				res, err := c.Container.Invoker.LookupEntity(ctx, &v1.PermissionLookupEntityRequest{
 					TenantId: "t1",
 					Metadata: &v1.PermissionLookupEntityRequestMetadata{
@@ -423,6 +489,12 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 					Permission: permission,
 					Subject:    subject,
 				})
+				return res.GetEntityIds(), err
+			}
+
+			// Each Assertion in the current filter is processed
+			for permission, expected := range filter.Assertions {
+				ids, err := lookup(permission)
 				if err != nil {
 					errors = append(errors, Error{
 						Type:    "scenarios",
@@ -435,9 +507,9 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 				query := tuple.SubjectToString(subject) + " " + permission + " " + filter.EntityType
 
 				// Check if the actual result of the entity lookup does NOT match the expected result
-				if !isSameArray(res.GetEntityIds(), expected) {
+				if !isSameArray(ids, expected) {
 					expectedStr := strings.Join(expected, ", ")
-					actualStr := strings.Join(res.GetEntityIds(), ", ")
+					actualStr := strings.Join(ids, ", ")
 
 					errorMsg := fmt.Sprintf("Query: %s, Expected: [%s], Actual: [%s]", query, expectedStr, actualStr)
 
@@ -448,6 +520,11 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 					})
 				}
 			}
+
+			// The contained and the excluded entities of the filter are processed
+			errors = append(errors, partialFilterErrors(i, filter.Contains, filter.Excludes, lookup, func(permission string) string {
+				return tuple.SubjectToString(subject) + " " + permission + " " + filter.EntityType
+			})...)
 		}
 
 		// Each SubjectFilter in the current scenario is processed
@@ -483,9 +560,8 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 				continue
 			}
 
-			// Each Assertion in the current filter is processed
-			for permission, expected := range filter.Assertions {
-				// Perform a lookup for the subject with the given entity and permission
+			// lookup performs a lookup for the subjects with the given entity and permission
+			lookup := func(permission string) ([]string, error) {
 				res, err := c.Container.Invoker.LookupSubject(ctx, &v1.PermissionLookupSubjectRequest{
 					TenantId: "t1",
 					Metadata: &v1.PermissionLookupSubjectRequestMetadata{
@@ -498,6 +574,12 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 					Permission:       permission,
 					Entity:           entity,
 				})
+				return res.GetSubjectIds(), err
+			}
+
+			// Each Assertion in the current filter is processed
+			for permission, expected := range filter.Assertions {
+				ids, err := lookup(permission)
 				if err != nil {
 					errors = append(errors, Error{
 						Type:    "scenarios",
@@ -510,9 +592,9 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 				query := tuple.EntityToString(entity) + " " + permission + " " + filter.SubjectReference
 
 				// Check if the actual result of the subject lookup does NOT match the expected result
-				if !isSameArray(res.GetSubjectIds(), expected) {
+				if !isSameArray(ids, expected) {
 					expectedStr := strings.Join(expected, ", ")
-					actualStr := strings.Join(res.GetSubjectIds(), ", ")
+					actualStr := strings.Join(ids, ", ")
 
 					errorMsg := fmt.Sprintf("Query: %s, Expected: [%s], Actual: [%s]", query, expectedStr, actualStr)
 
@@ -523,12 +605,295 @@ func (c *Development) RunWithShape(ctx context.Context, shape *file.Shape) (erro
 					})
 				}
 			}
+
+			// The contained and the excluded subjects of the filter are processed
+			errors = append(errors, partialFilterErrors(i, filter.Contains, filter.Excludes, lookup, func(permission string) string {
+				return tuple.EntityToString(entity) + " " + permission + " " + filter.SubjectReference
+			})...)
+		}
+
+		// Each SubjectPermission in the current scenario is processed
+		for _, sp := range scenario.SubjectPermissions {
+			entity, err := tuple.E(sp.Entity)
+			if err != nil {
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     i,
+					Message: err.Error(),
+				})
+				continue
+			}
+
+			ear, err := tuple.EAR(sp.Subject)
+			if err != nil {
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     i,
+					Message: err.Error(),
+				})
+				continue
+			}
+
+			cont, err := Context(sp.Context)
+			if err != nil {
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     i,
+					Message: err.Error(),
+				})
+				continue
+			}
+
+			subject := &v1.Subject{
+				Type:     ear.GetEntity().GetType(),
+				Id:       ear.GetEntity().GetId(),
+				Relation: ear.GetRelation(),
+			}
+
+			// The permissions of the subject on the entity are checked together
+			res, err := c.Container.Invoker.SubjectPermission(ctx, &v1.PermissionSubjectPermissionRequest{
+				TenantId: "t1",
+				Metadata: &v1.PermissionSubjectPermissionRequestMetadata{
+					SchemaVersion:  version,
+					SnapToken:      token.NewNoopToken().Encode().String(),
+					OnlyPermission: sp.OnlyPermission,
+					Depth:          100,
+				},
+				Context: cont,
+				Entity:  entity,
+				Subject: subject,
+			})
+			if err != nil {
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     i,
+					Message: err.Error(),
+				})
+				continue
+			}
+
+			query := tuple.SubjectToString(subject) + " " + tuple.EntityToString(entity)
+
+			// Check if the returned results are the same as the expected ones
+			expectedStr, actualStr := SubjectPermissionResults(sp.Assertions), SubjectPermissionResults(CheckResults(res.GetResults()))
+			if expectedStr != actualStr {
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     i,
+					Message: fmt.Sprintf("Query: %s, Expected: {%s}, Actual: {%s}", query, expectedStr, actualStr),
+				})
+			}
+		}
+
+		// Each Expand in the current scenario is processed
+		for _, ex := range scenario.Expands {
+			entity, err := tuple.E(ex.Entity)
+			if err != nil {
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     i,
+					Message: err.Error(),
+				})
+				continue
+			}
+
+			cont, err := Context(ex.Context)
+			if err != nil {
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     i,
+					Message: err.Error(),
+				})
+				continue
+			}
+
+			// The permission of the entity is expanded
+			res, err := c.Container.Invoker.Expand(ctx, &v1.PermissionExpandRequest{
+				TenantId: "t1",
+				Metadata: &v1.PermissionExpandRequestMetadata{
+					SchemaVersion: version,
+					SnapToken:     token.NewNoopToken().Encode().String(),
+				},
+				Context:    cont,
+				Entity:     entity,
+				Permission: ex.Permission,
+			})
+			if err != nil {
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     i,
+					Message: err.Error(),
+				})
+				continue
+			}
+
+			// Check if the tree is the same as the snapshot
+			if actual := ExpandTree(res.GetTree()); !SameTree(ex.Tree, actual) {
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     i,
+					Message: fmt.Sprintf("Query: %s#%s, Expected:\n%s\nActual:\n%s", tuple.EntityToString(entity), ex.Permission, ex.Tree, actual),
+				})
+			}
 		}
 	}
 
 	return
 }
 
+// Write - Validates the relationships and the attributes against the head version of the schema loaded by
+// RunWithShape and writes them. Nothing is written if one of them is not valid, the errors of all of them are
+// returned.
+func (c *Development) Write(ctx context.Context, relationships, attributes []string) (errors []Error) {
+	version, err := c.Container.SR.HeadVersion(ctx, "t1")
+	if err != nil {
+		return []Error{{Type: "schema", Key: "", Message: err.Error()}}
+	}
+
+	tuples := database.NewTupleCollection()
+	for _, t := range relationships {
+		tup, err := tuple.Tuple(t)
+		if err == nil {
+			var definition *v1.EntityDefinition
+			definition, _, err = c.Container.SR.ReadEntityDefinition(ctx, "t1", tup.GetEntity().GetType(), version)
+			if err == nil {
+				err = validation.ValidateTuple(definition, tup)
+			}
+		}
+		if err != nil {
+			errors = append(errors, Error{Type: "relationships", Key: t, Message: err.Error()})
+			continue
+		}
+		tuples.Add(tup)
+	}
+
+	attrs := database.NewAttributeCollection()
+	for _, a := range attributes {
+		attr, err := attribute.Attribute(a)
+		if err == nil {
+			var definition *v1.EntityDefinition
+			definition, _, err = c.Container.SR.ReadEntityDefinition(ctx, "t1", attr.GetEntity().GetType(), version)
+			if err == nil {
+				err = validation.ValidateAttribute(definition, attr)
+			}
+		}
+		if err != nil {
+			errors = append(errors, Error{Type: "attributes", Key: a, Message: err.Error()})
+			continue
+		}
+		attrs.Add(attr)
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	if _, err = c.Container.DW.Write(ctx, "t1", tuples, attrs); err != nil {
+		return []Error{{Type: "data", Key: "", Message: err.Error()}}
+	}
+	return nil
+}
+
+// partialFilterErrors - Returns the errors of the contained and the excluded ids of a filter, the lookup returns the
+// ids of a permission and the query describes the filter of a permission
+func partialFilterErrors(key int, contains, excludes map[string][]string, lookup func(permission string) ([]string, error), query func(permission string) string) (errors []Error) {
+	for _, assertion := range []struct {
+		expected map[string][]string
+		contains bool
+	}{
+		{expected: contains, contains: true},
+		{expected: excludes, contains: false},
+	} {
+		for permission, expected := range assertion.expected {
+			ids, err := lookup(permission)
+			if err != nil {
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     key,
+					Message: err.Error(),
+				})
+				continue
+			}
+
+			// Check if any of the ids are missing or present, depending on the assertion
+			var wrong []string
+			if assertion.contains {
+				wrong = Missing(ids, expected)
+			} else {
+				wrong = Present(ids, expected)
+			}
+
+			if len(wrong) > 0 {
+				sort.Strings(ids)
+
+				verb := "Contains"
+				if !assertion.contains {
+					verb = "Excludes"
+				}
+				errors = append(errors, Error{
+					Type:    "scenarios",
+					Key:     key,
+					Message: fmt.Sprintf("Query: %s, %s: [%s], Actual: [%s]", query(permission), verb, strings.Join(expected, ", "), strings.Join(ids, ", ")),
+				})
+			}
+		}
+	}
+	return errors
+}
+
+// Missing - Returns the expected ids that are not among the actual ones
+func Missing(actual, expected []string) (missing []string) {
+	found := make(map[string]bool, len(actual))
+	for _, id := range actual {
+		found[id] = true
+	}
+	for _, id := range expected {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// Present - Returns the excluded ids that are among the actual ones
+func Present(actual, excluded []string) (present []string) {
+	found := make(map[string]bool, len(actual))
+	for _, id := range actual {
+		found[id] = true
+	}
+	for _, id := range excluded {
+		if found[id] {
+			present = append(present, id)
+		}
+	}
+	return present
+}
+
+// CheckResults - Returns the results of a subject permission request as booleans
+func CheckResults(results map[string]v1.CheckResult) map[string]bool {
+	m := make(map[string]bool, len(results))
+	for key, result := range results {
+		m[key] = result == v1.CheckResult_CHECK_RESULT_ALLOWED
+	}
+	return m
+}
+
+// SubjectPermissionResults - Returns the results of a subject permission request sorted by their keys, such as
+// "edit: false, view: true", to compare and print them
+func SubjectPermissionResults(results map[string]bool) string {
+	keys := make([]string, 0, len(results))
+	for key := range results {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s: %t", key, results[key]))
+	}
+	return strings.Join(parts, ", ")
+}
+
 // Context is a function that takes a file context and returns a base context and an error.
 func Context(fileContext file.Context) (cont *v1.Context, err error) {
 	// Initialize an empty base context to be populated from the file context.
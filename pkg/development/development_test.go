@@ -0,0 +1,287 @@
+package development_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Permify/permify/pkg/development"
+	"github.com/Permify/permify/pkg/development/file"
+)
+
+// TestDevelopment -
+func TestDevelopment(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "development-suite")
+}
+
+// negativeShape - a shape with error assertions, partial filters, subject permissions and an expand snapshot
+const negativeShape = `
+schema: >-
+  entity user {}
+
+  entity organization {
+    relation admin @user
+    relation member @user
+  }
+
+  entity document {
+    relation org @organization
+    relation owner @user
+    relation viewer @user @organization#member
+    attribute public boolean
+    permission edit = owner or org.admin
+    permission view = viewer or edit or public
+    permission deep = deeper
+    permission deeper = deepest
+    permission deepest = view
+  }
+relationships:
+  - organization:1#admin@user:1
+  - organization:1#member@user:3
+  - document:1#org@organization:1
+  - document:1#owner@user:2
+  - document:1#viewer@organization:1#member
+  - document:2#owner@user:2
+attributes:
+  - document:1$public|boolean:false
+scenarios:
+  - name: negative
+    description: errors and partial filters
+    checks:
+      - entity: document:1
+        subject: user:1
+        depth: 3
+        assertions:
+          edit: true
+        errors:
+          deep: ERROR_CODE_DEPTH_NOT_ENOUGH
+      - entity: document:1
+        subject: user:1
+        errors:
+          unknown: ERROR_CODE
+    entity_filters:
+      - entity_type: document
+        subject: user:2
+        contains:
+          edit: ["1"]
+        excludes:
+          view: ["3"]
+    subject_filters:
+      - subject_reference: user
+        entity: document:1
+        contains:
+          view: ["3"]
+        excludes:
+          edit: ["3"]
+    subject_permissions:
+      - entity: document:1
+        subject: user:3
+        only_permission: true
+        assertions:
+          edit: false
+          view: true
+          deep: true
+          deeper: true
+          deepest: true
+    expands:
+      - entity: document:1
+        permission: edit
+        tree: |
+          document:1#edit union
+            document:1#owner
+              user:2
+            document:1#org union
+              organization:1#admin
+                user:1
+`
+
+// decode - decodes the shape and applies the change to it
+func decode(change func(s *file.Shape)) *file.Shape {
+	s := &file.Shape{}
+	Expect(yaml.Unmarshal([]byte(negativeShape), s)).ShouldNot(HaveOccurred())
+	change(s)
+	return s
+}
+
+var _ = Describe("development", func() {
+	Context("RunWithShape", func() {
+		It("Case 1: negative and partial assertions pass", func() {
+			errors := development.NewContainer().RunWithShape(context.Background(), decode(func(s *file.Shape) {}))
+			Expect(errors).Should(BeEmpty())
+		})
+
+		It("Case 2: negative and partial assertions fail", func() {
+			errors := development.NewContainer().RunWithShape(context.Background(), decode(func(s *file.Shape) {
+				scenario := &s.Scenarios[0]
+				scenario.Checks[0].Errors["deep"] = "ERROR_CODE_TIMEOUT"
+				scenario.EntityFilters[0].Contains["edit"] = []string{"1", "5"}
+				scenario.SubjectFilters[0].Excludes["edit"] = []string{"2"}
+				scenario.SubjectPermissions[0].Assertions["edit"] = true
+				scenario.Expands[0].Tree = "document:1#edit union"
+			}))
+
+			messages := make([]string, 0, len(errors))
+			for _, e := range errors {
+				messages = append(messages, e.Message)
+			}
+
+			Expect(messages).Should(ConsistOf(
+				"Query: user:1 deep document:1, Expected: ERROR_CODE_TIMEOUT, Actual: ERROR_CODE_DEPTH_NOT_ENOUGH",
+				"Query: user:2 edit document, Contains: [1, 5], Actual: [1, 2]",
+				"Query: document:1 edit user, Excludes: [2], Actual: [1, 2]",
+				"Query: user:3 document:1, Expected: {deep: true, deeper: true, deepest: true, edit: true, view: true}, Actual: {deep: true, deeper: true, deepest: true, edit: false, view: true}",
+				"Query: document:1#edit, Expected:\ndocument:1#edit union\nActual:\ndocument:1#edit union\n  document:1#owner\n    user:2\n  document:1#org union\n    organization:1#admin\n      user:1\n",
+			))
+		})
+
+		It("Case 3: the imports of the schema are relative to the shape file", func() {
+			dir := GinkgoT().TempDir()
+			Expect(os.MkdirAll(filepath.Join(dir, "common"), 0o755)).Should(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "common", "user.perm"), []byte("entity user {}\n"), 0o600)).Should(Succeed())
+
+			shape := &file.Shape{
+				Name:          filepath.Join(dir, "shape.yaml"),
+				Schema:        "import \"common/user.perm\"\n\nentity document {\n  relation owner @user\n  relation viewer @user\n  permission view = owner\n}",
+				Relationships: []string{"document:1#owner@user:1"},
+				Scenarios: []file.Scenario{{
+					Name:   "imports",
+					Checks: []file.Check{{Entity: "document:1", Subject: "user:1", Assertions: map[string]bool{"view": true}}},
+				}},
+			}
+
+			dev := development.NewContainer()
+			Expect(dev.RunWithShape(context.Background(), shape)).Should(BeEmpty())
+
+			result, errors := dev.Call(context.Background(), "coverage", nil)
+			Expect(errors).Should(BeEmpty())
+			Expect(result).Should(ContainSubstring(`"EntityName":"user"`))
+
+			warnings, errors := development.Lint(shape)
+			Expect(errors).Should(BeEmpty())
+			Expect(warnings).Should(ConsistOf(development.Warning{
+				Rule:       "unused-relation",
+				Definition: "document",
+				Name:       "viewer",
+				Message:    "relation document#viewer is not used by any permission or relation",
+				File:       shape.Name,
+				Line:       5,
+				Column:     12,
+			}))
+
+			// the imports cannot be found relative to the working directory
+			shape.Name = ""
+			errors = development.NewContainer().RunWithShape(context.Background(), shape)
+			Expect(errors).Should(HaveLen(1))
+			Expect(errors[0].Message).Should(ContainSubstring("import common/user.perm cannot be loaded"))
+		})
+	})
+
+	Context("Call", func() {
+		It("Case 1: permission methods query the loaded shape", func() {
+			dev := development.NewContainer()
+			Expect(dev.RunWithShape(context.Background(), decode(func(s *file.Shape) {}))).Should(BeEmpty())
+
+			result, errors := dev.Call(context.Background(), "check", []byte(`{"entity": {"type": "document", "id": "1"}, "permission": "edit", "subject": {"type": "user", "id": "1"}}`))
+			Expect(errors).Should(BeEmpty())
+			Expect(result).Should(MatchJSON(`{"can": "CHECK_RESULT_ALLOWED", "metadata": {"check_count": 2}}`))
+
+			result, errors = dev.Call(context.Background(), "lookupEntity", []byte(`{"entity_type": "document", "permission": "edit", "subject": {"type": "user", "id": "2"}}`))
+			Expect(errors).Should(BeEmpty())
+			Expect(result).Should(MatchJSON(`{"entity_ids": ["1", "2"]}`))
+
+			result, errors = dev.Call(context.Background(), "subjectPermission", []byte(`{"entity": {"type": "document", "id": "2"}, "subject": {"type": "user", "id": "2"}, "metadata": {"only_permission": true}}`))
+			Expect(errors).Should(BeEmpty())
+			Expect(result).Should(ContainSubstring(`"edit":"CHECK_RESULT_ALLOWED"`))
+
+			result, errors = dev.Call(context.Background(), "expand", []byte(`{"entity": {"type": "document", "id": "2"}, "permission": "edit"}`))
+			Expect(errors).Should(BeEmpty())
+			Expect(result).Should(ContainSubstring(`"permission":"edit"`))
+
+			_, errors = dev.Call(context.Background(), "check", []byte(`{"entity": {"type": "document", "id": "1"}, "permission": "can edit", "subject": {"type": "user", "id": "1"}}`))
+			Expect(errors).Should(HaveLen(1))
+			Expect(errors[0].Type).Should(Equal("request"))
+		})
+
+		It("Case 2: coverage and lint of the loaded shape", func() {
+			dev := development.NewContainer()
+			Expect(dev.RunWithShape(context.Background(), decode(func(s *file.Shape) {}))).Should(BeEmpty())
+
+			result, errors := dev.Call(context.Background(), "coverage", nil)
+			Expect(errors).Should(BeEmpty())
+			Expect(result).Should(ContainSubstring(`"TotalRelationshipsCoverage"`))
+
+			result, errors = dev.Call(context.Background(), "lint", nil)
+			Expect(errors).Should(BeEmpty())
+			Expect(result).Should(MatchJSON(`[{
+				"rule": "unused-permission",
+				"definition": "document",
+				"name": "deep",
+				"message": "permission document#deep is neither used by any permission nor asserted in any scenario",
+				"line": 13,
+				"column": 14
+			}]`))
+		})
+
+		It("Case 3: unknown methods and shapes that are not loaded", func() {
+			_, errors := development.NewContainer().Call(context.Background(), "check", nil)
+			Expect(errors).Should(HaveLen(1))
+			Expect(errors[0].Message).Should(Equal("no shape is loaded, run a shape first"))
+
+			_, errors = development.NewContainer().Call(context.Background(), "delete", nil)
+			Expect(errors).Should(HaveLen(1))
+			Expect(errors[0].Message).Should(ContainSubstring("unknown method, must be one of check, coverage, expand, lint"))
+		})
+
+		It("Case 4: schema errors have positions", func() {
+			dev := development.NewContainer()
+			errors := dev.RunWithShape(context.Background(), &file.Shape{Schema: "entity user {}\n\nentity document {\n  permission view = owner\n}"})
+			Expect(errors).Should(HaveLen(1))
+			Expect(errors[0].Line).Should(Equal(4))
+			Expect(errors[0].Column).Should(Equal(21))
+
+			_, errors = dev.Call(context.Background(), "lint", nil)
+			Expect(errors).Should(HaveLen(1))
+			Expect(errors[0].Line).Should(Equal(4))
+
+			errors = dev.RunWithShape(context.Background(), &file.Shape{Schema: "entity user {}\n\nentity document {\n  relation owner @user\n  relation owner @user\n}"})
+			Expect(errors).Should(HaveLen(1))
+			Expect(errors[0].Message).Should(Equal("6:2:duplication found for document#owner"))
+			Expect(errors[0].File).Should(BeEmpty())
+			Expect(errors[0].Line).Should(Equal(6))
+			Expect(errors[0].Column).Should(Equal(1))
+
+			errors = dev.RunWithShape(context.Background(), &file.Shape{Schema: "entity user {}\n\nentity document {\n  relation owner @team\n}"})
+			Expect(errors).Should(HaveLen(1))
+			Expect(errors[0].Line).Should(Equal(4))
+			Expect(errors[0].Column).Should(Equal(19))
+		})
+	})
+
+	Context("Lint", func() {
+		It("Case 1: warnings have the positions of their definitions", func() {
+			warnings, errors := development.Lint(&file.Shape{Schema: "entity user {}\n\nentity document {\n  relation owner @user\n  relation viewer @user\n  permission view = owner\n}"})
+			Expect(errors).Should(BeEmpty())
+			Expect(warnings).Should(ContainElement(development.Warning{
+				Rule:       "unused-relation",
+				Definition: "document",
+				Name:       "viewer",
+				Message:    "relation document#viewer is not used by any permission or relation",
+				Line:       5,
+				Column:     12,
+			}))
+		})
+	})
+
+	Context("SameTree", func() {
+		It("Case 1: trailing spaces and empty lines are ignored", func() {
+			Expect(development.SameTree("a union\n  b\n\n", "a union  \n  b")).Should(BeTrue())
+			Expect(development.SameTree("a union\n  b", "a union\n b")).Should(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,187 @@
+package development
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Permify/permify/pkg/attribute"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// ExpandTree - Renders the expand tree as indented text to be compared with the trees of the shape files. Every node
+// is written as entity#permission, followed by the operation of the node or the sorted subjects or values of the
+// leaf, indented by two spaces for each level, such as:
+//
+//	document:1#view union
+//	  document:1#owner
+//	    user:1
+//	  document:1#editor
+//	    user:2
+func ExpandTree(tree *v1.Expand) string {
+	var sb strings.Builder
+	writeExpand(&sb, tree, 0, nil)
+	return sb.String()
+}
+
+// ExplainTree - Renders the expand tree like ExpandTree with whether the subject is in each node at the end of its
+// line, ✓ if it is, ✗ if it is not and ? if it depends on the values of attributes. The subject is marked with ✓ in
+// the subjects of the leaves, such as:
+//
+//	document:1#view union ✓
+//	  document:1#owner ✗
+//	    user:1
+//	  document:1#editor ✓
+//	    user:2 ✓
+func ExplainTree(tree *v1.Expand, subject *v1.Subject) string {
+	var sb strings.Builder
+	writeExpand(&sb, tree, 0, subject)
+	return sb.String()
+}
+
+// TreeContains - Returns whether the subject is in the tree, ok is false if the tree has values of attributes
+func TreeContains(tree *v1.Expand, subject *v1.Subject) (found, ok bool) {
+	if node := tree.GetExpand(); node != nil {
+		results := make([]bool, 0, len(node.GetChildren()))
+		for _, child := range node.GetChildren() {
+			found, ok := TreeContains(child, subject)
+			if !ok {
+				return false, false
+			}
+			results = append(results, found)
+		}
+
+		switch node.GetOperation() {
+		case v1.ExpandTreeNode_OPERATION_INTERSECTION:
+			return len(results) > 0 && !slices.Contains(results, false), true
+		case v1.ExpandTreeNode_OPERATION_EXCLUSION:
+			return len(results) > 0 && results[0] && !slices.Contains(results[1:], true), true
+		default:
+			return slices.Contains(results, true), true
+		}
+	}
+
+	if tree.GetLeaf().GetSubjects() == nil {
+		return false, false
+	}
+
+	for _, s := range tree.GetLeaf().GetSubjects().GetSubjects() {
+		if sameSubject(s, subject) {
+			return true, true
+		}
+	}
+	return false, true
+}
+
+// sameSubject - Returns whether the subject of a leaf is the subject, the subject without a relation is the same as
+// the subject of a leaf with the ellipsis relation
+func sameSubject(leaf, subject *v1.Subject) bool {
+	if leaf.GetType() != subject.GetType() || leaf.GetId() != subject.GetId() {
+		return false
+	}
+	return leaf.GetRelation() == subject.GetRelation() || (subject.GetRelation() == "" && leaf.GetRelation() == tuple.ELLIPSIS)
+}
+
+// explanation - Returns the mark of whether the subject is in the node
+func explanation(node *v1.Expand, subject *v1.Subject) string {
+	found, ok := TreeContains(node, subject)
+	switch {
+	case !ok:
+		return "?"
+	case found:
+		return "✓"
+	default:
+		return "✗"
+	}
+}
+
+// SameTree - Returns true if the trees are the same, ignoring the trailing spaces of the lines and the empty lines
+func SameTree(a, b string) bool {
+	return normalizeTree(a) == normalizeTree(b)
+}
+
+// writeExpand - Writes the node and its children at the given depth, with whether the subject is in them if the
+// subject is not nil
+func writeExpand(sb *strings.Builder, node *v1.Expand, depth int, subject *v1.Subject) {
+	indent := strings.Repeat("  ", depth)
+
+	line := tuple.EntityToString(node.GetEntity()) + "#" + node.GetPermission()
+	if len(node.GetArguments()) > 0 {
+		arguments := make([]string, 0, len(node.GetArguments()))
+		for _, argument := range node.GetArguments() {
+			if related := argument.GetTupleToComputedAttribute(); related != nil {
+				arguments = append(arguments, related.GetTupleSet().GetRelation()+"."+related.GetComputed().GetName())
+				continue
+			}
+			arguments = append(arguments, argument.GetComputedAttribute().GetName())
+		}
+		line += "(" + strings.Join(arguments, ", ") + ")"
+	}
+
+	tree := node.GetExpand()
+	if tree != nil {
+		line += " " + operation(tree.GetOperation())
+	}
+	if subject != nil {
+		line += " " + explanation(node, subject)
+	}
+	sb.WriteString(indent + line + "\n")
+
+	if tree != nil {
+		for _, child := range tree.GetChildren() {
+			writeExpand(sb, child, depth+1, subject)
+		}
+		return
+	}
+
+	var values []string
+	leaf := node.GetLeaf()
+	switch {
+	case leaf.GetSubjects() != nil:
+		for _, s := range leaf.GetSubjects().GetSubjects() {
+			value := tuple.SubjectToString(s)
+			if subject != nil && sameSubject(s, subject) {
+				value += " ✓"
+			}
+			values = append(values, value)
+		}
+	case leaf.GetValues() != nil:
+		for key, value := range leaf.GetValues().GetValues() {
+			values = append(values, key+" = "+attribute.AnyToString(value))
+		}
+	case leaf.GetValue() != nil:
+		values = append(values, "= "+attribute.AnyToString(leaf.GetValue()))
+	}
+	sort.Strings(values)
+
+	for _, value := range values {
+		sb.WriteString(indent + "  " + value + "\n")
+	}
+}
+
+// operation - Returns the name of the operation of a node
+func operation(op v1.ExpandTreeNode_Operation) string {
+	switch op {
+	case v1.ExpandTreeNode_OPERATION_UNION:
+		return "union"
+	case v1.ExpandTreeNode_OPERATION_INTERSECTION:
+		return "intersection"
+	case v1.ExpandTreeNode_OPERATION_EXCLUSION:
+		return "exclusion"
+	default:
+		return "unspecified"
+	}
+}
+
+// normalizeTree - Removes the trailing spaces of the lines and the empty lines of the tree
+func normalizeTree(tree string) string {
+	var lines []string
+	for _, line := range strings.Split(tree, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
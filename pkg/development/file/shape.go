@@ -1,20 +1,38 @@
 package file
 
+import (
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/schema"
+)
+
 // Shape is a struct that represents an authorization configuration.
 type Shape struct {
-	TenantID string `yaml:"tenant_id"`
+	// Name is the path or the URL of the shape file the imports of the schema are relative to, it is empty if the
+	// shape is not read from a file.
+	Name string `yaml:"-"`
+
+	TenantID string `yaml:"tenant_id,omitempty"`
 
 	// Schema is a string that represents the authorization model schema.
 	Schema string `yaml:"schema"`
 
 	// Relationships is a slice of strings that represent the authorization relationships.
-	Relationships []string `yaml:"relationships"`
+	Relationships []string `yaml:"relationships,omitempty"`
 
 	// Attributes is a slice of strings that represent the authorization attributes.
-	Attributes []string `yaml:"attributes"`
+	Attributes []string `yaml:"attributes,omitempty"`
 
 	// Scenarios is a slice of Scenario structs that represent the different authorization scenarios.
-	Scenarios []Scenario `yaml:"scenarios"`
+	Scenarios []Scenario `yaml:"scenarios,omitempty"`
+}
+
+// ParseSchema parses the schema of the shape together with the files it imports, relative to the shape file.
+func (s *Shape) ParseSchema() (*ast.Schema, error) {
+	modules, err := schema.NewLocalSchemaLoader().ResolveModules(schema.Module{Name: s.Name, Content: s.Schema}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return schema.Parse(modules...)
 }
 
 // Scenario is a struct that represents a specific authorization scenario.
@@ -23,34 +41,40 @@ type Scenario struct {
 	Name string `yaml:"name"`
 
 	// Description is a string that provides a brief explanation of the scenario.
-	Description string `yaml:"description"`
+	Description string `yaml:"description,omitempty"`
 
 	// Checks is a slice of Check structs that represent the authorization checks to be performed.
-	Checks []Check `yaml:"checks"`
+	Checks []Check `yaml:"checks,omitempty"`
 
 	// EntityFilters is a slice of Filter structs that represent the filters to be applied during the checks.
-	EntityFilters []EntityFilter `yaml:"entity_filters"`
+	EntityFilters []EntityFilter `yaml:"entity_filters,omitempty"`
 
 	// SubjectFilters is a slice of Filter structs that represent the filters to be applied during the checks.
-	SubjectFilters []SubjectFilter `yaml:"subject_filters"`
+	SubjectFilters []SubjectFilter `yaml:"subject_filters,omitempty"`
+
+	// SubjectPermissions is a slice of SubjectPermission structs that represent the permissions of subjects to be checked.
+	SubjectPermissions []SubjectPermission `yaml:"subject_permissions,omitempty"`
+
+	// Expands is a slice of Expand structs that represent the expanded permissions to be compared with their trees.
+	Expands []Expand `yaml:"expands,omitempty"`
 }
 
 // Context represents a structure with context data.
 type Context struct {
 	// Tuples is a slice of strings, each representing a tuple in the context.
-	Tuples []string `yaml:"tuples"`
+	Tuples []string `yaml:"tuples,omitempty"`
 
 	// Attributes is a slice of strings, each representing an attribute in the context.
-	Attributes []string `yaml:"attributes"`
+	Attributes []string `yaml:"attributes,omitempty"`
 
 	// Data is a map where each key-value pair represents additional context data.
-	Data map[string]interface{} `yaml:"data"`
+	Data map[string]interface{} `yaml:"data,omitempty"`
 }
 
 // Check is a struct that represents an individual authorization check.
 type Check struct {
 	// Context is a struct that represents the context of the authorization check.
-	Context Context `yaml:"context"`
+	Context Context `yaml:"context,omitempty"`
 
 	// Entity is a string that represents the entity type involved in the authorization check.
 	Entity string `yaml:"entity"`
@@ -58,14 +82,21 @@ type Check struct {
 	// Subject is a string that represents the subject of the authorization check.
 	Subject string `yaml:"subject"`
 
+	// Depth is the depth of the authorization check, 100 if it is not set.
+	Depth int32 `yaml:"depth,omitempty"`
+
 	// Assertions is a map that contains the authorization assertions to be evaluated.
-	Assertions map[string]bool `yaml:"assertions"`
+	Assertions map[string]bool `yaml:"assertions,omitempty"`
+
+	// Errors is a map of permissions to the errors their checks are expected to fail with, such as
+	// ERROR_CODE_DEPTH_NOT_ENOUGH. A check passes if its error contains the expected one.
+	Errors map[string]string `yaml:"errors,omitempty"`
 }
 
 // EntityFilter is a struct that represents a filter to be applied during an authorization check.
 type EntityFilter struct {
 	// Context is a struct that represents the context of the authorization entity filter.
-	Context Context `yaml:"context"`
+	Context Context `yaml:"context,omitempty"`
 
 	// EntityType is a string that represents the type of entity the filter applies to.
 	EntityType string `yaml:"entity_type"`
@@ -74,13 +105,19 @@ type EntityFilter struct {
 	Subject string `yaml:"subject"`
 
 	// Assertions is a map that contains the filter assertions to be applied.
-	Assertions map[string][]string `yaml:"assertions"`
+	Assertions map[string][]string `yaml:"assertions,omitempty"`
+
+	// Contains is a map of permissions to the ids the result of the filter is expected to contain, among others.
+	Contains map[string][]string `yaml:"contains,omitempty"`
+
+	// Excludes is a map of permissions to the ids the result of the filter is expected not to contain.
+	Excludes map[string][]string `yaml:"excludes,omitempty"`
 }
 
 // SubjectFilter is a struct that represents a filter to be applied during an authorization check.
 type SubjectFilter struct {
 	// Context is a struct that represents the context of the authorization subject filter.
-	Context Context `yaml:"context"`
+	Context Context `yaml:"context,omitempty"`
 
 	// EntityType is a string that represents the type of entity the filter applies to.
 	SubjectReference string `yaml:"subject_reference"`
@@ -89,5 +126,45 @@ type SubjectFilter struct {
 	Entity string `yaml:"entity"`
 
 	// Assertions is a map that contains the filter assertions to be applied.
-	Assertions map[string][]string `yaml:"assertions"`
+	Assertions map[string][]string `yaml:"assertions,omitempty"`
+
+	// Contains is a map of permissions to the ids the result of the filter is expected to contain, among others.
+	Contains map[string][]string `yaml:"contains,omitempty"`
+
+	// Excludes is a map of permissions to the ids the result of the filter is expected not to contain.
+	Excludes map[string][]string `yaml:"excludes,omitempty"`
+}
+
+// SubjectPermission is a struct that represents the permissions of a subject on an entity to be checked together.
+type SubjectPermission struct {
+	// Context is a struct that represents the context of the subject permission.
+	Context Context `yaml:"context,omitempty"`
+
+	// Entity is a string that represents the entity the permissions are checked on.
+	Entity string `yaml:"entity"`
+
+	// Subject is a string that represents the subject whose permissions are checked.
+	Subject string `yaml:"subject"`
+
+	// OnlyPermission is a boolean that represents whether only the permissions are checked, without the relations.
+	OnlyPermission bool `yaml:"only_permission,omitempty"`
+
+	// Assertions is a map of the permissions and the relations to their expected results. It is expected to be the
+	// same as the returned map.
+	Assertions map[string]bool `yaml:"assertions,omitempty"`
+}
+
+// Expand is a struct that represents a permission to be expanded and compared with a snapshot of its tree.
+type Expand struct {
+	// Context is a struct that represents the context of the expansion.
+	Context Context `yaml:"context,omitempty"`
+
+	// Entity is a string that represents the entity the permission is expanded on.
+	Entity string `yaml:"entity"`
+
+	// Permission is a string that represents the permission or the relation to be expanded.
+	Permission string `yaml:"permission"`
+
+	// Tree is the expected tree, as it is rendered by development.ExpandTree.
+	Tree string `yaml:"tree,omitempty"`
 }
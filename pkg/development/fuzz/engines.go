@@ -0,0 +1,240 @@
+package fuzz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Permify/permify/pkg/development"
+	"github.com/Permify/permify/pkg/development/file"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// query - A permission of an entity checked for a subject
+type query struct {
+	entity     *base.Entity
+	permission string
+	subject    *base.Subject
+}
+
+// String - Returns the query as it is printed, such as user:1 edit document:1
+func (q query) String() string {
+	return fmt.Sprintf("%s %s %s", tuple.SubjectToString(q.subject), q.permission, tuple.EntityToString(q.entity))
+}
+
+// engine - An engine whose results are compared with the results of the check engine
+type engine struct {
+	name string
+	// allowed returns whether the engine allows the subject the permission on the entity, ok is false if the engine
+	// cannot tell
+	allowed func(ctx context.Context, s *session, q query) (allowed, ok bool, err error)
+	// assert adds the assertion of the shape files that fails when the engine disagrees with the expected result
+	assert func(s *session, q query, expected bool, scenario *file.Scenario)
+}
+
+// engines - the engines compared with the check engine
+var engines = []engine{
+	{name: "LookupEntity", allowed: lookupEntity, assert: assertLookupEntity},
+	{name: "LookupSubject", allowed: lookupSubject, assert: assertLookupSubject},
+	{name: "SubjectPermission", allowed: subjectPermission, assert: assertSubjectPermission},
+	{name: "Expand", allowed: expand, assert: func(*session, query, bool, *file.Scenario) {}},
+}
+
+// session - The results of the engines on a data set, the results of the lookups, the subject permissions and the
+// expansions are shared by the queries
+type session struct {
+	dev                *development.Development
+	entities           map[string][]string
+	subjects           map[string][]string
+	subjectPermissions map[string]map[string]base.CheckResult
+	trees              map[string]*base.Expand
+}
+
+// newSession - Writes the schema and the data into a new development container
+func newSession(ctx context.Context, schema string, relationships, attributes []string) (*session, error) {
+	dev := development.NewContainer()
+	errs := dev.RunWithShape(ctx, &file.Shape{
+		Schema:        schema,
+		Relationships: relationships,
+		Attributes:    attributes,
+	})
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s: %v: %s", errs[0].Type, errs[0].Key, errs[0].Message)
+	}
+
+	return &session{
+		dev:                dev,
+		entities:           map[string][]string{},
+		subjects:           map[string][]string{},
+		subjectPermissions: map[string]map[string]base.CheckResult{},
+		trees:              map[string]*base.Expand{},
+	}, nil
+}
+
+// check - Returns whether the check engine allows the subject the permission on the entity
+func (s *session) check(ctx context.Context, q query) (bool, error) {
+	res, err := s.dev.Container.Invoker.Check(ctx, &base.PermissionCheckRequest{
+		TenantId: "t1",
+		Metadata: &base.PermissionCheckRequestMetadata{
+			SnapToken: token.NewNoopToken().Encode().String(),
+			Depth:     100,
+		},
+		Entity:     q.entity,
+		Permission: q.permission,
+		Subject:    q.subject,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.GetCan() == base.CheckResult_CHECK_RESULT_ALLOWED, nil
+}
+
+// lookupEntity - Returns whether the entity is among the entities the subject has the permission on
+func lookupEntity(ctx context.Context, s *session, q query) (bool, bool, error) {
+	key := fmt.Sprintf("%s %s %s", tuple.SubjectToString(q.subject), q.permission, q.entity.GetType())
+	ids, ok := s.entities[key]
+	if !ok {
+		res, err := s.dev.Container.Invoker.LookupEntity(ctx, &base.PermissionLookupEntityRequest{
+			TenantId: "t1",
+			Metadata: &base.PermissionLookupEntityRequestMetadata{
+				SnapToken: token.NewNoopToken().Encode().String(),
+				Depth:     100,
+			},
+			EntityType: q.entity.GetType(),
+			Permission: q.permission,
+			Subject:    q.subject,
+		})
+		if err != nil {
+			return false, false, err
+		}
+		ids = res.GetEntityIds()
+		s.entities[key] = ids
+	}
+	return slices.Contains(ids, q.entity.GetId()), true, nil
+}
+
+// assertLookupEntity - Asserts that the entities of the subject contain or exclude the entity
+func assertLookupEntity(_ *session, q query, expected bool, scenario *file.Scenario) {
+	filter := file.EntityFilter{EntityType: q.entity.GetType(), Subject: tuple.SubjectToString(q.subject)}
+	ids := map[string][]string{q.permission: {q.entity.GetId()}}
+	if expected {
+		filter.Contains = ids
+	} else {
+		filter.Excludes = ids
+	}
+	scenario.EntityFilters = append(scenario.EntityFilters, filter)
+}
+
+// lookupSubject - Returns whether the subject is among the subjects that have the permission on the entity
+func lookupSubject(ctx context.Context, s *session, q query) (bool, bool, error) {
+	key := fmt.Sprintf("%s %s %s", tuple.EntityToString(q.entity), q.permission, q.subject.GetType())
+	ids, ok := s.subjects[key]
+	if !ok {
+		res, err := s.dev.Container.Invoker.LookupSubject(ctx, &base.PermissionLookupSubjectRequest{
+			TenantId: "t1",
+			Metadata: &base.PermissionLookupSubjectRequestMetadata{
+				SnapToken: token.NewNoopToken().Encode().String(),
+				Depth:     100,
+			},
+			Entity:           q.entity,
+			Permission:       q.permission,
+			SubjectReference: &base.RelationReference{Type: q.subject.GetType()},
+		})
+		if err != nil {
+			return false, false, err
+		}
+		ids = res.GetSubjectIds()
+		s.subjects[key] = ids
+	}
+	return slices.Contains(ids, q.subject.GetId()), true, nil
+}
+
+// assertLookupSubject - Asserts that the subjects of the entity contain or exclude the subject
+func assertLookupSubject(_ *session, q query, expected bool, scenario *file.Scenario) {
+	filter := file.SubjectFilter{SubjectReference: q.subject.GetType(), Entity: tuple.EntityToString(q.entity)}
+	ids := map[string][]string{q.permission: {q.subject.GetId()}}
+	if expected {
+		filter.Contains = ids
+	} else {
+		filter.Excludes = ids
+	}
+	scenario.SubjectFilters = append(scenario.SubjectFilters, filter)
+}
+
+// subjectPermission - Returns whether the permission is allowed among the permissions of the subject on the entity
+func subjectPermission(ctx context.Context, s *session, q query) (bool, bool, error) {
+	results, err := s.subjectPermission(ctx, q)
+	if err != nil {
+		return false, false, err
+	}
+	return results[q.permission] == base.CheckResult_CHECK_RESULT_ALLOWED, true, nil
+}
+
+// subjectPermission - Returns the results of the permissions of the subject on the entity
+func (s *session) subjectPermission(ctx context.Context, q query) (map[string]base.CheckResult, error) {
+	key := fmt.Sprintf("%s %s", tuple.SubjectToString(q.subject), tuple.EntityToString(q.entity))
+	if results, ok := s.subjectPermissions[key]; ok {
+		return results, nil
+	}
+
+	res, err := s.dev.Container.Invoker.SubjectPermission(ctx, &base.PermissionSubjectPermissionRequest{
+		TenantId: "t1",
+		Metadata: &base.PermissionSubjectPermissionRequestMetadata{
+			SnapToken:      token.NewNoopToken().Encode().String(),
+			OnlyPermission: true,
+			Depth:          100,
+		},
+		Entity:  q.entity,
+		Subject: q.subject,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.subjectPermissions[key] = res.GetResults()
+	return res.GetResults(), nil
+}
+
+// assertSubjectPermission - Asserts that the permissions of the subject on the entity are the returned ones, with the
+// expected result of the permission
+func assertSubjectPermission(s *session, q query, expected bool, scenario *file.Scenario) {
+	results, err := s.subjectPermission(context.Background(), q)
+	if err != nil {
+		return
+	}
+
+	assertions := development.CheckResults(results)
+	assertions[q.permission] = expected
+	scenario.SubjectPermissions = append(scenario.SubjectPermissions, file.SubjectPermission{
+		Entity:         tuple.EntityToString(q.entity),
+		Subject:        tuple.SubjectToString(q.subject),
+		OnlyPermission: true,
+		Assertions:     assertions,
+	})
+}
+
+// expand - Returns whether the subject is in the expanded tree of the permission of the entity, the trees with the
+// values of attributes cannot tell as they are evaluated by the rules
+func expand(ctx context.Context, s *session, q query) (bool, bool, error) {
+	key := fmt.Sprintf("%s#%s", tuple.EntityToString(q.entity), q.permission)
+	tree, ok := s.trees[key]
+	if !ok {
+		res, err := s.dev.Container.Invoker.Expand(ctx, &base.PermissionExpandRequest{
+			TenantId: "t1",
+			Metadata: &base.PermissionExpandRequestMetadata{
+				SnapToken: token.NewNoopToken().Encode().String(),
+			},
+			Entity:     q.entity,
+			Permission: q.permission,
+		})
+		if err != nil {
+			return false, false, err
+		}
+		tree = res.GetTree()
+		s.trees[key] = tree
+	}
+
+	allowed, ok := development.TreeContains(tree, q.subject)
+	return allowed, ok, nil
+}
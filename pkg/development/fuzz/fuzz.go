@@ -0,0 +1,225 @@
+package fuzz
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/Permify/permify/pkg/development/file"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// Options - Options of the fuzzing
+type Options struct {
+	// Seed is the seed of the random data, the same seed generates the same data for the same schema.
+	Seed int64
+	// Iterations is the number of the random data sets that are generated and checked.
+	Iterations int
+	// Entities is the number of the entities generated of each entity type.
+	Entities int
+	// Density is the probability of each possible relationship and attribute to be generated, between 0 and 1.
+	Density float64
+}
+
+// Result - The result of the fuzzing of a schema
+type Result struct {
+	// Queries is the number of the checked queries, a query is a permission of an entity for a subject.
+	Queries int
+	// Skipped is the number of the queries the check engine returned an error for, such as ERROR_CODE_DEPTH_NOT_ENOUGH,
+	// or an engine compared with it failed to evaluate a rule for, such as for a missing field of the context data.
+	Skipped int
+	// Inconsistency is the first query the engines disagree on, nil if they agree on all of them.
+	Inconsistency *Inconsistency
+}
+
+// Inconsistency - A query an engine disagrees with the check engine on
+type Inconsistency struct {
+	// Query is the permission of an entity for a subject, such as user:1 edit document:1.
+	Query string
+	// Engine is the engine disagreeing with the check engine, such as LookupEntity.
+	Engine string
+	// Expected is the result of the check engine and Actual is the result of the engine, or its error.
+	Expected string
+	Actual   string
+	// Shape is the minimal shape file the inconsistency is reproduced with, its scenario fails on it.
+	Shape *file.Shape
+}
+
+// Run - Generates random relationships and attributes from the types of the schema and checks that the lookups, the
+// subject permissions and the expansions of each permission of each entity for each subject agree with the check
+// engine. The first inconsistency is shrunk to the minimal relationships and attributes it is reproduced with.
+func Run(ctx context.Context, schema string, opts Options) (*Result, error) {
+	return run(ctx, schema, opts, engines)
+}
+
+// run - Runs the fuzzing with the given engines compared with the check engine
+func run(ctx context.Context, schema string, opts Options, engines []engine) (*Result, error) {
+	sch, err := (&file.Shape{Schema: schema}).ParseSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	entities, _, err := compiler.NewCompiler(true, sch).Compile()
+	if err != nil {
+		return nil, err
+	}
+
+	g := newGenerator(rand.New(rand.NewSource(opts.Seed)), entities, opts.Entities, opts.Density)
+	queries := g.queries()
+
+	result := &Result{}
+	for i := 0; i < opts.Iterations; i++ {
+		relationships, attributes := g.generate()
+
+		s, err := newSession(ctx, schema, relationships, attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, q := range queries {
+			result.Queries++
+
+			allowed, err := s.check(ctx, q)
+			if err != nil {
+				result.Skipped++
+				continue
+			}
+
+			skipped := false
+			for _, e := range engines {
+				_, disagrees, err := disagreement(ctx, s, q, e, allowed)
+				if err != nil {
+					skipped = true
+					continue
+				}
+				if !disagrees {
+					continue
+				}
+
+				result.Inconsistency, err = shrink(ctx, schema, q, e, relationships, attributes)
+				return result, err
+			}
+			if skipped {
+				result.Skipped++
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// disagreement - Returns the result of the engine and whether it disagrees with the result of the check engine. An
+// error of the engine disagrees with the check engine, which succeeded, and is returned as the result, except for the
+// errors of the evaluation of the rules, which are returned to be skipped since the generated data does not include
+// the context data the rules read.
+func disagreement(ctx context.Context, s *session, q query, e engine, allowed bool) (string, bool, error) {
+	actual, ok, err := e.allowed(ctx, s, q)
+	if err != nil {
+		if evaluationError(err) {
+			return "", false, err
+		}
+		return err.Error(), true, nil
+	}
+	if !ok {
+		return "", false, nil
+	}
+	return checkResult(actual), actual != allowed, nil
+}
+
+// shrink - Removes the relationships and the attributes one by one as long as the engine keeps disagreeing with the
+// check engine on the query, and returns the inconsistency with the remaining ones
+func shrink(ctx context.Context, schema string, q query, e engine, relationships, attributes []string) (*Inconsistency, error) {
+	// reproduce returns the inconsistency of the data, nil if the engines agree on it
+	reproduce := func(relationships, attributes []string) (*Inconsistency, error) {
+		s, err := newSession(ctx, schema, relationships, attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		allowed, err := s.check(ctx, q)
+		if err != nil {
+			return nil, nil
+		}
+
+		actual, disagrees, err := disagreement(ctx, s, q, e, allowed)
+		if err != nil || !disagrees {
+			return nil, nil
+		}
+
+		scenario := file.Scenario{
+			Name:        "fuzz",
+			Description: fmt.Sprintf("%s disagrees with Check on %s", e.name, q),
+			Checks: []file.Check{{
+				Entity:     tuple.EntityToString(q.entity),
+				Subject:    tuple.SubjectToString(q.subject),
+				Assertions: map[string]bool{q.permission: allowed},
+			}},
+		}
+		e.assert(s, q, allowed, &scenario)
+
+		return &Inconsistency{
+			Query:    q.String(),
+			Engine:   e.name,
+			Expected: checkResult(allowed),
+			Actual:   actual,
+			Shape: &file.Shape{
+				Schema:        schema,
+				Relationships: relationships,
+				Attributes:    attributes,
+				Scenarios:     []file.Scenario{scenario},
+			},
+		}, nil
+	}
+
+	inconsistency, err := reproduce(relationships, attributes)
+	if err != nil || inconsistency == nil {
+		return inconsistency, err
+	}
+
+	for removed := true; removed; {
+		removed = false
+		for _, list := range []*[]string{&relationships, &attributes} {
+			for i := 0; i < len(*list); {
+				candidate := append(append([]string{}, (*list)[:i]...), (*list)[i+1:]...)
+
+				rel, attr := relationships, attributes
+				if list == &relationships {
+					rel = candidate
+				} else {
+					attr = candidate
+				}
+
+				smaller, err := reproduce(rel, attr)
+				if err != nil {
+					return nil, err
+				}
+				if smaller == nil {
+					i++
+					continue
+				}
+
+				*list = candidate
+				inconsistency = smaller
+				removed = true
+			}
+		}
+	}
+
+	return inconsistency, nil
+}
+
+// checkResult - Returns the result as it is printed
+func checkResult(allowed bool) string {
+	if allowed {
+		return "ALLOWED"
+	}
+	return "DENIED"
+}
+
+// evaluationError - Returns whether the error is a failed evaluation of a rule, such as for a missing field of the
+// context data read by the rule
+func evaluationError(err error) bool {
+	return strings.Contains(err.Error(), "failed to evaluate expression")
+}
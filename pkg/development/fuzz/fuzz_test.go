@@ -0,0 +1,207 @@
+package fuzz
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/pkg/development/file"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TestFuzz -
+func TestFuzz(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "fuzz-suite")
+}
+
+var _ = Describe("fuzz", func() {
+	Context("Run", func() {
+		It("Case 1: engines agree on relations, tuple to user sets and exclusions", func() {
+			schema := `
+			entity user {}
+
+			entity organization {
+				relation admin @user
+				relation member @user
+			}
+
+			entity folder {
+				relation org @organization
+				relation parent @folder
+				relation owner @user
+				relation collaborator @user @organization#member
+				permission edit = owner or org.admin or parent.edit
+				permission view = (collaborator or edit or parent.view) not org.member
+			}`
+
+			result, err := Run(context.Background(), schema, Options{Seed: 1, Iterations: 2, Entities: 3, Density: 0.3})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Inconsistency).Should(BeNil())
+			// 2 iterations of 3 folders with 2 permissions for 3 users, organizations and folders
+			Expect(result.Queries).Should(Equal(2 * 3 * 2 * 9))
+			Expect(result.Skipped).Should(Equal(0))
+		})
+
+		It("Case 2: engine errors on the context data read by the rules are skipped", func() {
+			schema := `
+			entity user {}
+
+			entity account {
+				relation owner @user
+				attribute balance integer
+				permission withdraw = check_balance(balance) and owner
+			}
+
+			rule check_balance(balance integer) {
+				balance >= context.data.amount
+			}`
+
+			result, err := Run(context.Background(), schema, Options{Seed: 1, Iterations: 2, Entities: 3, Density: 0.5})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Inconsistency).Should(BeNil())
+			Expect(result.Skipped).Should(BeNumerically(">", 0))
+		})
+
+		It("Case 3: invalid schema", func() {
+			_, err := Run(context.Background(), "entity document { permission view = viewer }", Options{Iterations: 1, Entities: 1})
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("Case 4: engine errors other than the evaluation of the rules are reported", func() {
+			schema := `
+			entity user {}
+
+			entity document {
+				relation viewer @user
+				permission view = viewer
+			}`
+
+			// fake fails whenever user:1 can view document:2
+			fake := engine{
+				name: "Fake",
+				allowed: func(ctx context.Context, s *session, q query) (bool, bool, error) {
+					trigger, err := s.check(ctx, query{
+						entity:     &base.Entity{Type: "document", Id: "2"},
+						permission: "view",
+						subject:    &base.Subject{Type: "user", Id: "1"},
+					})
+					if err != nil {
+						return false, false, err
+					}
+					if trigger {
+						return false, false, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+					}
+					allowed, err := s.check(ctx, q)
+					return allowed, true, err
+				},
+				assert: func(*session, query, bool, *file.Scenario) {},
+			}
+
+			result, err := run(context.Background(), schema, Options{Seed: 1, Iterations: 5, Entities: 3, Density: 0.5}, []engine{fake})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Skipped).Should(Equal(0))
+			Expect(result.Inconsistency).ShouldNot(BeNil())
+
+			Expect(result.Inconsistency.Engine).Should(Equal("Fake"))
+			Expect(result.Inconsistency.Actual).Should(Equal("ERROR_CODE_EXECUTION"))
+			Expect(result.Inconsistency.Shape.Relationships).Should(Equal([]string{"document:2#viewer@user:1"}))
+		})
+	})
+
+	Context("shrink", func() {
+		It("Case 1: the inconsistency is reproduced with the relationships it depends on", func() {
+			schema := `
+			entity user {}
+
+			entity document {
+				relation viewer @user
+				permission view = viewer
+			}`
+
+			// fake disagrees with the check engine whenever user:1 can view document:2
+			fake := engine{
+				name: "Fake",
+				allowed: func(ctx context.Context, s *session, q query) (bool, bool, error) {
+					trigger, err := s.check(ctx, query{
+						entity:     &base.Entity{Type: "document", Id: "2"},
+						permission: "view",
+						subject:    &base.Subject{Type: "user", Id: "1"},
+					})
+					if err != nil {
+						return false, false, err
+					}
+					allowed, err := s.check(ctx, q)
+					return allowed != trigger, true, err
+				},
+				assert: func(*session, query, bool, *file.Scenario) {},
+			}
+
+			q := query{
+				entity:     &base.Entity{Type: "document", Id: "1"},
+				permission: "view",
+				subject:    &base.Subject{Type: "user", Id: "1"},
+			}
+
+			inconsistency, err := shrink(context.Background(), schema, q, fake, []string{
+				"document:1#viewer@user:1",
+				"document:1#viewer@user:2",
+				"document:2#viewer@user:1",
+				"document:2#viewer@user:3",
+				"document:3#viewer@user:1",
+			}, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(inconsistency).ShouldNot(BeNil())
+
+			Expect(inconsistency.Query).Should(Equal("user:1 view document:1"))
+			Expect(inconsistency.Engine).Should(Equal("Fake"))
+			Expect(inconsistency.Expected).Should(Equal("DENIED"))
+			Expect(inconsistency.Actual).Should(Equal("ALLOWED"))
+			Expect(inconsistency.Shape.Relationships).Should(Equal([]string{"document:2#viewer@user:1"}))
+			Expect(inconsistency.Shape.Scenarios[0].Checks[0].Assertions).Should(Equal(map[string]bool{"view": false}))
+		})
+	})
+
+	Context("generator", func() {
+		It("Case 1: the same seed generates the same data from the types of the schema", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity document {
+				relation parent @document
+				relation viewer @user
+				attribute level integer
+				attribute tags string[]
+				permission view = viewer or parent.view
+			}`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			entities, _, err := compiler.NewCompiler(true, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			first, firstAttributes := newGenerator(rand.New(rand.NewSource(7)), entities, 3, 1).generate()
+			second, secondAttributes := newGenerator(rand.New(rand.NewSource(7)), entities, 3, 1).generate()
+			Expect(first).Should(Equal(second))
+			Expect(firstAttributes).Should(Equal(secondAttributes))
+
+			// the documents are only parents of the documents after them
+			Expect(first).Should(ConsistOf(
+				"document:2#parent@document:1",
+				"document:3#parent@document:1",
+				"document:3#parent@document:2",
+				"document:1#viewer@user:1", "document:1#viewer@user:2", "document:1#viewer@user:3",
+				"document:2#viewer@user:1", "document:2#viewer@user:2", "document:2#viewer@user:3",
+				"document:3#viewer@user:1", "document:3#viewer@user:2", "document:3#viewer@user:3",
+			))
+			Expect(firstAttributes).Should(HaveLen(6))
+			Expect(firstAttributes[0]).Should(MatchRegexp(`^document:1\$level\|integer:[0-9]$`))
+			Expect(firstAttributes[1]).Should(MatchRegexp(`^document:1\$tags\|string\[\]:[abc](,[abc])?$`))
+		})
+	})
+})
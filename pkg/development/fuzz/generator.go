@@ -0,0 +1,179 @@
+package fuzz
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// generator - Generates random relationships and attributes from the types of the entities of a schema
+type generator struct {
+	rand     *rand.Rand
+	entities []*base.EntityDefinition
+	ids      []string
+	density  float64
+}
+
+// newGenerator - Creates a new generator of the entities, with the given number of entities of each type
+func newGenerator(r *rand.Rand, entities []*base.EntityDefinition, count int, density float64) *generator {
+	sorted := append([]*base.EntityDefinition{}, entities...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetName() < sorted[j].GetName()
+	})
+
+	ids := make([]string, 0, count)
+	for i := 1; i <= count; i++ {
+		ids = append(ids, strconv.Itoa(i))
+	}
+
+	return &generator{rand: r, entities: sorted, ids: ids, density: density}
+}
+
+// generate - Generates each possible relationship and attribute of the entities with the probability of the density
+func (g *generator) generate() (relationships, attributes []string) {
+	for _, entity := range g.entities {
+		for i, id := range g.ids {
+			for _, name := range sortedKeys(entity.GetRelations()) {
+				for _, ref := range entity.GetRelations()[name].GetRelationReferences() {
+					for j, subjectID := range g.ids {
+						// the entities are only related to the entities of the same type before them, which keeps the
+						// relationships of a type acyclic
+						if ref.GetType() == entity.GetName() && j >= i {
+							continue
+						}
+						if g.rand.Float64() >= g.density {
+							continue
+						}
+
+						subject := ref.GetType() + ":" + subjectID
+						if ref.GetRelation() != "" {
+							subject += "#" + ref.GetRelation()
+						}
+						relationships = append(relationships, fmt.Sprintf("%s:%s#%s@%s", entity.GetName(), id, name, subject))
+					}
+				}
+			}
+
+			for _, name := range sortedKeys(entity.GetAttributes()) {
+				if g.rand.Float64() >= g.density {
+					continue
+				}
+				typ, value := g.value(entity.GetAttributes()[name].GetType())
+				attributes = append(attributes, fmt.Sprintf("%s:%s$%s|%s:%s", entity.GetName(), id, name, typ, value))
+			}
+		}
+	}
+	return relationships, attributes
+}
+
+// value - Returns the name of the attribute type and a random value of it, as they are written in the attributes
+func (g *generator) value(typ base.AttributeType) (string, string) {
+	switch typ {
+	case base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN:
+		return "boolean", g.boolean()
+	case base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN_ARRAY:
+		return "boolean[]", g.array(g.boolean)
+	case base.AttributeType_ATTRIBUTE_TYPE_STRING:
+		return "string", g.string()
+	case base.AttributeType_ATTRIBUTE_TYPE_STRING_ARRAY:
+		return "string[]", g.array(g.string)
+	case base.AttributeType_ATTRIBUTE_TYPE_INTEGER:
+		return "integer", g.integer()
+	case base.AttributeType_ATTRIBUTE_TYPE_INTEGER_ARRAY:
+		return "integer[]", g.array(g.integer)
+	case base.AttributeType_ATTRIBUTE_TYPE_DOUBLE:
+		return "double", g.double()
+	default:
+		return "double[]", g.array(g.double)
+	}
+}
+
+// boolean - Returns a random boolean
+func (g *generator) boolean() string {
+	return strconv.FormatBool(g.rand.Intn(2) == 1)
+}
+
+// stringValues - the strings the string attributes are chosen from, a few of them so that they are often equal
+var stringValues = []string{"a", "b", "c"}
+
+// string - Returns a random string
+func (g *generator) string() string {
+	return stringValues[g.rand.Intn(len(stringValues))]
+}
+
+// integer - Returns a random integer between 0 and 9
+func (g *generator) integer() string {
+	return strconv.Itoa(g.rand.Intn(10))
+}
+
+// double - Returns a random double between 0 and 9.9
+func (g *generator) double() string {
+	return strconv.FormatFloat(float64(g.rand.Intn(100))/10, 'f', 1, 64)
+}
+
+// array - Returns one or two random values
+func (g *generator) array(value func() string) string {
+	values := []string{value()}
+	if g.rand.Intn(2) == 1 {
+		values = append(values, value())
+	}
+	return strings.Join(values, ",")
+}
+
+// subjects - Returns the subjects the permissions are checked for, the entities of the types that are related
+// directly, without a relation
+func (g *generator) subjects() []*base.Subject {
+	types := map[string]bool{}
+	for _, entity := range g.entities {
+		for _, relation := range entity.GetRelations() {
+			for _, ref := range relation.GetRelationReferences() {
+				if ref.GetRelation() == "" {
+					types[ref.GetType()] = true
+				}
+			}
+		}
+	}
+
+	var subjects []*base.Subject
+	for _, typ := range sortedKeys(types) {
+		for _, id := range g.ids {
+			subjects = append(subjects, &base.Subject{Type: typ, Id: id})
+		}
+	}
+	return subjects
+}
+
+// queries - Returns the queries of each permission of each entity for each subject
+func (g *generator) queries() []query {
+	subjects := g.subjects()
+
+	var queries []query
+	for _, entity := range g.entities {
+		for _, id := range g.ids {
+			for _, permission := range sortedKeys(entity.GetPermissions()) {
+				for _, subject := range subjects {
+					queries = append(queries, query{
+						entity:     &base.Entity{Type: entity.GetName(), Id: id},
+						permission: permission,
+						subject:    subject,
+					})
+				}
+			}
+		}
+	}
+	return queries
+}
+
+// sortedKeys - Returns the keys of the map in order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// DataBuilder builds the graph of the relationships around an entity
+type DataBuilder struct {
+	reader storage.DataReader
+	schema *base.SchemaDefinition
+}
+
+// NewDataBuilder creates a new DataBuilder object reading the relationships of the entities of the schema.
+func NewDataBuilder(reader storage.DataReader, schema *base.SchemaDefinition) DataBuilder {
+	return DataBuilder{reader: reader, schema: schema}
+}
+
+// step is an entity or a subject set of an entity reached by the walk, and the number of relationships it is away
+// from the entity the walk started from
+type step struct {
+	entity   *base.Entity
+	relation string
+	hops     int
+}
+
+// RelationshipsToGraph walks the relationships of the entity at the snap token up to the given number of hops and
+// returns them as a graph of the entities and the subject sets they connect, with the relations as the labels of the
+// edges. The subjects of a relationship are followed only if they are subject sets, such as group:1#member, or if the
+// relation is the tuple set of a tuple to user set in the schema of the entity, such as parent in parent.view. The
+// other subjects, such as users, are added to the graph without their relationships.
+func (b DataBuilder) RelationshipsToGraph(ctx context.Context, tenantID, snap string, entity *base.Entity, depth int) (Graph, error) {
+	if _, err := schema.GetEntityByName(b.schema, entity.GetType()); err != nil {
+		return Graph{}, err
+	}
+
+	var g Graph
+	g.AddNode(entityNode(entity))
+
+	visited := map[string]bool{}
+	queue := []step{{entity: entity}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		key := fmt.Sprintf("%s#%s", tuple.EntityToString(current.entity), current.relation)
+		if visited[key] || visited[tuple.EntityToString(current.entity)+"#"] || current.hops >= depth {
+			continue
+		}
+		visited[key] = true
+
+		definition, err := schema.GetEntityByName(b.schema, current.entity.GetType())
+		if err != nil {
+			return Graph{}, err
+		}
+		tupleSets := tupleSetRelations(definition)
+
+		from := entityNode(current.entity)
+		if current.relation != "" {
+			from = subjectSetNode(current.entity, current.relation)
+		}
+
+		it, err := b.reader.QueryRelationships(ctx, tenantID, &base.TupleFilter{
+			Entity: &base.EntityFilter{
+				Type: current.entity.GetType(),
+				Ids:  []string{current.entity.GetId()},
+			},
+			Relation: current.relation,
+		}, snap, database.NewCursorPagination())
+		if err != nil {
+			return Graph{}, err
+		}
+
+		for it.HasNext() {
+			t := it.GetNext()
+			subject := &base.Entity{Type: t.GetSubject().GetType(), Id: t.GetSubject().GetId()}
+
+			if tuple.IsDirectSubject(t.GetSubject()) {
+				g.AddLabeledEdge(from, entityNode(subject), t.GetRelation())
+				if tupleSets[t.GetRelation()] {
+					queue = append(queue, step{entity: subject, hops: current.hops + 1})
+				}
+				continue
+			}
+
+			g.AddLabeledEdge(from, subjectSetNode(subject, t.GetSubject().GetRelation()), t.GetRelation())
+			queue = append(queue, step{entity: subject, relation: t.GetSubject().GetRelation(), hops: current.hops + 1})
+		}
+	}
+
+	nodes, edges := g.normalize()
+	var normalized Graph
+	normalized.AddNodes(nodes)
+	normalized.AddEdges(edges)
+	return normalized, nil
+}
+
+// entityNode returns the node of an entity, such as document:1
+func entityNode(entity *base.Entity) *Node {
+	id := tuple.EntityToString(entity)
+	return &Node{Type: "entity", ID: id, Label: id}
+}
+
+// subjectSetNode returns the node of a subject set, such as group:1#member
+func subjectSetNode(entity *base.Entity, relation string) *Node {
+	id := fmt.Sprintf("%s#%s", tuple.EntityToString(entity), relation)
+	return &Node{Type: "relation", ID: id, Label: relation}
+}
+
+// tupleSetRelations returns the relations of the entity the permissions reach other entities through, the tuple sets
+// of the tuple to user sets and of the attributes of related entities passed to the rules
+func tupleSetRelations(entity *base.EntityDefinition) map[string]bool {
+	relations := map[string]bool{}
+
+	var collect func(child *base.Child)
+	collect = func(child *base.Child) {
+		if rewrite := child.GetRewrite(); rewrite != nil {
+			for _, c := range rewrite.GetChildren() {
+				collect(c)
+			}
+			return
+		}
+
+		leaf := child.GetLeaf()
+		if ttu := leaf.GetTupleToUserSet(); ttu != nil {
+			relations[ttu.GetTupleSet().GetRelation()] = true
+		}
+		for _, arg := range leaf.GetCall().GetArguments() {
+			if ttca := arg.GetTupleToComputedAttribute(); ttca != nil {
+				relations[ttca.GetTupleSet().GetRelation()] = true
+			}
+		}
+	}
+
+	for _, permission := range entity.GetPermissions() {
+		collect(permission.GetChild())
+	}
+	return relations
+}
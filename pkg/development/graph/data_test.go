@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+var _ = Describe("data graph", func() {
+	input := `
+	entity user {}
+
+	entity group {
+		relation member @user
+	}
+
+	entity folder {
+		relation parent @folder
+		relation owner @user
+		permission view = owner or parent.view
+	}
+
+	entity document {
+		relation folder @folder
+		relation viewer @user @group#member
+		relation reviewer @folder
+		permission view = viewer or folder.view
+	}`
+
+	// dataGraph - writes the relationships into an in-memory database and walks them from document:1
+	dataGraph := func(depth int, relationships ...string) Graph {
+		db, err := factories.DatabaseFactory(config.Database{Engine: database.MEMORY.String()})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		tuples := make([]*base.Tuple, 0, len(relationships))
+		for _, r := range relationships {
+			t, err := tuple.Tuple(r)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+
+		ctx := context.Background()
+		snap, err := factories.DataWriterFactory(db).Write(ctx, "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		g, err := NewDataBuilder(factories.DataReaderFactory(db), schemaGraphDefinition(input)).
+			RelationshipsToGraph(ctx, "t1", snap.String(), &base.Entity{Type: "document", Id: "1"}, depth)
+		Expect(err).ShouldNot(HaveOccurred())
+		return g
+	}
+
+	relationships := []string{
+		"document:1#viewer@user:1",
+		"document:1#viewer@group:1#member",
+		"document:1#folder@folder:1",
+		"document:1#reviewer@folder:3",
+		"group:1#member@user:2",
+		"group:2#member@user:3",
+		"folder:1#parent@folder:2",
+		"folder:1#owner@user:4",
+		"folder:2#owner@user:5",
+		"folder:3#owner@user:6",
+	}
+
+	Context("RelationshipsToGraph", func() {
+		It("Case 1: subject sets and tuple sets are followed", func() {
+			g := dataGraph(3, relationships...)
+
+			Expect(g.DOT()).Should(Equal(`digraph schema {
+  rankdir=LR;
+  node [fontname="Helvetica"];
+  "document:1" [label="document:1", shape=box];
+  "folder:1" [label="folder:1", shape=box];
+  "folder:2" [label="folder:2", shape=box];
+  "folder:3" [label="folder:3", shape=box];
+  "group:1#member" [label="group:1#member", shape=ellipse];
+  "user:1" [label="user:1", shape=box];
+  "user:2" [label="user:2", shape=box];
+  "user:4" [label="user:4", shape=box];
+  "user:5" [label="user:5", shape=box];
+  "document:1" -> "folder:1" [label="folder"];
+  "document:1" -> "folder:3" [label="reviewer"];
+  "document:1" -> "group:1#member" [label="viewer"];
+  "document:1" -> "user:1" [label="viewer"];
+  "folder:1" -> "folder:2" [label="parent"];
+  "folder:1" -> "user:4" [label="owner"];
+  "folder:2" -> "user:5" [label="owner"];
+  "group:1#member" -> "user:2" [label="member"];
+}
+`))
+		})
+
+		It("Case 2: the walk stops after the given number of hops", func() {
+			g := dataGraph(1, relationships...)
+
+			var ids []string
+			for _, n := range g.Nodes() {
+				ids = append(ids, n.ID)
+			}
+			Expect(ids).Should(Equal([]string{"document:1", "folder:1", "folder:3", "group:1#member", "user:1"}))
+			Expect(g.Edges()).Should(HaveLen(4))
+		})
+
+		It("Case 3: entity type is not in the schema", func() {
+			_, err := NewDataBuilder(nil, schemaGraphDefinition(input)).
+				RelationshipsToGraph(context.Background(), "t1", "", &base.Entity{Type: "organization", Id: "1"}, 1)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -9,8 +9,9 @@ type Node struct {
 
 // Edge - Edge Structure
 type Edge struct {
-	From *Node `json:"from"`
-	To   *Node `json:"to"`
+	From  *Node  `json:"from"`
+	To    *Node  `json:"to"`
+	Label string `json:"label,omitempty"`
 }
 
 // Graph - Graph Structure
@@ -51,3 +52,12 @@ func (g *Graph) AddEdge(from, to *Node) {
 		To:   to,
 	})
 }
+
+// AddLabeledEdge - Add edge with a label, such as the relation of a relationship, to graph
+func (g *Graph) AddLabeledEdge(from, to *Node, label string) {
+	g.edges = append(g.edges, &Edge{
+		From:  from,
+		To:    to,
+		Label: label,
+	})
+}
@@ -0,0 +1,233 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Formats - the formats a graph can be rendered in
+var Formats = []string{"dot", "mermaid", "json"}
+
+// Render - Renders the graph in the given format
+func (g *Graph) Render(format string) ([]byte, error) {
+	switch format {
+	case "dot":
+		return []byte(g.DOT()), nil
+	case "mermaid":
+		return []byte(g.Mermaid()), nil
+	case "json":
+		return g.JSON()
+	default:
+		return nil, fmt.Errorf("unsupported format: %s, must be one of %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+// Focus - Returns the graph of the node with the given id and the nodes it depends on transitively, such as the
+// relations, permissions, attributes and rules a permission is built from. The id is the name of an entity or a rule,
+// or the id of a relation, permission or attribute, such as document#view or document$is_public. The relations and
+// the permissions are followed to the entities they refer to, but the other members of these entities are not.
+func (g *Graph) Focus(id string) (Graph, error) {
+	nodes, edges := g.normalize()
+
+	byID := map[string]*Node{}
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	if _, ok := byID[id]; !ok {
+		return Graph{}, fmt.Errorf("%s is not found in the graph", id)
+	}
+
+	// the rules depend on the attributes passed to them, which are the sources of their edges
+	dependencies := map[string][]string{}
+	for _, e := range edges {
+		if e.To.Type == "rule" && e.From.Type == "attribute" {
+			dependencies[e.To.ID] = append(dependencies[e.To.ID], e.From.ID)
+			continue
+		}
+		dependencies[e.From.ID] = append(dependencies[e.From.ID], e.To.ID)
+	}
+
+	visited := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		// only the members of the focused entity are followed
+		if byID[current].Type == "entity" && current != id {
+			continue
+		}
+
+		for _, next := range dependencies[current] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	var focused Graph
+	for _, n := range nodes {
+		if visited[n.ID] {
+			focused.AddNode(n)
+		}
+	}
+	for _, e := range edges {
+		if !visited[e.From.ID] || !visited[e.To.ID] {
+			continue
+		}
+		// the entities that are not focused are only kept as the types of the relations
+		if e.From.Type == "entity" && e.From.ID != id {
+			continue
+		}
+		focused.AddLabeledEdge(byID[e.From.ID], byID[e.To.ID], e.Label)
+	}
+	return focused, nil
+}
+
+// DOT - Renders the graph in the Graphviz DOT language
+func (g *Graph) DOT() string {
+	nodes, edges := g.normalize()
+
+	var sb strings.Builder
+	sb.WriteString("digraph schema {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [fontname=\"Helvetica\"];\n")
+	for _, n := range nodes {
+		sb.WriteString(fmt.Sprintf("  %q [label=%q, shape=%s];\n", n.ID, label(n), dotShapes[n.Type]))
+	}
+	for _, e := range edges {
+		if e.Label == "" {
+			sb.WriteString(fmt.Sprintf("  %q -> %q;\n", e.From.ID, e.To.ID))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("  %q -> %q [label=%q];\n", e.From.ID, e.To.ID, e.Label))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// dotShapes - the shapes of the nodes in DOT by their types
+var dotShapes = map[string]string{
+	"entity":     "box",
+	"relation":   "ellipse",
+	"permission": "hexagon",
+	"attribute":  "note",
+	"rule":       "component",
+	"operation":  "circle",
+}
+
+// Mermaid - Renders the graph as a Mermaid flowchart
+func (g *Graph) Mermaid() string {
+	nodes, edges := g.normalize()
+
+	// the ids of the schema are not valid in mermaid, the nodes are numbered in their order instead
+	ids := map[string]string{}
+	for i, n := range nodes {
+		ids[n.ID] = fmt.Sprintf("n%d", i)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for _, n := range nodes {
+		shape := mermaidShapes[n.Type]
+		sb.WriteString(fmt.Sprintf("  %s%s\"%s\"%s\n", ids[n.ID], shape[0], strings.ReplaceAll(label(n), "\"", "#quot;"), shape[1]))
+	}
+	for _, e := range edges {
+		if e.Label == "" {
+			sb.WriteString(fmt.Sprintf("  %s --> %s\n", ids[e.From.ID], ids[e.To.ID]))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("  %s -->|\"%s\"| %s\n", ids[e.From.ID], strings.ReplaceAll(e.Label, "\"", "#quot;"), ids[e.To.ID]))
+	}
+	return sb.String()
+}
+
+// mermaidShapes - the opening and closing brackets of the nodes in Mermaid by their types
+var mermaidShapes = map[string][2]string{
+	"entity":     {"[", "]"},
+	"relation":   {"(", ")"},
+	"permission": {"{{", "}}"},
+	"attribute":  {"[/", "/]"},
+	"rule":       {"[[", "]]"},
+	"operation":  {"((", "))"},
+}
+
+// JSON - Renders the nodes and the edges of the graph as JSON, in the format of the graph of the playground
+func (g *Graph) JSON() ([]byte, error) {
+	nodes, edges := g.normalize()
+	return json.MarshalIndent(struct {
+		Nodes []*Node `json:"nodes"`
+		Edges []*Edge `json:"edges"`
+	}{Nodes: nodes, Edges: edges}, "", "  ")
+}
+
+// normalize - Returns the nodes sorted by their ids and the edges sorted by the ids of their ends and their labels, without the
+// duplicates. The ends of the edges are replaced with the nodes of the graph with the same ids, as an edge may refer
+// to a permission as a relation, and the ends that are not nodes of the graph are added as nodes.
+func (g *Graph) normalize() ([]*Node, []*Edge) {
+	byID := map[string]*Node{}
+	for _, n := range g.nodes {
+		if _, ok := byID[n.ID]; !ok {
+			byID[n.ID] = n
+		}
+	}
+	for _, e := range g.edges {
+		for _, n := range []*Node{e.From, e.To} {
+			if _, ok := byID[n.ID]; !ok {
+				byID[n.ID] = n
+			}
+		}
+	}
+
+	nodes := make([]*Node, 0, len(byID))
+	for _, n := range byID {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+
+	seen := map[[3]string]bool{}
+	edges := make([]*Edge, 0, len(g.edges))
+	for _, e := range g.edges {
+		key := [3]string{e.From.ID, e.To.ID, e.Label}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		edges = append(edges, &Edge{From: byID[e.From.ID], To: byID[e.To.ID], Label: e.Label})
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From.ID != edges[j].From.ID {
+			return edges[i].From.ID < edges[j].From.ID
+		}
+		if edges[i].To.ID != edges[j].To.ID {
+			return edges[i].To.ID < edges[j].To.ID
+		}
+		return edges[i].Label < edges[j].Label
+	})
+
+	return nodes, edges
+}
+
+// label - Returns the label of the node, the relations, permissions and attributes are labeled with their ids to tell
+// the ones of different entities apart
+func label(n *Node) string {
+	switch n.Type {
+	case "relation", "permission", "attribute":
+		return n.ID
+	case "operation":
+		switch n.Label {
+		case "OPERATION_UNION":
+			return "or"
+		case "OPERATION_INTERSECTION":
+			return "and"
+		case "OPERATION_EXCLUSION":
+			return "not"
+		}
+	}
+	return n.Label
+}
@@ -0,0 +1,152 @@
+package graph
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/schema"
+)
+
+// TestGraph -
+func TestGraph(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "graph-suite")
+}
+
+// schemaGraphDefinition - compiles the schema
+func schemaGraphDefinition(input string) *base.SchemaDefinition {
+	sch, err := parser.NewParser(input).Parse()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	entities, rules, err := compiler.NewCompiler(true, sch).Compile()
+	Expect(err).ShouldNot(HaveOccurred())
+	return schema.Schema(entities, rules)
+}
+
+// schemaGraph - compiles the schema and converts it into a graph
+func schemaGraph(input string) Graph {
+	g, err := NewBuilder(schemaGraphDefinition(input)).SchemaToGraph()
+	Expect(err).ShouldNot(HaveOccurred())
+	return g
+}
+
+var _ = Describe("graph", func() {
+	input := `
+	entity user {}
+
+	entity folder {
+		relation owner @user
+		permission edit = owner
+	}
+
+	entity document {
+		relation folder @folder
+		relation viewer @user
+		attribute level integer
+		permission view = viewer or folder.edit
+		permission high = check_level(level)
+	}
+
+	rule check_level(level integer) {
+		level > 3
+	}`
+
+	Context("DOT", func() {
+		It("Case 1: focused permission", func() {
+			g := schemaGraph(input)
+
+			focused, err := g.Focus("document#view")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(focused.DOT()).Should(Equal(`digraph schema {
+  rankdir=LR;
+  node [fontname="Helvetica"];
+  "document#folder" [label="document#folder", shape=ellipse];
+  "document#view" [label="document#view", shape=hexagon];
+  "document#view.0" [label="or", shape=circle];
+  "document#viewer" [label="document#viewer", shape=ellipse];
+  "folder" [label="folder", shape=box];
+  "folder#edit" [label="folder#edit", shape=hexagon];
+  "folder#owner" [label="folder#owner", shape=ellipse];
+  "user" [label="user", shape=box];
+  "document#folder" -> "folder";
+  "document#view" -> "document#view.0";
+  "document#view.0" -> "document#folder";
+  "document#view.0" -> "document#viewer";
+  "document#view.0" -> "folder#edit";
+  "document#viewer" -> "user";
+  "folder#edit" -> "folder#owner";
+  "folder#owner" -> "user";
+}
+`))
+		})
+
+		It("Case 2: the same schema is always rendered the same", func() {
+			first, second := schemaGraph(input), schemaGraph(input)
+			Expect(first.DOT()).Should(Equal(second.DOT()))
+		})
+	})
+
+	Context("Mermaid", func() {
+		It("Case 1: focused rule call", func() {
+			g := schemaGraph(input)
+
+			focused, err := g.Focus("document#high")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(focused.Mermaid()).Should(Equal(`flowchart LR
+  n0[["check_level"]]
+  n1{{"document#high"}}
+  n2[/"document$level"/]
+  n1 --> n0
+  n2 --> n0
+`))
+		})
+	})
+
+	Context("JSON", func() {
+		It("Case 1: focused entity", func() {
+			g := schemaGraph(input)
+
+			focused, err := g.Focus("folder")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			out, err := focused.Render("json")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var decoded struct {
+				Nodes []Node `json:"nodes"`
+				Edges []struct {
+					From Node `json:"from"`
+					To   Node `json:"to"`
+				} `json:"edges"`
+			}
+			Expect(json.Unmarshal(out, &decoded)).ShouldNot(HaveOccurred())
+
+			var ids []string
+			for _, n := range decoded.Nodes {
+				ids = append(ids, n.ID)
+			}
+			Expect(ids).Should(Equal([]string{"folder", "folder#edit", "folder#owner", "user"}))
+			Expect(decoded.Edges).Should(HaveLen(4))
+		})
+	})
+
+	Context("Focus", func() {
+		It("Case 1: unknown node", func() {
+			g := schemaGraph(input)
+
+			_, err := g.Focus("document#edit")
+			Expect(err).Should(MatchError("document#edit is not found in the graph"))
+
+			_, err = g.Render("svg")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
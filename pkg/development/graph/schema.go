@@ -4,8 +4,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/rs/xid"
-
 	"github.com/Permify/permify/internal/schema"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -139,13 +137,14 @@ func (b Builder) RuleToGraph(rule *base.RuleDefinition) (g Graph, err error) {
 // It returns the constructed graph and any encountered errors.
 func (b Builder) buildPermissionGraph(entity *base.EntityDefinition, from *Node, children []*base.Child) (g Graph, err error) {
 	// Iterate through the list of children
-	for _, child := range children {
+	for i, child := range children {
 		switch child.GetType().(type) {
 		// Handle Rewrite type children
 		case *base.Child_Rewrite:
 			rw := &Node{
-				Type:  "operation",
-				ID:    xid.New().String(),
+				Type: "operation",
+				// The id is derived from the parent and the position of the child, so the graph of a schema is always the same
+				ID:    fmt.Sprintf("%s.%d", from.ID, i),
 				Label: child.GetRewrite().GetRewriteOperation().String(),
 			}
 
@@ -177,6 +176,13 @@ func (b Builder) buildPermissionGraph(entity *base.EntityDefinition, from *Node,
 					return Graph{}, errors.New(base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String())
 				}
 
+				// Add an edge to the tuple set relation the computed relations are reached through
+				g.AddEdge(from, &Node{
+					Type:  "relation",
+					ID:    fmt.Sprintf("%s#%s", entity.GetName(), re.GetName()),
+					Label: re.GetName(),
+				})
+
 				// Add edges from the current 'from' node to referenced relations
 				for _, r := range re.GetRelationReferences() {
 					ag, err := b.addEdgeFromRelation(from, r, leaf)
@@ -224,6 +230,18 @@ func (b Builder) buildPermissionGraph(entity *base.EntityDefinition, from *Node,
 							ID:    leaf.GetCall().GetRuleName(),
 							Label: leaf.GetCall().GetRuleName(),
 						})
+					case *base.Argument_TupleToComputedAttribute:
+						for _, ref := range entity.GetRelations()[op.TupleToComputedAttribute.GetTupleSet().GetRelation()].GetRelationReferences() {
+							g.AddEdge(&Node{
+								Type:  "attribute",
+								ID:    fmt.Sprintf("%s$%s", ref.GetType(), op.TupleToComputedAttribute.GetComputed().GetName()),
+								Label: op.TupleToComputedAttribute.GetComputed().GetName(),
+							}, &Node{
+								Type:  "rule",
+								ID:    leaf.GetCall().GetRuleName(),
+								Label: leaf.GetCall().GetRuleName(),
+							})
+						}
 					default:
 						break
 					}
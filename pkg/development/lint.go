@@ -0,0 +1,118 @@
+package development
+
+import (
+	"strings"
+
+	internalSchema "github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/pkg/development/file"
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/token"
+	"github.com/Permify/permify/pkg/dsl/utils"
+)
+
+// Warning - A warning of the schema linter with the position of the definition or the name it is about in the schema
+type Warning struct {
+	Rule       string `json:"rule"`
+	Definition string `json:"definition"`
+	Name       string `json:"name,omitempty"`
+	Message    string `json:"message"`
+	// File, Line and Column are the position of the definition, File is the shape file, or empty if the shape is not
+	// read from a file, for the definitions of the schema itself, it is the imported file of the definition otherwise.
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+// Lint - Lints the schema of the shape, the permissions asserted by its scenarios are not reported as unused
+func Lint(shape *file.Shape, opts ...internalSchema.LinterOption) ([]Warning, []Error) {
+	sch, err := shape.ParseSchema()
+	if err != nil {
+		return nil, []Error{schemaError(err)}
+	}
+
+	c := compiler.NewCompiler(true, sch)
+	entities, rules, err := c.Compile()
+	if err != nil {
+		return nil, []Error{schemaError(err)}
+	}
+
+	opts = append(opts, internalSchema.AssertedPermissions(AssertedPermissions(shape)...), internalSchema.RuleCalls(c.RuleCalls()))
+	warnings := internalSchema.NewLinter(internalSchema.NewSchemaFromEntityAndRuleDefinitions(entities, rules), opts...).Lint()
+
+	result := make([]Warning, 0, len(warnings))
+	for _, w := range warnings {
+		warning := Warning{
+			Rule:       w.GetRule(),
+			Definition: w.GetDefinition(),
+			Name:       w.GetName(),
+			Message:    w.GetMessage(),
+		}
+		if tkn, ok := definitionToken(sch, w.GetDefinition(), w.GetName()); ok {
+			warning.File = tkn.PositionInfo.File
+			warning.Line = tkn.PositionInfo.LinePosition
+			warning.Column = tkn.PositionInfo.Column()
+		}
+		result = append(result, warning)
+	}
+	return result, nil
+}
+
+// definitionToken - Returns the name token of the entity or the rule, or of the relation, attribute or permission of
+// the entity if the name is not empty
+func definitionToken(sch *ast.Schema, definition, name string) (token.Token, bool) {
+	for _, st := range sch.Statements {
+		switch s := st.(type) {
+		case *ast.RuleStatement:
+			if s.Name.Literal == definition && name == "" {
+				return s.Name, true
+			}
+		case *ast.EntityStatement:
+			if s.Name.Literal != definition {
+				continue
+			}
+			if name == "" {
+				return s.Name, true
+			}
+			for _, statements := range [][]ast.Statement{s.RelationStatements, s.AttributeStatements, s.PermissionStatements} {
+				for _, member := range statements {
+					var tkn token.Token
+					switch m := member.(type) {
+					case *ast.RelationStatement:
+						tkn = m.Name
+					case *ast.AttributeStatement:
+						tkn = m.Name
+					case *ast.PermissionStatement:
+						tkn = m.Name
+					}
+					if tkn.Literal == name {
+						return tkn, true
+					}
+				}
+			}
+		}
+	}
+	return token.Token{}, false
+}
+
+// AssertedPermissions - Returns the permissions asserted by the checks and the entity filters of the scenarios of the
+// shape, such as document#view
+func AssertedPermissions(s *file.Shape) []string {
+	var keys []string
+	for _, scenario := range s.Scenarios {
+		for _, check := range scenario.Checks {
+			entityType := strings.SplitN(check.Entity, ":", 2)[0]
+			for permission := range check.Assertions {
+				keys = append(keys, utils.Key(entityType, permission))
+			}
+		}
+		for _, filter := range scenario.EntityFilters {
+			for _, assertions := range []map[string][]string{filter.Assertions, filter.Contains, filter.Excludes} {
+				for permission := range assertions {
+					keys = append(keys, utils.Key(filter.EntityType, permission))
+				}
+			}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,341 @@
+// Package permifytest runs a schema on an in-memory development container in Go tests. The relationships and the
+// attributes are written and the checks, the lookups and the expand trees are asserted with the testing.T of the
+// test instead of the scenarios of a shape file:
+//
+//	func TestDocuments(t *testing.T) {
+//		c := permifytest.New(t, schema)
+//		c.Write("document:1#owner@user:1", "document:2$public|boolean:true")
+//
+//		c.AssertAllowed("document:1", "edit", "user:1")
+//		c.AssertDenied("document:1", "edit", "user:2")
+//		c.AssertLookupEntity("document", "view", "user:1", "1", "2")
+//		c.AssertExpandGolden("document:1", "edit", "testdata/document_edit.golden")
+//	}
+package permifytest
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Permify/permify/pkg/development"
+	"github.com/Permify/permify/pkg/development/file"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// UpdateGoldenEnv - the environment variable the golden files are written instead of compared if it is set to true
+const UpdateGoldenEnv = "PERMIFY_UPDATE_GOLDEN"
+
+// T - The methods of testing.T the container reports the failures with
+type T interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+}
+
+// Container - A development container with the schema of a test loaded
+type Container struct {
+	t   T
+	ctx context.Context
+	dev *development.Development
+}
+
+// New - Creates a new container with the schema, the test fails if the schema is not valid
+func New(t T, schema string) *Container {
+	t.Helper()
+	return NewWithShape(t, &file.Shape{Schema: schema})
+}
+
+// NewWithShape - Creates a new container running the shape, the errors of its schema, relationships, attributes and
+// scenarios fail the test
+func NewWithShape(t T, shape *file.Shape) *Container {
+	t.Helper()
+
+	c := &Container{t: t, ctx: context.Background(), dev: development.NewContainer()}
+	for _, e := range c.dev.RunWithShape(c.ctx, shape) {
+		if e.Type == "schema" {
+			t.Fatalf("schema: %s", e.Message)
+			return c
+		}
+		t.Errorf("%s: %v: %s", e.Type, e.Key, e.Message)
+	}
+	return c
+}
+
+// NewFromFile - Creates a new container running the shape file, such as the file of permify validate
+func NewFromFile(t T, path string) *Container {
+	t.Helper()
+
+	u, err := url.Parse(path)
+	if err != nil {
+		t.Fatalf("%s: %v", path, err)
+		return nil
+	}
+
+	decoder, err := file.NewDecoderFromURL(u)
+	if err != nil {
+		t.Fatalf("%s: %v", path, err)
+		return nil
+	}
+
+	s := &file.Shape{Name: path}
+	if err = decoder.Decode(s); err != nil {
+		t.Fatalf("%s: %v", path, err)
+		return nil
+	}
+	return NewWithShape(t, s)
+}
+
+// Development - Returns the development container, for the calls the helpers do not cover
+func (c *Container) Development() *development.Development {
+	return c.dev
+}
+
+// Write - Writes the relationships, such as document:1#owner@user:1, and the attributes, such as
+// document:1$public|boolean:true, the test fails if one of them is not valid for the schema
+func (c *Container) Write(data ...string) *Container {
+	c.t.Helper()
+
+	var relationships, attributes []string
+	for _, d := range data {
+		if strings.Contains(d, "$") {
+			attributes = append(attributes, d)
+			continue
+		}
+		relationships = append(relationships, d)
+	}
+
+	if errs := c.dev.Write(c.ctx, relationships, attributes); len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, e := range errs {
+			messages = append(messages, fmt.Sprintf("%v: %s", e.Key, e.Message))
+		}
+		c.t.Fatalf("write: %s", strings.Join(messages, ", "))
+	}
+	return c
+}
+
+// Check - Returns whether the subject, such as user:1 or group:1#member, has the permission on the entity
+func (c *Container) Check(entity, permission, subject string) bool {
+	c.t.Helper()
+
+	e, s, ok := c.parse(entity, subject)
+	if !ok {
+		return false
+	}
+
+	res, err := c.dev.Container.Invoker.Check(c.ctx, &v1.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.PermissionCheckRequestMetadata{SnapToken: token.NewNoopToken().Encode().String(), Depth: 100},
+		Entity:     e,
+		Permission: permission,
+		Subject:    s,
+	})
+	if err != nil {
+		c.t.Fatalf("check %s %s %s: %v", entity, permission, subject, err)
+		return false
+	}
+	return res.GetCan() == v1.CheckResult_CHECK_RESULT_ALLOWED
+}
+
+// AssertAllowed - Fails the test if the subject does not have the permission on the entity
+func (c *Container) AssertAllowed(entity, permission, subject string) {
+	c.t.Helper()
+	if !c.Check(entity, permission, subject) {
+		c.t.Errorf("%s %s %s: expected allowed, got denied", subject, permission, entity)
+	}
+}
+
+// AssertDenied - Fails the test if the subject has the permission on the entity
+func (c *Container) AssertDenied(entity, permission, subject string) {
+	c.t.Helper()
+	if c.Check(entity, permission, subject) {
+		c.t.Errorf("%s %s %s: expected denied, got allowed", subject, permission, entity)
+	}
+}
+
+// LookupEntity - Returns the sorted ids of the entities of the type the subject has the permission on
+func (c *Container) LookupEntity(entityType, permission, subject string) []string {
+	c.t.Helper()
+
+	s, ok := c.subject(subject)
+	if !ok {
+		return nil
+	}
+
+	res, err := c.dev.Container.Invoker.LookupEntity(c.ctx, &v1.PermissionLookupEntityRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.PermissionLookupEntityRequestMetadata{SnapToken: token.NewNoopToken().Encode().String(), Depth: 100},
+		EntityType: entityType,
+		Permission: permission,
+		Subject:    s,
+	})
+	if err != nil {
+		c.t.Fatalf("lookup entity %s %s %s: %v", entityType, permission, subject, err)
+		return nil
+	}
+
+	ids := slices.Clone(res.GetEntityIds())
+	slices.Sort(ids)
+	return ids
+}
+
+// AssertLookupEntity - Fails the test if the ids of the entities of the type the subject has the permission on are
+// not the expected ids, in any order
+func (c *Container) AssertLookupEntity(entityType, permission, subject string, expected ...string) {
+	c.t.Helper()
+	if actual := c.LookupEntity(entityType, permission, subject); !sameIDs(actual, expected) {
+		c.t.Errorf("lookup entity %s %s %s: expected %v, got %v", entityType, permission, subject, sorted(expected), actual)
+	}
+}
+
+// LookupSubject - Returns the sorted ids of the subjects of the reference, such as user or group#member, that have
+// the permission on the entity
+func (c *Container) LookupSubject(entity, permission, subjectReference string) []string {
+	c.t.Helper()
+
+	e, err := tuple.E(entity)
+	if err != nil {
+		c.t.Fatalf("%s: %v", entity, err)
+		return nil
+	}
+
+	res, err := c.dev.Container.Invoker.LookupSubject(c.ctx, &v1.PermissionLookupSubjectRequest{
+		TenantId:         "t1",
+		Metadata:         &v1.PermissionLookupSubjectRequestMetadata{SnapToken: token.NewNoopToken().Encode().String(), Depth: 100},
+		Entity:           e,
+		Permission:       permission,
+		SubjectReference: tuple.RelationReference(subjectReference),
+	})
+	if err != nil {
+		c.t.Fatalf("lookup subject %s %s %s: %v", entity, permission, subjectReference, err)
+		return nil
+	}
+
+	ids := slices.Clone(res.GetSubjectIds())
+	slices.Sort(ids)
+	return ids
+}
+
+// AssertLookupSubject - Fails the test if the ids of the subjects of the reference that have the permission on the
+// entity are not the expected ids, in any order
+func (c *Container) AssertLookupSubject(entity, permission, subjectReference string, expected ...string) {
+	c.t.Helper()
+	if actual := c.LookupSubject(entity, permission, subjectReference); !sameIDs(actual, expected) {
+		c.t.Errorf("lookup subject %s %s %s: expected %v, got %v", entity, permission, subjectReference, sorted(expected), actual)
+	}
+}
+
+// Expand - Returns the expand tree of the permission of the entity, rendered as the trees of the shape files
+func (c *Container) Expand(entity, permission string) string {
+	c.t.Helper()
+
+	e, err := tuple.E(entity)
+	if err != nil {
+		c.t.Fatalf("%s: %v", entity, err)
+		return ""
+	}
+
+	res, err := c.dev.Container.Invoker.Expand(c.ctx, &v1.PermissionExpandRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.PermissionExpandRequestMetadata{SnapToken: token.NewNoopToken().Encode().String()},
+		Entity:     e,
+		Permission: permission,
+	})
+	if err != nil {
+		c.t.Fatalf("expand %s %s: %v", entity, permission, err)
+		return ""
+	}
+	return development.ExpandTree(res.GetTree())
+}
+
+// AssertExpand - Fails the test if the expand tree of the permission of the entity is not the expected tree, the
+// trailing spaces and the empty lines are ignored
+func (c *Container) AssertExpand(entity, permission, expected string) {
+	c.t.Helper()
+	if actual := c.Expand(entity, permission); !development.SameTree(expected, actual) {
+		c.t.Errorf("expand %s#%s:\nexpected:\n%s\nactual:\n%s", entity, permission, expected, actual)
+	}
+}
+
+// AssertExpandGolden - Fails the test if the expand tree of the permission of the entity is not the tree of the
+// golden file. The golden file is written with the tree instead, creating its directory, if the PERMIFY_UPDATE_GOLDEN
+// environment variable is true, such as PERMIFY_UPDATE_GOLDEN=true go test ./...
+func (c *Container) AssertExpandGolden(entity, permission, path string) {
+	c.t.Helper()
+
+	actual := c.Expand(entity, permission)
+	if os.Getenv(UpdateGoldenEnv) == "true" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			c.t.Fatalf("golden file %s: %v", path, err)
+			return
+		}
+		if err := os.WriteFile(path, []byte(actual), 0o644); err != nil {
+			c.t.Fatalf("golden file %s: %v", path, err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		c.t.Fatalf("golden file %s: %v, run the test with %s=true to write it", path, err, UpdateGoldenEnv)
+		return
+	}
+
+	if !development.SameTree(string(expected), actual) {
+		c.t.Errorf("expand %s#%s does not match the golden file %s, run the test with %s=true to update it:\nexpected:\n%s\nactual:\n%s",
+			entity, permission, path, UpdateGoldenEnv, expected, actual)
+	}
+}
+
+// parse - Parses the entity and the subject, the test fails if one of them is not valid
+func (c *Container) parse(entity, subject string) (*v1.Entity, *v1.Subject, bool) {
+	c.t.Helper()
+
+	e, err := tuple.E(entity)
+	if err != nil {
+		c.t.Fatalf("%s: %v", entity, err)
+		return nil, nil, false
+	}
+
+	s, ok := c.subject(subject)
+	return e, s, ok
+}
+
+// subject - Parses the subject, such as user:1 or group:1#member, the test fails if it is not valid
+func (c *Container) subject(subject string) (*v1.Subject, bool) {
+	c.t.Helper()
+
+	ear, err := tuple.EAR(subject)
+	if err != nil {
+		c.t.Fatalf("%s: %v", subject, err)
+		return nil, false
+	}
+
+	return &v1.Subject{
+		Type:     ear.GetEntity().GetType(),
+		Id:       ear.GetEntity().GetId(),
+		Relation: ear.GetRelation(),
+	}, true
+}
+
+// sameIDs - Returns whether the ids are the same in any order
+func sameIDs(actual, expected []string) bool {
+	return slices.Equal(sorted(actual), sorted(expected))
+}
+
+// sorted - Returns a sorted copy of the ids
+func sorted(ids []string) []string {
+	s := slices.Clone(ids)
+	slices.Sort(s)
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,157 @@
+package permifytest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/pkg/development/file"
+)
+
+// TestPermifytest -
+func TestPermifytest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "permifytest-suite")
+}
+
+// recorder - records the failures reported to it
+type recorder struct {
+	errors []string
+	fatals []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.fatals = append(r.fatals, fmt.Sprintf(format, args...))
+}
+
+var _ = Describe("permifytest", func() {
+	schema := `
+	entity user {}
+
+	entity group {
+		relation member @user
+	}
+
+	entity document {
+		relation owner @user
+		relation editor @user @group#member
+		attribute public boolean
+		permission edit = owner or editor
+		permission view = edit or public
+	}`
+
+	// container - returns a container with the schema and the relationships and attributes of the tests
+	container := func(t T) *Container {
+		return New(t, schema).Write(
+			"document:1#owner@user:1",
+			"document:1#editor@group:1#member",
+			"group:1#member@user:2",
+			"document:2$public|boolean:true",
+		)
+	}
+
+	Context("Assertions", func() {
+		It("Case 1: passing assertions", func() {
+			c := container(GinkgoT())
+
+			c.AssertAllowed("document:1", "edit", "user:2")
+			c.AssertDenied("document:1", "edit", "user:3")
+			c.AssertLookupEntity("document", "view", "user:1", "1", "2")
+			c.AssertLookupSubject("document:1", "edit", "user", "2", "1")
+			c.AssertExpandGolden("document:1", "edit", "testdata/document_edit.golden")
+		})
+
+		It("Case 2: failing assertions are reported", func() {
+			r := &recorder{}
+			c := container(r)
+
+			c.AssertAllowed("document:1", "edit", "user:3")
+			c.AssertDenied("document:1", "edit", "user:1")
+			c.AssertLookupEntity("document", "edit", "user:1", "1", "2")
+			c.AssertExpand("document:2", "edit", "document:2#edit union")
+
+			Expect(r.fatals).Should(BeEmpty())
+			Expect(r.errors).Should(HaveLen(4))
+			Expect(r.errors[0]).Should(Equal("user:3 edit document:1: expected allowed, got denied"))
+			Expect(r.errors[1]).Should(Equal("user:1 edit document:1: expected denied, got allowed"))
+			Expect(r.errors[2]).Should(Equal("lookup entity document edit user:1: expected [1 2], got [1]"))
+			Expect(r.errors[3]).Should(ContainSubstring("expand document:2#edit:"))
+		})
+
+		It("Case 3: invalid schema, data and queries are fatal", func() {
+			r := &recorder{}
+			New(r, "entity user {")
+			Expect(r.fatals).Should(HaveLen(1))
+
+			r = &recorder{}
+			c := New(r, schema).Write("document:1#viewer@user:1", "document:1$public|integer:1")
+			Expect(r.fatals).Should(HaveLen(1))
+			Expect(r.fatals[0]).Should(HavePrefix("write: document:1#viewer@user:1: ERROR_CODE_RELATION_DEFINITION_NOT_FOUND, document:1$public|integer:1: "))
+
+			r = &recorder{}
+			c.t = r
+			c.Check("document", "edit", "user:1")
+			c.Check("document:1", "delete", "user:1")
+			Expect(r.fatals).Should(HaveLen(2))
+		})
+
+		It("Case 4: scenarios of the shape fail the test", func() {
+			r := &recorder{}
+			NewWithShape(r, &file.Shape{
+				Schema:        schema,
+				Relationships: []string{"document:1#owner@user:1"},
+				Scenarios: []file.Scenario{{
+					Name: "owner",
+					Checks: []file.Check{{
+						Entity:     "document:1",
+						Subject:    "user:2",
+						Assertions: map[string]bool{"edit": true},
+					}},
+				}},
+			})
+
+			Expect(r.fatals).Should(BeEmpty())
+			Expect(r.errors).Should(Equal([]string{"scenarios: 0: Query: user:2 edit document:1, Expected: true, Actual: false"}))
+		})
+	})
+
+	Context("Golden", func() {
+		It("Case 1: missing and different golden files", func() {
+			r := &recorder{}
+			c := container(r)
+
+			c.AssertExpandGolden("document:1", "edit", filepath.Join(GinkgoT().TempDir(), "missing.golden"))
+			Expect(r.fatals).Should(HaveLen(1))
+			Expect(r.fatals[0]).Should(ContainSubstring("run the test with PERMIFY_UPDATE_GOLDEN=true to write it"))
+
+			c.AssertExpandGolden("document:2", "edit", "testdata/document_edit.golden")
+			Expect(r.errors).Should(HaveLen(1))
+			Expect(r.errors[0]).Should(HavePrefix("expand document:2#edit does not match the golden file testdata/document_edit.golden"))
+		})
+
+		It("Case 2: golden files are written when they are updated", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "golden", "document_edit.golden")
+			GinkgoT().Setenv(UpdateGoldenEnv, "true")
+
+			c := container(GinkgoT())
+			c.AssertExpandGolden("document:1", "edit", path)
+
+			written, err := os.ReadFile(path)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			expected, err := os.ReadFile("testdata/document_edit.golden")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(written)).Should(Equal(string(expected)))
+		})
+	})
+})
@@ -0,0 +1,220 @@
+package repl
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Permify/permify/pkg/attribute"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Complete - Returns the completions of the last word of the line, the commands for the first word and the entity
+// types, the ids of the entities of the session and the relations, attributes and permissions of the schema for the
+// arguments of the commands
+func (r *REPL) Complete(line string) []string {
+	words := strings.Fields(line)
+	if len(words) == 0 || strings.HasSuffix(line, " ") {
+		words = append(words, "")
+	}
+	word := words[len(words)-1]
+
+	if len(words) == 1 {
+		names := make([]string, 0, len(commands))
+		for _, c := range commands {
+			names = append(names, c.name)
+		}
+		return matching(names, word)
+	}
+
+	if r.schema == nil {
+		return nil
+	}
+
+	switch position := len(words) - 1; words[0] {
+	case "write":
+		return r.completeRelationship(word)
+	case "check", "explain":
+		switch position {
+		case 1:
+			return r.completeEntity(word)
+		case 2:
+			return matching(r.names(entityType(words[1])), word)
+		case 3:
+			return r.completeSubject(word)
+		}
+	case "lookup":
+		switch position {
+		case 1:
+			return matching(r.entityTypes(), word)
+		case 2:
+			return matching(r.names(words[1]), word)
+		case 3:
+			return r.completeSubject(word)
+		}
+	case "subjects":
+		switch position {
+		case 1:
+			return r.completeEntity(word)
+		case 2:
+			return matching(r.names(entityType(words[1])), word)
+		case 3:
+			if i := strings.Index(word, "#"); i >= 0 {
+				return matching(prefixed(word[:i+1], r.relations(word[:i])), word)
+			}
+			return matching(r.entityTypes(), word)
+		}
+	case "expand":
+		switch position {
+		case 1:
+			return r.completeEntity(word)
+		case 2:
+			return matching(r.names(entityType(words[1])), word)
+		}
+	}
+	return nil
+}
+
+// CompleteLine - Returns the line with its last word completed, to the only completion or to the common prefix of the
+// completions. A space is added after a completed argument unless it continues, such as an entity type before its id.
+func (r *REPL) CompleteLine(line string) string {
+	completions := r.Complete(line)
+	if len(completions) == 0 {
+		return line
+	}
+
+	start := strings.LastIndexAny(line, " \t") + 1
+	completed := completions[0]
+	for _, c := range completions[1:] {
+		for !strings.HasPrefix(c, completed) {
+			completed = completed[:len(completed)-1]
+		}
+	}
+
+	if len(completions) == 1 && !strings.HasSuffix(completed, ":") && !strings.HasSuffix(completed, "#") &&
+		!strings.HasSuffix(completed, "@") && !strings.HasSuffix(completed, "$") {
+		completed += " "
+	}
+
+	if len(completed) < len(line)-start {
+		return line
+	}
+	return line[:start] + completed
+}
+
+// completeRelationship - Returns the completions of a relationship, such as document:1#owner@user:1, or of an
+// attribute, such as document:1$public|boolean:true
+func (r *REPL) completeRelationship(word string) []string {
+	if i := strings.Index(word, "@"); i >= 0 {
+		return prefixed(word[:i+1], r.completeSubject(word[i+1:]))
+	}
+
+	if i := strings.Index(word, "$"); i >= 0 {
+		definition := r.schema.GetEntityDefinitions()[entityType(word[:i])]
+		var attributes []string
+		for name, a := range definition.GetAttributes() {
+			attributes = append(attributes, word[:i+1]+name+"|"+attribute.TypeToString(a.GetType())+":")
+		}
+		return matching(attributes, word)
+	}
+
+	if i := strings.Index(word, "#"); i >= 0 {
+		return matching(prefixed(word[:i+1], suffixed(r.relations(entityType(word[:i])), "@")), word)
+	}
+
+	return r.completeEntity(word)
+}
+
+// completeSubject - Returns the completions of a subject, such as user:1 or group:1#member
+func (r *REPL) completeSubject(word string) []string {
+	if i := strings.Index(word, "#"); i >= 0 {
+		return matching(prefixed(word[:i+1], r.relations(entityType(word[:i]))), word)
+	}
+	return r.completeEntity(word)
+}
+
+// completeEntity - Returns the completions of an entity, the entity types followed by a colon and then the ids of the
+// entities of the type in the session
+func (r *REPL) completeEntity(word string) []string {
+	i := strings.Index(word, ":")
+	if i < 0 {
+		return matching(suffixed(r.entityTypes(), ":"), word)
+	}
+
+	ids := make([]string, 0, len(r.ids[word[:i]]))
+	for id := range r.ids[word[:i]] {
+		ids = append(ids, word[:i+1]+id)
+	}
+	return matching(ids, word)
+}
+
+// entityTypes - Returns the entity types of the schema
+func (r *REPL) entityTypes() []string {
+	types := make([]string, 0, len(r.schema.GetEntityDefinitions()))
+	for name := range r.schema.GetEntityDefinitions() {
+		types = append(types, name)
+	}
+	return types
+}
+
+// relations - Returns the relations of the entity type
+func (r *REPL) relations(entityType string) []string {
+	var relations []string
+	for name := range r.schema.GetEntityDefinitions()[entityType].GetRelations() {
+		relations = append(relations, name)
+	}
+	return relations
+}
+
+// names - Returns the permissions, the relations and the attributes of the entity type, which can be checked
+func (r *REPL) names(entityType string) []string {
+	definition := r.schema.GetEntityDefinitions()[entityType]
+
+	var names []string
+	for name := range definition.GetPermissions() {
+		names = append(names, name)
+	}
+	for name := range definition.GetRelations() {
+		names = append(names, name)
+	}
+	for name, a := range definition.GetAttributes() {
+		if a.GetType() == v1.AttributeType_ATTRIBUTE_TYPE_BOOLEAN {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// entityType - Returns the type of an entity, such as document of document:1
+func entityType(entity string) string {
+	return strings.SplitN(entity, ":", 2)[0]
+}
+
+// matching - Returns the sorted candidates starting with the word
+func matching(candidates []string, word string) []string {
+	var result []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, word) {
+			result = append(result, c)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// prefixed - Returns the candidates with the prefix
+func prefixed(prefix string, candidates []string) []string {
+	result := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		result = append(result, prefix+c)
+	}
+	return result
+}
+
+// suffixed - Returns the candidates with the suffix
+func suffixed(candidates []string, suffix string) []string {
+	result := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		result = append(result, c+suffix)
+	}
+	return result
+}
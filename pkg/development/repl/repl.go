@@ -0,0 +1,438 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/development"
+	"github.com/Permify/permify/pkg/development/file"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// ErrExit - Returned by Eval for the exit command
+var ErrExit = errors.New("exit")
+
+// ShapeReader - Reads the shape of a schema or a shape file
+type ShapeReader func(path string) (*file.Shape, error)
+
+// REPL - Evaluates the commands of an interactive session on a development container, the relationships and the
+// attributes written in the session are kept until another shape is loaded
+type REPL struct {
+	out  io.Writer
+	read ShapeReader
+
+	dev    *development.Development
+	shape  *file.Shape
+	schema *v1.SchemaDefinition
+
+	// ids are the ids of the entities of the relationships and the attributes, by entity type, for the completion
+	ids map[string]map[string]bool
+
+	history []string
+}
+
+// command - A command of the session with its usage and description for the help
+type command struct {
+	name        string
+	usage       string
+	description string
+}
+
+// commands - the commands of the session in the order of the help
+var commands = []command{
+	{"write", "write <relationship|attribute>...", "writes relationships, such as document:1#owner@user:1, and attributes, such as document:1$public|boolean:true"},
+	{"check", "check <entity> <permission> <subject>", "checks whether the subject has the permission on the entity, such as check document:1 edit user:1"},
+	{"lookup", "lookup <entity type> <permission> <subject>", "lists the entities of the type the subject has the permission on, such as lookup document edit user:1"},
+	{"subjects", "subjects <entity> <permission> <subject type>", "lists the subjects of the type that have the permission on the entity, such as subjects document:1 edit user"},
+	{"expand", "expand <entity> <permission>", "prints the expand tree of the permission of the entity, such as expand document:1 edit"},
+	{"explain", "explain <entity> <permission> <subject>", "checks the permission and prints the expand tree with whether the subject is in each node"},
+	{"load", "load <file>", "loads a schema or a shape file into a new container, the data written in the session is dropped"},
+	{"schema", "schema", "prints the schema"},
+	{"history", "history", "prints the history of the commands"},
+	{"help", "help", "prints the commands"},
+	{"exit", "exit", "ends the session"},
+}
+
+// errUsage - Returned by the commands called with wrong arguments, Eval returns the usage of the command instead
+var errUsage = errors.New("usage")
+
+// New - Creates a new session printing to the writer, the reader reads the files of the load command
+func New(out io.Writer, read ShapeReader) *REPL {
+	return &REPL{
+		out:  out,
+		read: read,
+		ids:  map[string]map[string]bool{},
+	}
+}
+
+// Load - Runs the shape on a new container, the errors of its relationships, attributes and scenarios are returned
+// with the shape loaded, the shape is not loaded if its schema has errors
+func (r *REPL) Load(ctx context.Context, shape *file.Shape) []development.Error {
+	dev := development.NewContainer()
+
+	errs := dev.RunWithShape(ctx, shape)
+	for _, e := range errs {
+		if e.Type == "schema" {
+			return errs
+		}
+	}
+
+	sch, err := dev.ReadSchema(ctx)
+	if err != nil {
+		return append(errs, development.Error{Type: "schema", Key: "", Message: err.Error()})
+	}
+
+	r.dev, r.shape, r.schema = dev, shape, sch
+	r.ids = map[string]map[string]bool{}
+	for _, t := range shape.Relationships {
+		if tup, err := tuple.Tuple(t); err == nil {
+			r.addEntity(tup.GetEntity())
+			r.addEntity(&v1.Entity{Type: tup.GetSubject().GetType(), Id: tup.GetSubject().GetId()})
+		}
+	}
+	for _, a := range shape.Attributes {
+		if attr, err := attribute.Attribute(a); err == nil {
+			r.addEntity(attr.GetEntity())
+		}
+	}
+	return errs
+}
+
+// AddHistory - Adds the lines to the history of the session, such as the lines of the previous sessions
+func (r *REPL) AddHistory(lines ...string) {
+	r.history = append(r.history, lines...)
+}
+
+// Eval - Evaluates a line of the session, ErrExit is returned for the exit command
+func (r *REPL) Eval(ctx context.Context, line string) error {
+	args := strings.Fields(line)
+	if len(args) == 0 {
+		return nil
+	}
+	r.history = append(r.history, strings.TrimSpace(line))
+
+	name := args[0]
+	if name == "quit" {
+		name = "exit"
+	}
+
+	i := slices.IndexFunc(commands, func(c command) bool { return c.name == name })
+	if i < 0 {
+		return fmt.Errorf("unknown command %s, type help for the commands", args[0])
+	}
+
+	var err error
+	switch name {
+	case "load":
+		err = r.load(ctx, args[1:])
+	case "help":
+		r.help()
+	case "history":
+		r.printHistory()
+	case "exit":
+		return ErrExit
+	default:
+		if r.dev == nil {
+			return errors.New("no schema is loaded, load a schema or a shape file first")
+		}
+
+		switch name {
+		case "write":
+			err = r.write(ctx, args[1:])
+		case "check":
+			err = r.check(ctx, args[1:])
+		case "lookup":
+			err = r.lookup(ctx, args[1:])
+		case "subjects":
+			err = r.subjects(ctx, args[1:])
+		case "expand":
+			err = r.expand(ctx, args[1:])
+		case "explain":
+			err = r.explain(ctx, args[1:])
+		case "schema":
+			fmt.Fprintln(r.out, strings.TrimSpace(r.shape.Schema))
+		}
+	}
+
+	if errors.Is(err, errUsage) {
+		return fmt.Errorf("usage: %s", commands[i].usage)
+	}
+	return err
+}
+
+// write - Validates the relationships and the attributes against the schema and writes them
+func (r *REPL) write(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	var relationships, attributes []string
+	for _, arg := range args {
+		if strings.Contains(arg, "$") {
+			attributes = append(attributes, arg)
+			continue
+		}
+		relationships = append(relationships, arg)
+	}
+
+	if errs := r.dev.Write(ctx, relationships, attributes); len(errs) > 0 {
+		return fmt.Errorf("%v: %s", errs[0].Key, errs[0].Message)
+	}
+
+	for _, t := range relationships {
+		tup, _ := tuple.Tuple(t)
+		r.addEntity(tup.GetEntity())
+		r.addEntity(&v1.Entity{Type: tup.GetSubject().GetType(), Id: tup.GetSubject().GetId()})
+	}
+	for _, a := range attributes {
+		attr, _ := attribute.Attribute(a)
+		r.addEntity(attr.GetEntity())
+	}
+
+	fmt.Fprintf(r.out, "written %d relationships and %d attributes\n", len(relationships), len(attributes))
+	return nil
+}
+
+// check - Checks the permission of the entity for the subject
+func (r *REPL) check(ctx context.Context, args []string) error {
+	entity, permission, subject, err := checkArguments(args)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.dev.Container.Invoker.Check(ctx, &v1.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.PermissionCheckRequestMetadata{SnapToken: token.NewNoopToken().Encode().String(), Depth: 100},
+		Entity:     entity,
+		Permission: permission,
+		Subject:    subject,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(r.out, result(res.GetCan()))
+	return nil
+}
+
+// lookup - Lists the entities of the type the subject has the permission on
+func (r *REPL) lookup(ctx context.Context, args []string) error {
+	if len(args) != 3 {
+		return errUsage
+	}
+
+	subject, err := parseSubject(args[2])
+	if err != nil {
+		return err
+	}
+
+	res, err := r.dev.Container.Invoker.LookupEntity(ctx, &v1.PermissionLookupEntityRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.PermissionLookupEntityRequestMetadata{SnapToken: token.NewNoopToken().Encode().String(), Depth: 100},
+		EntityType: args[0],
+		Permission: args[1],
+		Subject:    subject,
+	})
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(res.GetEntityIds()))
+	for _, id := range res.GetEntityIds() {
+		ids = append(ids, tuple.EntityToString(&v1.Entity{Type: args[0], Id: id}))
+	}
+	r.printList(ids)
+	return nil
+}
+
+// subjects - Lists the subjects of the type that have the permission on the entity
+func (r *REPL) subjects(ctx context.Context, args []string) error {
+	if len(args) != 3 {
+		return errUsage
+	}
+
+	entity, err := tuple.E(args[0])
+	if err != nil {
+		return err
+	}
+
+	reference := tuple.RelationReference(args[2])
+	res, err := r.dev.Container.Invoker.LookupSubject(ctx, &v1.PermissionLookupSubjectRequest{
+		TenantId:         "t1",
+		Metadata:         &v1.PermissionLookupSubjectRequestMetadata{SnapToken: token.NewNoopToken().Encode().String(), Depth: 100},
+		Entity:           entity,
+		Permission:       args[1],
+		SubjectReference: reference,
+	})
+	if err != nil {
+		return err
+	}
+
+	subjects := make([]string, 0, len(res.GetSubjectIds()))
+	for _, id := range res.GetSubjectIds() {
+		subjects = append(subjects, tuple.SubjectToString(&v1.Subject{Type: reference.GetType(), Id: id, Relation: reference.GetRelation()}))
+	}
+	r.printList(subjects)
+	return nil
+}
+
+// expand - Prints the expand tree of the permission of the entity
+func (r *REPL) expand(ctx context.Context, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+
+	entity, err := tuple.E(args[0])
+	if err != nil {
+		return err
+	}
+
+	tree, err := r.expandTree(ctx, entity, args[1])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(r.out, development.ExpandTree(tree))
+	return nil
+}
+
+// explain - Checks the permission and prints the expand tree with whether the subject is in each node
+func (r *REPL) explain(ctx context.Context, args []string) error {
+	entity, permission, subject, err := checkArguments(args)
+	if err != nil {
+		return err
+	}
+
+	if err = r.check(ctx, args); err != nil {
+		return err
+	}
+
+	tree, err := r.expandTree(ctx, entity, permission)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(r.out, development.ExplainTree(tree, subject))
+	return nil
+}
+
+// load - Loads a schema or a shape file into a new container
+func (r *REPL) load(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	shape, err := r.read(args[0])
+	if err != nil {
+		return err
+	}
+
+	errs := r.Load(ctx, shape)
+	for _, e := range errs {
+		fmt.Fprintf(r.out, "%s: %v: %s\n", e.Type, e.Key, e.Message)
+	}
+	if r.shape != shape {
+		return fmt.Errorf("%s is not loaded", args[0])
+	}
+
+	fmt.Fprintf(r.out, "loaded %s\n", args[0])
+	return nil
+}
+
+// printHistory - Prints the history of the session
+func (r *REPL) printHistory() {
+	for i, line := range r.history {
+		fmt.Fprintf(r.out, "%5d  %s\n", i+1, line)
+	}
+}
+
+// help - Prints the commands
+func (r *REPL) help() {
+	for _, c := range commands {
+		fmt.Fprintf(r.out, "%-46s %s\n", c.usage, c.description)
+	}
+}
+
+// expandTree - Returns the expand tree of the permission of the entity
+func (r *REPL) expandTree(ctx context.Context, entity *v1.Entity, permission string) (*v1.Expand, error) {
+	res, err := r.dev.Container.Invoker.Expand(ctx, &v1.PermissionExpandRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.PermissionExpandRequestMetadata{SnapToken: token.NewNoopToken().Encode().String()},
+		Entity:     entity,
+		Permission: permission,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetTree(), nil
+}
+
+// addEntity - Adds the entity to the ids of the completion
+func (r *REPL) addEntity(entity *v1.Entity) {
+	if entity.GetType() == "" || entity.GetId() == "" || entity.GetId() == tuple.ELLIPSIS {
+		return
+	}
+	if r.ids[entity.GetType()] == nil {
+		r.ids[entity.GetType()] = map[string]bool{}
+	}
+	r.ids[entity.GetType()][entity.GetId()] = true
+}
+
+// printList - Prints the items sorted, one per line
+func (r *REPL) printList(items []string) {
+	if len(items) == 0 {
+		fmt.Fprintln(r.out, "(none)")
+		return
+	}
+	sort.Strings(items)
+	for _, item := range items {
+		fmt.Fprintln(r.out, item)
+	}
+}
+
+// checkArguments - Parses the entity, the permission and the subject of the check and the explain commands
+func checkArguments(args []string) (*v1.Entity, string, *v1.Subject, error) {
+	if len(args) != 3 {
+		return nil, "", nil, errUsage
+	}
+
+	entity, err := tuple.E(args[0])
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	subject, err := parseSubject(args[2])
+	if err != nil {
+		return nil, "", nil, err
+	}
+	return entity, args[1], subject, nil
+}
+
+// parseSubject - Parses a subject, such as user:1 or group:1#member
+func parseSubject(s string) (*v1.Subject, error) {
+	ear, err := tuple.EAR(s)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.Subject{
+		Type:     ear.GetEntity().GetType(),
+		Id:       ear.GetEntity().GetId(),
+		Relation: ear.GetRelation(),
+	}, nil
+}
+
+// result - Returns the result of a check
+func result(can v1.CheckResult) string {
+	if can == v1.CheckResult_CHECK_RESULT_ALLOWED {
+		return "allowed"
+	}
+	return "denied"
+}
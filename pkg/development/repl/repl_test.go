@@ -0,0 +1,167 @@
+package repl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/pkg/development/file"
+)
+
+// TestRepl -
+func TestRepl(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "repl-suite")
+}
+
+var _ = Describe("repl", func() {
+	shape := &file.Shape{
+		Schema: `
+		entity user {}
+
+		entity group {
+			relation member @user
+		}
+
+		entity document {
+			relation owner @user
+			relation editor @user @group#member
+			attribute public boolean
+			permission edit = owner or editor
+			permission view = edit or public
+		}`,
+		Relationships: []string{
+			"document:1#owner@user:1",
+		},
+	}
+
+	// session - returns a session with the shape loaded and the buffer it prints to
+	session := func() (*REPL, *bytes.Buffer) {
+		out := &bytes.Buffer{}
+		r := New(out, func(path string) (*file.Shape, error) {
+			if path != "shape.yaml" {
+				return nil, errors.New("file not found")
+			}
+			return shape, nil
+		})
+		Expect(r.Eval(context.Background(), "load shape.yaml")).ShouldNot(HaveOccurred())
+		out.Reset()
+		return r, out
+	}
+
+	// eval - evaluates the line and returns what is printed
+	eval := func(r *REPL, out *bytes.Buffer, line string) string {
+		out.Reset()
+		Expect(r.Eval(context.Background(), line)).ShouldNot(HaveOccurred())
+		return out.String()
+	}
+
+	Context("Eval", func() {
+		It("Case 1: written relationships are checked, looked up and expanded", func() {
+			r, out := session()
+
+			Expect(eval(r, out, "check document:1 edit user:2")).Should(Equal("denied\n"))
+			Expect(eval(r, out, "write document:1#editor@group:1#member group:1#member@user:2 document:2$public|boolean:true")).
+				Should(Equal("written 2 relationships and 1 attributes\n"))
+			Expect(eval(r, out, "check document:1 edit user:2")).Should(Equal("allowed\n"))
+			Expect(eval(r, out, "lookup document edit user:1")).Should(Equal("document:1\n"))
+			Expect(eval(r, out, "lookup document edit user:3")).Should(Equal("(none)\n"))
+			Expect(eval(r, out, "subjects document:1 edit user")).Should(Equal("user:1\nuser:2\n"))
+			Expect(eval(r, out, "expand document:1 edit")).Should(Equal(`document:1#edit union
+  document:1#owner
+    user:1
+  document:1#editor union
+    group:1#member
+      user:2
+    document:1#editor
+      group:1#member
+`))
+		})
+
+		It("Case 2: explain marks the nodes the subject is in", func() {
+			r, out := session()
+
+			eval(r, out, "write document:1#editor@group:1#member group:1#member@user:2 document:2$public|boolean:true")
+
+			Expect(eval(r, out, "explain document:1 edit user:2")).Should(Equal(`allowed
+document:1#edit union ✓
+  document:1#owner ✗
+    user:1
+  document:1#editor union ✓
+    group:1#member ✓
+      user:2 ✓
+    document:1#editor ✗
+      group:1#member
+`))
+
+			Expect(eval(r, out, "explain document:2 view user:2")).Should(Equal(`allowed
+document:2#view union ?
+  document:2#edit union ✗
+    document:2#owner ✗
+    document:2#editor ✗
+  document:2#public ?
+    = true
+`))
+		})
+
+		It("Case 3: errors", func() {
+			r := New(&bytes.Buffer{}, func(string) (*file.Shape, error) {
+				return nil, errors.New("file not found")
+			})
+			ctx := context.Background()
+
+			Expect(r.Eval(ctx, "check document:1 edit user:1")).Should(MatchError("no schema is loaded, load a schema or a shape file first"))
+			Expect(r.Eval(ctx, "load missing.perm")).Should(MatchError("file not found"))
+			Expect(r.Eval(ctx, "delete document:1")).Should(MatchError("unknown command delete, type help for the commands"))
+			Expect(r.Eval(ctx, "quit")).Should(MatchError(ErrExit))
+
+			r, _ = session()
+			Expect(r.Eval(ctx, "check document:1 edit")).Should(MatchError("usage: check <entity> <permission> <subject>"))
+			Expect(r.Eval(ctx, "write document:1#viewer@user:1")).Should(MatchError("document:1#viewer@user:1: ERROR_CODE_RELATION_DEFINITION_NOT_FOUND"))
+			Expect(r.Eval(ctx, "write document:1$public|integer:1")).Should(HaveOccurred())
+		})
+
+		It("Case 4: history", func() {
+			r, out := session()
+			r.AddHistory("check document:1 edit user:1")
+
+			Expect(eval(r, out, "history")).Should(Equal("    1  load shape.yaml\n    2  check document:1 edit user:1\n    3  history\n"))
+		})
+	})
+
+	Context("Complete", func() {
+		It("Case 1: commands, entities, permissions and subjects", func() {
+			r, _ := session()
+
+			Expect(r.Complete("ex")).Should(Equal([]string{"exit", "expand", "explain"}))
+			Expect(r.Complete("check ")).Should(Equal([]string{"document:", "group:", "user:"}))
+			Expect(r.Complete("check document:")).Should(Equal([]string{"document:1"}))
+			Expect(r.Complete("check document:1 e")).Should(Equal([]string{"edit", "editor"}))
+			Expect(r.Complete("check document:1 edit u")).Should(Equal([]string{"user:"}))
+			Expect(r.Complete("lookup doc")).Should(Equal([]string{"document"}))
+			Expect(r.Complete("lookup document ")).Should(Equal([]string{"edit", "editor", "owner", "public", "view"}))
+			Expect(r.Complete("subjects document:1 edit group#")).Should(Equal([]string{"group#member"}))
+		})
+
+		It("Case 2: relationships and attributes", func() {
+			r, _ := session()
+
+			Expect(r.Complete("write document:1#")).Should(Equal([]string{"document:1#editor@", "document:1#owner@"}))
+			Expect(r.Complete("write document:1#editor@group:1#")).Should(Equal([]string{"document:1#editor@group:1#member"}))
+			Expect(r.Complete("write document:1$")).Should(Equal([]string{"document:1$public|boolean:"}))
+		})
+
+		It("Case 3: CompleteLine completes to the common prefix", func() {
+			r, _ := session()
+
+			Expect(r.CompleteLine("ch")).Should(Equal("check "))
+			Expect(r.CompleteLine("check doc")).Should(Equal("check document:"))
+			Expect(r.CompleteLine("check document:1 ed")).Should(Equal("check document:1 edit"))
+			Expect(r.CompleteLine("check document:1 xyz")).Should(Equal("check document:1 xyz"))
+		})
+	})
+})
@@ -0,0 +1,242 @@
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Kinds of the cases
+const (
+	KindSchema            = "schema"
+	KindRelationship      = "relationship"
+	KindAttribute         = "attribute"
+	KindCheck             = "check"
+	KindEntityFilter      = "entity_filter"
+	KindSubjectFilter     = "subject_filter"
+	KindSubjectPermission = "subject_permission"
+	KindExpand            = "expand"
+)
+
+// Report - the results of the validation of shape files
+type Report struct {
+	Suites []Suite `json:"suites"`
+}
+
+// Suite - the results of the validation of a shape file
+type Suite struct {
+	// Name is the path of the shape file.
+	Name string `json:"name"`
+	// Error is the error that prevented the file from being validated, such as a schema that does not compile.
+	Error string `json:"error,omitempty"`
+	// Setup holds the cases writing the schema, the relationships and the attributes of the file.
+	Setup     []Case     `json:"setup"`
+	Scenarios []Scenario `json:"scenarios"`
+	// Time is the duration of the validation of the file in seconds.
+	Time float64 `json:"time"`
+}
+
+// Scenario - the results of a scenario of a shape file
+type Scenario struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Cases       []Case `json:"cases"`
+}
+
+// Case - the result of a single assertion of a scenario, or of writing a part of a shape file
+type Case struct {
+	Kind string `json:"kind"`
+	// Name is the query of the assertion, such as "user:1 edit repository:1", or the written relationship or attribute.
+	Name       string `json:"name"`
+	Entity     string `json:"entity,omitempty"`
+	Subject    string `json:"subject,omitempty"`
+	Permission string `json:"permission,omitempty"`
+	Expected   string `json:"expected,omitempty"`
+	Actual     string `json:"actual,omitempty"`
+	Passed     bool   `json:"passed"`
+	// Error is the error that prevented the assertion from being evaluated.
+	Error string `json:"error,omitempty"`
+	// Time is the duration of the assertion in seconds.
+	Time float64 `json:"time"`
+}
+
+// Message - returns the reason of the failure of the case, or an empty string if it passed
+func (c Case) Message() string {
+	switch {
+	case c.Passed:
+		return ""
+	case c.Error != "":
+		return c.Error
+	case c.Kind == KindExpand:
+		// the trees are printed under each other
+		return fmt.Sprintf("expected:\n%s\nactual:\n%s", strings.TrimRight(c.Expected, "\n"), strings.TrimRight(c.Actual, "\n"))
+	default:
+		return fmt.Sprintf("expected: %s actual: %s", c.Expected, c.Actual)
+	}
+}
+
+// Cases - returns the setup cases followed by the cases of the scenarios
+func (s Suite) Cases() []Case {
+	cases := append([]Case{}, s.Setup...)
+	for _, scenario := range s.Scenarios {
+		cases = append(cases, scenario.Cases...)
+	}
+	return cases
+}
+
+// Failed - returns true if the file could not be validated or any of its cases failed
+func (s Suite) Failed() bool {
+	if s.Error != "" {
+		return true
+	}
+	for _, c := range s.Cases() {
+		if !c.Passed {
+			return true
+		}
+	}
+	return false
+}
+
+// Failed - returns true if any of the files failed
+func (r Report) Failed() bool {
+	for _, s := range r.Suites {
+		if s.Failed() {
+			return true
+		}
+	}
+	return false
+}
+
+// JSON - writes the report as indented JSON
+func (r Report) JSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+type (
+	junitTestSuites struct {
+		XMLName  xml.Name         `xml:"testsuites"`
+		Tests    int              `xml:"tests,attr"`
+		Failures int              `xml:"failures,attr"`
+		Errors   int              `xml:"errors,attr"`
+		Time     string           `xml:"time,attr"`
+		Suites   []junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name     string          `xml:"name,attr"`
+		Tests    int             `xml:"tests,attr"`
+		Failures int             `xml:"failures,attr"`
+		Errors   int             `xml:"errors,attr"`
+		Time     string          `xml:"time,attr"`
+		Cases    []junitTestCase `xml:"testcase"`
+	}
+
+	junitTestCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Time      string        `xml:"time,attr"`
+		Failure   *junitMessage `xml:"failure,omitempty"`
+		Error     *junitMessage `xml:"error,omitempty"`
+	}
+
+	junitMessage struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr"`
+		Text    string `xml:",chardata"`
+	}
+)
+
+// JUnit - writes the report in the JUnit XML format. Every file is a test suite, and every case is a test case of
+// the class named after the file and the scenario. A file that could not be validated is a test suite with a single
+// test case with an error.
+func (r Report) JUnit(w io.Writer) error {
+	root := junitTestSuites{}
+	var total float64
+
+	for _, s := range r.Suites {
+		suite := junitTestSuite{Name: s.Name, Time: seconds(s.Time)}
+		total += s.Time
+
+		if s.Error != "" {
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      "validate",
+				ClassName: s.Name,
+				Time:      seconds(s.Time),
+				Error:     &junitMessage{Message: s.Error, Type: "error", Text: s.Error},
+			})
+			suite.Errors++
+		}
+
+		add := func(className string, c Case) {
+			tc := junitTestCase{
+				Name:      fmt.Sprintf("%s: %s", c.Kind, c.Name),
+				ClassName: className,
+				Time:      seconds(c.Time),
+			}
+			switch {
+			case c.Passed:
+			case c.Error != "":
+				tc.Error = &junitMessage{Message: c.Message(), Type: "error", Text: details(c)}
+				suite.Errors++
+			default:
+				tc.Failure = &junitMessage{Message: c.Message(), Type: "assertion", Text: details(c)}
+				suite.Failures++
+			}
+			suite.Cases = append(suite.Cases, tc)
+		}
+
+		for _, c := range s.Setup {
+			add(s.Name, c)
+		}
+		for _, scenario := range s.Scenarios {
+			for _, c := range scenario.Cases {
+				add(s.Name+"."+scenario.Name, c)
+			}
+		}
+
+		suite.Tests = len(suite.Cases)
+		root.Tests += suite.Tests
+		root.Failures += suite.Failures
+		root.Errors += suite.Errors
+		root.Suites = append(root.Suites, suite)
+	}
+	root.Time = seconds(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(root); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// details - returns the fields of the failed case, one per line
+func details(c Case) string {
+	var text string
+	for _, field := range [][2]string{
+		{"entity", c.Entity},
+		{"subject", c.Subject},
+		{"permission", c.Permission},
+		{"expected", c.Expected},
+		{"actual", c.Actual},
+		{"error", c.Error},
+	} {
+		if field[1] != "" {
+			text += fmt.Sprintf("%s: %s\n", field[0], field[1])
+		}
+	}
+	return text
+}
+
+// seconds - formats the duration in seconds
+func seconds(s float64) string {
+	return fmt.Sprintf("%.3f", s)
+}
@@ -0,0 +1,143 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/pkg/development/report"
+)
+
+// junitTestSuites and the types below decode the parts of the JUnit report the tests check
+type (
+	junitTestSuites struct {
+		Tests    int              `xml:"tests,attr"`
+		Failures int              `xml:"failures,attr"`
+		Errors   int              `xml:"errors,attr"`
+		Suites   []junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name  string          `xml:"name,attr"`
+		Cases []junitTestCase `xml:"testcase"`
+	}
+
+	junitTestCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Failure   *junitMessage `xml:"failure"`
+		Error     *junitMessage `xml:"error"`
+	}
+
+	junitMessage struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr"`
+		Text    string `xml:",chardata"`
+	}
+)
+
+// TestReport -
+func TestReport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "report-suite")
+}
+
+var _ = Describe("report", func() {
+	r := report.Report{
+		Suites: []report.Suite{
+			{
+				Name: "shapes/github.yaml",
+				Setup: []report.Case{
+					{Kind: report.KindSchema, Name: "schema", Passed: true},
+					{Kind: report.KindRelationship, Name: "repository:1#owner@user:1", Passed: true},
+				},
+				Scenarios: []report.Scenario{
+					{
+						Name: "owners",
+						Cases: []report.Case{
+							{Kind: report.KindCheck, Name: "user:1 edit repository:1", Entity: "repository:1", Subject: "user:1", Permission: "edit", Expected: "ALLOWED", Actual: "ALLOWED", Passed: true},
+							{Kind: report.KindCheck, Name: "user:2 edit repository:1", Entity: "repository:1", Subject: "user:2", Permission: "edit", Expected: "ALLOWED", Actual: "DENIED"},
+							{Kind: report.KindEntityFilter, Name: "user:1 edit repository", Subject: "user:1", Permission: "edit", Error: "entity definition not found"},
+						},
+					},
+				},
+			},
+			{
+				Name:  "shapes/broken.yaml",
+				Error: "undefined relation reference",
+			},
+		},
+	}
+
+	Context("Failed", func() {
+		It("Case 1: failed assertions and files", func() {
+			Expect(r.Failed()).Should(BeTrue())
+			Expect(r.Suites[0].Failed()).Should(BeTrue())
+			Expect(r.Suites[1].Failed()).Should(BeTrue())
+
+			passed := report.Suite{Name: "shapes/passed.yaml", Setup: []report.Case{{Kind: report.KindSchema, Name: "schema", Passed: true}}}
+			Expect(passed.Failed()).Should(BeFalse())
+			Expect(report.Report{Suites: []report.Suite{passed}}.Failed()).Should(BeFalse())
+		})
+
+		It("Case 2: messages", func() {
+			cases := r.Suites[0].Cases()
+			Expect(cases).Should(HaveLen(5))
+			Expect(cases[0].Message()).Should(Equal(""))
+			Expect(cases[3].Message()).Should(Equal("expected: ALLOWED actual: DENIED"))
+			Expect(cases[4].Message()).Should(Equal("entity definition not found"))
+		})
+	})
+
+	Context("JUnit", func() {
+		It("Case 1: suites, failures and errors", func() {
+			var buf bytes.Buffer
+			Expect(r.JUnit(&buf)).ShouldNot(HaveOccurred())
+
+			var root junitTestSuites
+			Expect(xml.Unmarshal(buf.Bytes(), &root)).ShouldNot(HaveOccurred())
+
+			Expect(root.Tests).Should(Equal(6))
+			Expect(root.Failures).Should(Equal(1))
+			Expect(root.Errors).Should(Equal(2))
+			Expect(root.Suites).Should(HaveLen(2))
+
+			suite := root.Suites[0]
+			Expect(suite.Name).Should(Equal("shapes/github.yaml"))
+			Expect(suite.Cases[0].Name).Should(Equal("schema: schema"))
+			Expect(suite.Cases[0].ClassName).Should(Equal("shapes/github.yaml"))
+
+			failed := suite.Cases[3]
+			Expect(failed.Name).Should(Equal("check: user:2 edit repository:1"))
+			Expect(failed.ClassName).Should(Equal("shapes/github.yaml.owners"))
+			Expect(failed.Failure).ShouldNot(BeNil())
+			Expect(failed.Failure.Type).Should(Equal("assertion"))
+			Expect(failed.Failure.Text).Should(Equal("entity: repository:1\nsubject: user:2\npermission: edit\nexpected: ALLOWED\nactual: DENIED\n"))
+
+			Expect(suite.Cases[4].Error).ShouldNot(BeNil())
+			Expect(suite.Cases[4].Error.Message).Should(Equal("entity definition not found"))
+
+			broken := root.Suites[1]
+			Expect(broken.Cases).Should(HaveLen(1))
+			Expect(broken.Cases[0].Name).Should(Equal("validate"))
+			Expect(broken.Cases[0].Error.Message).Should(Equal("undefined relation reference"))
+		})
+	})
+
+	Context("JSON", func() {
+		It("Case 1: round trip", func() {
+			var buf bytes.Buffer
+			Expect(r.JSON(&buf)).ShouldNot(HaveOccurred())
+
+			var decoded report.Report
+			Expect(json.Unmarshal(buf.Bytes(), &decoded)).ShouldNot(HaveOccurred())
+			Expect(decoded.Suites).Should(HaveLen(2))
+			Expect(decoded.Suites[0].Scenarios[0].Cases[1]).Should(Equal(r.Suites[0].Scenarios[0].Cases[1]))
+			Expect(decoded.Suites[1].Error).Should(Equal("undefined relation reference"))
+		})
+	})
+})
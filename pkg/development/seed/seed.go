@@ -0,0 +1,183 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/development/file"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// MaxBatchSize - the maximum number of relationships and of attributes of a write request
+const MaxBatchSize = 100
+
+// Client - The calls of the tenancy, schema and data services a shape is seeded with
+type Client interface {
+	ReadTenant(ctx context.Context, request *v1.TenantReadRequest) (*v1.TenantReadResponse, error)
+	CreateTenant(ctx context.Context, request *v1.TenantCreateRequest) (*v1.TenantCreateResponse, error)
+	WriteSchema(ctx context.Context, request *v1.SchemaWriteRequest) (*v1.SchemaWriteResponse, error)
+	WriteData(ctx context.Context, request *v1.DataWriteRequest) (*v1.DataWriteResponse, error)
+}
+
+// grpcClient - Calls the services of a running server
+type grpcClient struct {
+	tenancy v1.TenancyClient
+	schema  v1.SchemaClient
+	data    v1.DataClient
+}
+
+// NewGRPCClient - Creates a new client calling the services of the server on the connection
+func NewGRPCClient(conn grpc.ClientConnInterface) Client {
+	return grpcClient{
+		tenancy: v1.NewTenancyClient(conn),
+		schema:  v1.NewSchemaClient(conn),
+		data:    v1.NewDataClient(conn),
+	}
+}
+
+// ReadTenant - Reads the tenant
+func (c grpcClient) ReadTenant(ctx context.Context, request *v1.TenantReadRequest) (*v1.TenantReadResponse, error) {
+	return c.tenancy.Read(ctx, request)
+}
+
+// CreateTenant - Creates the tenant
+func (c grpcClient) CreateTenant(ctx context.Context, request *v1.TenantCreateRequest) (*v1.TenantCreateResponse, error) {
+	return c.tenancy.Create(ctx, request)
+}
+
+// WriteSchema - Writes the schema
+func (c grpcClient) WriteSchema(ctx context.Context, request *v1.SchemaWriteRequest) (*v1.SchemaWriteResponse, error) {
+	return c.schema.Write(ctx, request)
+}
+
+// WriteData - Writes the relationships and the attributes
+func (c grpcClient) WriteData(ctx context.Context, request *v1.DataWriteRequest) (*v1.DataWriteResponse, error) {
+	return c.data.Write(ctx, request)
+}
+
+// serverClient - Calls the servers of the services in the process
+type serverClient struct {
+	tenancy v1.TenancyServer
+	schema  v1.SchemaServer
+	data    v1.DataServer
+}
+
+// NewServerClient - Creates a new client calling the servers in the process, such as the servers of the container
+// of permify serve before it starts listening
+func NewServerClient(tenancy v1.TenancyServer, schema v1.SchemaServer, data v1.DataServer) Client {
+	return serverClient{
+		tenancy: tenancy,
+		schema:  schema,
+		data:    data,
+	}
+}
+
+// ReadTenant - Reads the tenant
+func (c serverClient) ReadTenant(ctx context.Context, request *v1.TenantReadRequest) (*v1.TenantReadResponse, error) {
+	return c.tenancy.Read(ctx, request)
+}
+
+// CreateTenant - Creates the tenant
+func (c serverClient) CreateTenant(ctx context.Context, request *v1.TenantCreateRequest) (*v1.TenantCreateResponse, error) {
+	return c.tenancy.Create(ctx, request)
+}
+
+// WriteSchema - Writes the schema
+func (c serverClient) WriteSchema(ctx context.Context, request *v1.SchemaWriteRequest) (*v1.SchemaWriteResponse, error) {
+	return c.schema.Write(ctx, request)
+}
+
+// WriteData - Writes the relationships and the attributes
+func (c serverClient) WriteData(ctx context.Context, request *v1.DataWriteRequest) (*v1.DataWriteResponse, error) {
+	return c.data.Write(ctx, request)
+}
+
+// Result - The result of seeding a tenant
+type Result struct {
+	TenantCreated bool
+	SchemaVersion string
+	// SnapToken is the snap token of the last write, it is empty if the shape has no relationships and attributes
+	SnapToken     string
+	Relationships int
+	Attributes    int
+}
+
+// Seed - Writes the schema of the shape to the tenant, creating the tenant if it does not exist, then writes the
+// relationships and the attributes of the shape in batches of the given size against the written schema. The schema
+// of the shape is written as it is, the imports of a schema file have to be resolved before. The relationships and
+// the attributes are parsed before anything is written, the batches written before a failing batch are kept.
+func Seed(ctx context.Context, client Client, tenantID string, shape *file.Shape, batchSize int) (Result, error) {
+	if batchSize < 1 || batchSize > MaxBatchSize {
+		return Result{}, fmt.Errorf("the batch size must be between 1 and %d", MaxBatchSize)
+	}
+
+	tuples := make([]*v1.Tuple, 0, len(shape.Relationships))
+	for _, r := range shape.Relationships {
+		t, err := tuple.Tuple(r)
+		if err != nil {
+			return Result{}, fmt.Errorf("%s: %w", r, err)
+		}
+		tuples = append(tuples, t)
+	}
+
+	attributes := make([]*v1.Attribute, 0, len(shape.Attributes))
+	for _, a := range shape.Attributes {
+		attr, err := attribute.Attribute(a)
+		if err != nil {
+			return Result{}, fmt.Errorf("%s: %w", a, err)
+		}
+		attributes = append(attributes, attr)
+	}
+
+	var result Result
+
+	_, err := client.ReadTenant(ctx, &v1.TenantReadRequest{Id: tenantID})
+	if status.Code(err) == codes.NotFound {
+		if _, err = client.CreateTenant(ctx, &v1.TenantCreateRequest{Id: tenantID, Name: tenantID}); err != nil {
+			return Result{}, fmt.Errorf("failed to create tenant %s: %w", tenantID, err)
+		}
+		result.TenantCreated = true
+	} else if err != nil {
+		return Result{}, fmt.Errorf("failed to read tenant %s: %w", tenantID, err)
+	}
+
+	sch, err := client.WriteSchema(ctx, &v1.SchemaWriteRequest{TenantId: tenantID, Schema: shape.Schema})
+	if err != nil {
+		return result, fmt.Errorf("failed to write schema: %w", err)
+	}
+	result.SchemaVersion = sch.GetSchemaVersion()
+
+	for start := 0; start < len(tuples) || start < len(attributes); start += batchSize {
+		request := &v1.DataWriteRequest{
+			TenantId:   tenantID,
+			Metadata:   &v1.DataWriteRequestMetadata{SchemaVersion: result.SchemaVersion},
+			Tuples:     batch(tuples, start, batchSize),
+			Attributes: batch(attributes, start, batchSize),
+		}
+
+		res, err := client.WriteData(ctx, request)
+		if err != nil {
+			return result, fmt.Errorf("failed to write the batch of relationships and attributes starting at %d: %w", start+1, err)
+		}
+
+		result.SnapToken = res.GetSnapToken()
+		result.Relationships += len(request.GetTuples())
+		result.Attributes += len(request.GetAttributes())
+	}
+
+	return result, nil
+}
+
+// batch - Returns the items of the batch starting at the index
+func batch[T any](items []T, start, size int) []T {
+	if start >= len(items) {
+		return nil
+	}
+	return items[start:min(start+size, len(items))]
+}
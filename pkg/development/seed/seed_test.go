@@ -0,0 +1,124 @@
+package seed
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/servers"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/development/file"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TestSeed -
+func TestSeed(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "seed-suite")
+}
+
+// recordingClient - records the data write requests of the client
+type recordingClient struct {
+	Client
+	writes []*v1.DataWriteRequest
+}
+
+// WriteData - Records the request and writes it
+func (c *recordingClient) WriteData(ctx context.Context, request *v1.DataWriteRequest) (*v1.DataWriteResponse, error) {
+	c.writes = append(c.writes, request)
+	return c.Client.WriteData(ctx, request)
+}
+
+var _ = Describe("seed", func() {
+	shape := &file.Shape{
+		Schema: `
+		entity user {}
+
+		entity document {
+			relation owner @user
+			attribute public boolean
+			permission view = owner or public
+		}`,
+		Relationships: []string{
+			"document:1#owner@user:1",
+			"document:2#owner@user:2",
+			"document:3#owner@user:1",
+		},
+		Attributes: []string{
+			"document:4$public|boolean:true",
+		},
+	}
+
+	// memoryClient - returns a client calling the servers of an in-memory database and its data server
+	memoryClient := func() (Client, *servers.DataServer) {
+		db, err := factories.DatabaseFactory(config.Database{Engine: database.MEMORY.String()})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		sr, sw := factories.SchemaReaderFactory(db), factories.SchemaWriterFactory(db)
+		dr, dw := factories.DataReaderFactory(db), factories.DataWriterFactory(db)
+		data := servers.NewDataServer(dr, dw, factories.BundleReaderFactory(db), sr)
+
+		return NewServerClient(
+			servers.NewTenancyServer(factories.TenantReaderFactory(db), factories.TenantWriterFactory(db), 0),
+			servers.NewSchemaServer(sw, sr),
+			data,
+		), data
+	}
+
+	Context("Seed", func() {
+		It("Case 1: the tenant is created and the data is written in batches", func() {
+			ctx := context.Background()
+			client, data := memoryClient()
+			recording := &recordingClient{Client: client}
+
+			result, err := Seed(ctx, recording, "acme", shape, 2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.TenantCreated).Should(BeTrue())
+			Expect(result.SchemaVersion).ShouldNot(BeEmpty())
+			Expect(result.SnapToken).ShouldNot(BeEmpty())
+			Expect(result.Relationships).Should(Equal(3))
+			Expect(result.Attributes).Should(Equal(1))
+
+			Expect(recording.writes).Should(HaveLen(2))
+			Expect(recording.writes[0].GetTuples()).Should(HaveLen(2))
+			Expect(recording.writes[0].GetAttributes()).Should(HaveLen(1))
+			Expect(recording.writes[1].GetTuples()).Should(HaveLen(1))
+			Expect(recording.writes[1].GetAttributes()).Should(BeEmpty())
+			for _, w := range recording.writes {
+				Expect(w.GetMetadata().GetSchemaVersion()).Should(Equal(result.SchemaVersion))
+			}
+
+			res, err := data.ReadRelationships(ctx, &v1.RelationshipReadRequest{
+				TenantId: "acme",
+				Metadata: &v1.RelationshipReadRequestMetadata{SnapToken: result.SnapToken},
+				Filter:   &v1.TupleFilter{Entity: &v1.EntityFilter{Type: "document"}},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.GetTuples()).Should(HaveLen(3))
+
+			result, err = Seed(ctx, client, "acme", shape, MaxBatchSize)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.TenantCreated).Should(BeFalse())
+		})
+
+		It("Case 2: errors", func() {
+			ctx := context.Background()
+			client, _ := memoryClient()
+
+			_, err := Seed(ctx, client, "acme", shape, MaxBatchSize+1)
+			Expect(err).Should(MatchError("the batch size must be between 1 and 100"))
+
+			_, err = Seed(ctx, client, "acme", &file.Shape{Schema: shape.Schema, Relationships: []string{"document:1#owner"}}, 10)
+			Expect(err).Should(HaveOccurred())
+
+			result, err := Seed(ctx, client, "acme", &file.Shape{Schema: shape.Schema, Relationships: []string{"document:1#viewer@user:1"}}, 10)
+			Expect(err).Should(HaveOccurred())
+			Expect(result.SchemaVersion).ShouldNot(BeEmpty())
+			Expect(result.Relationships).Should(Equal(0))
+		})
+	})
+})
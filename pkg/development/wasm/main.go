@@ -98,10 +98,36 @@ func visualize() js.Func {
 	})
 }
 
+// call returns a JavaScript function calling the method of the development container against the loaded shape.
+// It takes the request as a JSON string, which can be omitted for coverage and lint, and returns the JSON result
+// and null, or null and the JSON array of the errors, whose schema errors have their line and column.
+func call(name string) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		var request []byte
+		if len(args) > 0 && args[0].Type() == js.TypeString {
+			request = []byte(args[0].String())
+		}
+
+		result, errors := dev.Call(context.Background(), name, request)
+		if len(errors) > 0 {
+			out, err := json.Marshal(errors)
+			if err != nil {
+				return js.ValueOf([]interface{}{nil, err.Error()})
+			}
+			return js.ValueOf([]interface{}{nil, string(out)})
+		}
+
+		return js.ValueOf([]interface{}{string(result), nil})
+	})
+}
+
 func main() {
 	ch := make(chan struct{}, 0)
 	dev = development.NewContainer()
 	js.Global().Set("run", run())
 	js.Global().Set("visualize", visualize())
+	for _, name := range development.Methods() {
+		js.Global().Set(name, call(name))
+	}
 	<-ch
 }
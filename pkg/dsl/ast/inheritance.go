@@ -0,0 +1,135 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/Permify/permify/pkg/dsl/token"
+)
+
+// ResolveInheritance - adds the relations, attributes and permissions of the parent entities to the entities that
+// extend them, together with their references. A statement of an entity overrides the statement of its parent with
+// the same name, which must be of the same kind. Inherited permissions are resolved in the context of the extending
+// entity, so they use the overridden relations and permissions.
+//
+// The entities are flattened in place, so that they can be serialized and compiled without their parents.
+// Resolving an already resolved schema has no effect.
+func (sch *Schema) ResolveInheritance() error {
+	entities := map[string]*EntityStatement{}
+	for _, st := range sch.Statements {
+		if es, ok := st.(*EntityStatement); ok {
+			entities[es.Name.Literal] = es
+		}
+	}
+
+	// resolved holds the entities whose parents are added, resolving holds the entities being resolved to detect cycles
+	resolved := map[string]bool{}
+	resolving := map[string]bool{}
+
+	var resolve func(es *EntityStatement) error
+	resolve = func(es *EntityStatement) error {
+		if resolved[es.Name.Literal] || es.Extends.Literal == "" {
+			return nil
+		}
+		if resolving[es.Name.Literal] {
+			return token.NewSpacedError(es.Extends.PositionInfo, fmt.Sprintf("cyclic inheritance of entity %s", es.Name.Literal))
+		}
+		resolving[es.Name.Literal] = true
+
+		parent, ok := entities[es.Extends.Literal]
+		if !ok {
+			return token.NewSpacedError(es.Extends.PositionInfo, fmt.Sprintf("entity %s extends undefined entity %s", es.Name.Literal, es.Extends.Literal))
+		}
+
+		// the parent gets the statements of its own parents first
+		if err := resolve(parent); err != nil {
+			return err
+		}
+
+		if err := sch.inherit(es, parent); err != nil {
+			return err
+		}
+
+		resolved[es.Name.Literal] = true
+		return nil
+	}
+
+	for _, st := range sch.Statements {
+		if es, ok := st.(*EntityStatement); ok {
+			if err := resolve(es); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// inherit - adds the statements of the parent that are not overridden by the entity to the entity.
+func (sch *Schema) inherit(es, parent *EntityStatement) error {
+	kinds := []struct {
+		typ        ReferenceType
+		own        *[]Statement
+		statements []Statement
+	}{
+		{typ: RELATION, own: &es.RelationStatements, statements: parent.RelationStatements},
+		{typ: ATTRIBUTE, own: &es.AttributeStatements, statements: parent.AttributeStatements},
+		{typ: PERMISSION, own: &es.PermissionStatements, statements: parent.PermissionStatements},
+	}
+
+	var inherited [3][]Statement
+	for i, kind := range kinds {
+		for _, st := range kind.statements {
+			key := fmt.Sprintf("%s#%s", es.Name.Literal, st.GetName())
+
+			// a statement of the entity with the same name overrides the inherited one
+			if typ, exist := sch.GetReferences().GetReferenceType(key); exist {
+				if typ != kind.typ {
+					return token.NewSpacedError(position(es, st.GetName()), fmt.Sprintf("%s %s cannot override %s %s of entity %s", typ, key, kind.typ, st.GetName(), parent.Name.Literal))
+				}
+				continue
+			}
+
+			var err error
+			switch s := st.(type) {
+			case *RelationStatement:
+				err = sch.GetReferences().AddRelationReferences(key, s.RelationTypes)
+			case *AttributeStatement:
+				err = sch.GetReferences().AddAttributeReferences(key, s.AttributeType)
+			case *PermissionStatement:
+				err = sch.GetReferences().AddPermissionReference(key)
+			}
+			if err != nil {
+				return err
+			}
+
+			inherited[i] = append(inherited[i], st)
+		}
+	}
+
+	// the inherited statements come before the statements of the entity
+	for i, kind := range kinds {
+		*kind.own = append(inherited[i], *kind.own...)
+	}
+
+	return nil
+}
+
+// position - returns the position of the name of the statement of the entity with the given name.
+func position(es *EntityStatement, name string) token.PositionInfo {
+	for _, statements := range [][]Statement{es.RelationStatements, es.AttributeStatements, es.PermissionStatements} {
+		for _, st := range statements {
+			if st.GetName() != name {
+				continue
+			}
+			switch s := st.(type) {
+			case *RelationStatement:
+				return s.Name.PositionInfo
+			case *AttributeStatement:
+				return s.Name.PositionInfo
+			case *PermissionStatement:
+				return s.Name.PositionInfo
+			}
+		}
+	}
+	return es.Name.PositionInfo
+}
@@ -16,6 +16,7 @@ const (
 	ATTRIBUTE   ReferenceType = "attribute"
 	ENTITY      ReferenceType = "entity"
 	RULE        ReferenceType = "rule"
+	MACRO       ReferenceType = "macro"
 )
 
 // References - Map of all relational references extracted from the schema
@@ -24,6 +25,9 @@ type References struct {
 	entityReferences map[string]struct{}
 	// Map of rule references extracted from the schema
 	ruleReferences map[string]map[string]string
+	// Map of macro references extracted from the schema
+	// -> ["macro_name"] = []{"parameter", "parameter"}
+	macroReferences map[string][]string
 
 	// Map of permission references extracted from the schema
 	// -> ["entity_name#permission_name"] = {}
@@ -45,6 +49,7 @@ func NewReferences() *References {
 	return &References{
 		entityReferences:     map[string]struct{}{},
 		ruleReferences:       map[string]map[string]string{},
+		macroReferences:      map[string][]string{},
 		permissionReferences: map[string]struct{}{},
 		attributeReferences:  map[string]AttributeTypeStatement{},
 		relationReferences:   map[string][]RelationTypeStatement{},
@@ -78,6 +83,19 @@ func (refs *References) AddRuleReference(name string, types map[string]string) e
 	return nil
 }
 
+// AddMacroReference sets a reference for a macro with its parameters.
+func (refs *References) AddMacroReference(name string, parameters []string) error {
+	if name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if refs.IsReferenceExist(name) {
+		return fmt.Errorf("reference %s already exists", name)
+	}
+	refs.macroReferences[name] = parameters
+	refs.references[name] = MACRO
+	return nil
+}
+
 // AddRelationReferences sets references for a relation with its types.
 func (refs *References) AddRelationReferences(key string, types []RelationTypeStatement) error {
 	if key == "" {
@@ -186,6 +204,38 @@ func (refs *References) RemoveAttributeReferences(key string) error {
 	return nil
 }
 
+// Merge adds all references of other into refs, e.g. the references of another schema file.
+// If a reference exists in both, it returns an error.
+func (refs *References) Merge(other *References) error {
+	for key := range other.references {
+		if refs.IsReferenceExist(key) {
+			return fmt.Errorf("reference %s already exists", key)
+		}
+	}
+	for key, typ := range other.references {
+		refs.references[key] = typ
+	}
+	for key := range other.entityReferences {
+		refs.entityReferences[key] = struct{}{}
+	}
+	for key, types := range other.ruleReferences {
+		refs.ruleReferences[key] = types
+	}
+	for key, parameters := range other.macroReferences {
+		refs.macroReferences[key] = parameters
+	}
+	for key := range other.permissionReferences {
+		refs.permissionReferences[key] = struct{}{}
+	}
+	for key, typ := range other.attributeReferences {
+		refs.attributeReferences[key] = typ
+	}
+	for key, types := range other.relationReferences {
+		refs.relationReferences[key] = types
+	}
+	return nil
+}
+
 // GetReferenceType retrieves the type of the reference for a given key.
 func (refs *References) GetReferenceType(key string) (ReferenceType, bool) {
 	if _, ok := refs.references[key]; ok {
@@ -226,6 +276,14 @@ func (refs *References) IsRuleReferenceExist(name string) bool {
 	return false
 }
 
+// IsMacroReferenceExist checks if a macro reference exists for the given name.
+func (refs *References) IsMacroReferenceExist(name string) bool {
+	if _, ok := refs.macroReferences[name]; ok {
+		return true
+	}
+	return false
+}
+
 // IsReferenceExist checks if a reference exists for the given key.
 func (refs *References) IsReferenceExist(name string) bool {
 	if _, ok := refs.references[name]; ok {
@@ -257,3 +315,11 @@ func (refs *References) GetRuleArgumentTypesIfRuleExist(name string) (map[string
 	}
 	return map[string]string{}, false
 }
+
+// GetMacroParametersIfMacroExist retrieves the parameters of the macro with the given name.
+func (refs *References) GetMacroParametersIfMacroExist(name string) ([]string, bool) {
+	if _, ok := refs.macroReferences[name]; ok {
+		return refs.macroReferences[name], true
+	}
+	return nil, false
+}
@@ -16,6 +16,9 @@ type Schema struct {
 	// The list of statements in the schema
 	Statements []Statement
 
+	// The list of import statements in the schema, they are resolved by the schema loader
+	Imports []*ImportStatement
+
 	// references - Map of all relational references extracted from the schema
 	references *References
 }
@@ -23,6 +26,7 @@ type Schema struct {
 func NewSchema() *Schema {
 	return &Schema{
 		Statements: []Statement{},
+		Imports:    []*ImportStatement{},
 		references: NewReferences(),
 	}
 }
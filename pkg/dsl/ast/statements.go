@@ -1,6 +1,7 @@
 package ast
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/Permify/permify/pkg/dsl/token"
@@ -19,6 +20,8 @@ const (
 	EXPRESSION_STATEMENT     StatementType = "expression"
 	RELATION_TYPE_STATEMENT  StatementType = "relation_type"
 	ATTRIBUTE_TYPE_STATEMENT StatementType = "attribute_type"
+	IMPORT_STATEMENT         StatementType = "import"
+	MACRO_STATEMENT          StatementType = "macro"
 )
 
 // Statement defines an interface for a statement node.
@@ -33,6 +36,7 @@ type Statement interface {
 type EntityStatement struct {
 	Entity               token.Token // token.ENTITY
 	Name                 token.Token // token.IDENT
+	Extends              token.Token // token.IDENT, the name of the parent entity, empty if the entity has no parent
 	RelationStatements   []Statement // Statements that define relationships between entities
 	AttributeStatements  []Statement // Statements that define attributes of the entity
 	PermissionStatements []Statement // Statements that define permissions performed on the entity
@@ -41,7 +45,8 @@ type EntityStatement struct {
 // statementNode is a dummy method that satisfies the Statement interface.
 func (ls *EntityStatement) statementNode() {}
 
-// String returns a string representation of the EntityStatement.
+// String returns a string representation of the EntityStatement. The parent entity is omitted, since the
+// inherited statements are part of the entity once the inheritance is resolved.
 func (ls *EntityStatement) String() string {
 	var sb strings.Builder
 	sb.WriteString("entity")
@@ -93,6 +98,7 @@ type AttributeStatement struct {
 	Attribute     token.Token // token.ATTRIBUTE
 	Name          token.Token // token.IDENT
 	AttributeType AttributeTypeStatement
+	DefaultValue  *DefaultValueStatement // The default value of the attribute, nil if there is none
 }
 
 // statementNode is a dummy method that satisfies the Statement interface.
@@ -107,6 +113,10 @@ func (as *AttributeStatement) String() string {
 	sb.WriteString(as.Name.Literal)
 	sb.WriteString(" ")
 	sb.WriteString(as.AttributeType.String())
+	if as.DefaultValue != nil {
+		sb.WriteString(" = ")
+		sb.WriteString(as.DefaultValue.String())
+	}
 
 	// Return the final string.
 	return sb.String()
@@ -183,6 +193,34 @@ func (as *AttributeTypeStatement) StatementType() StatementType {
 
 func (as *AttributeTypeStatement) statementNode() {}
 
+// DefaultValueStatement represents the default value of an attribute, which is a literal or an array of literals.
+type DefaultValueStatement struct {
+	Values  []token.Token // token.STRING, token.INTEGER, token.DOUBLE or token.BOOLEAN
+	IsArray bool
+}
+
+// String returns a string representation of the DefaultValueStatement.
+func (ds *DefaultValueStatement) String() string {
+	literals := make([]string, 0, len(ds.Values))
+	for _, value := range ds.Values {
+		if value.Type == token.STRING {
+			literals = append(literals, quote(value.Literal))
+		} else {
+			literals = append(literals, value.Literal)
+		}
+	}
+	if ds.IsArray {
+		return "[" + strings.Join(literals, ", ") + "]"
+	}
+	return strings.Join(literals, ", ")
+}
+
+// quote returns the string literal of the given value, escaping the characters the lexer unescapes.
+func quote(value string) string {
+	replacer := strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n", "\t", "\\t")
+	return "\"" + replacer.Replace(value) + "\""
+}
+
 // RelationTypeStatement represents a statement that defines the type of relationship.
 type RelationTypeStatement struct {
 	Sign     token.Token // token.SIGN
@@ -281,6 +319,8 @@ type RuleStatement struct {
 	Name       token.Token // token.IDENT
 	Arguments  map[token.Token]AttributeTypeStatement
 	Expression string
+	// ExpressionTokens are the tokens of the expression, used to point to the parts of the expression in the errors
+	ExpressionTokens []token.Token
 }
 
 // statementNode is a marker method used to implement the Statement interface.
@@ -295,7 +335,8 @@ func (rs *RuleStatement) String() string {
 	sb.WriteString("(")
 
 	var literals []string
-	for param, typ := range rs.Arguments {
+	for _, param := range rs.Parameters() {
+		typ := rs.Arguments[param]
 		var pb strings.Builder
 		pb.WriteString(param.Literal)
 		pb.WriteString(" ")
@@ -325,6 +366,91 @@ func (rs *RuleStatement) GetName() string {
 	return rs.Name.Literal
 }
 
+// Parameters returns the parameters of the rule in the order they are declared.
+func (rs *RuleStatement) Parameters() []token.Token {
+	params := make([]token.Token, 0, len(rs.Arguments))
+	for param := range rs.Arguments {
+		params = append(params, param)
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i].PositionInfo.LinePosition != params[j].PositionInfo.LinePosition {
+			return params[i].PositionInfo.LinePosition < params[j].PositionInfo.LinePosition
+		}
+		return params[i].PositionInfo.ColumnPosition < params[j].PositionInfo.ColumnPosition
+	})
+	return params
+}
+
 func (rs *RuleStatement) StatementType() StatementType {
 	return RULE_STATEMENT
 }
+
+// ImportStatement represents an import statement, which includes the definitions of another schema file.
+type ImportStatement struct {
+	Import token.Token // token.IMPORT
+	Path   token.Token // token.STRING
+}
+
+// statementNode is a marker method used to implement the Statement interface.
+func (is *ImportStatement) statementNode() {}
+
+// String returns a string representation of the import statement.
+func (is *ImportStatement) String() string {
+	var sb strings.Builder
+	sb.WriteString("import")
+	sb.WriteString(" ")
+	sb.WriteString("\"")
+	sb.WriteString(is.Path.Literal)
+	sb.WriteString("\"")
+	return sb.String()
+}
+
+func (is *ImportStatement) GetName() string {
+	return is.Path.Literal
+}
+
+func (is *ImportStatement) StatementType() StatementType {
+	return IMPORT_STATEMENT
+}
+
+// MacroStatement represents a macro statement, which is a named permission expression with relation parameters
+// that can be used in the permissions of any entity.
+type MacroStatement struct {
+	Macro               token.Token   // token.MACRO
+	Name                token.Token   // token.IDENT
+	Parameters          []token.Token // token.IDENT
+	ExpressionStatement Statement
+}
+
+// statementNode is a marker method used to implement the Statement interface.
+func (ms *MacroStatement) statementNode() {}
+
+// String returns a string representation of the macro statement.
+func (ms *MacroStatement) String() string {
+	var sb strings.Builder
+	sb.WriteString("macro")
+	sb.WriteString(" ")
+	sb.WriteString(ms.Name.Literal)
+	sb.WriteString("(")
+
+	literals := make([]string, 0, len(ms.Parameters))
+	for _, param := range ms.Parameters {
+		literals = append(literals, param.Literal)
+	}
+	sb.WriteString(strings.Join(literals, ", "))
+
+	sb.WriteString(")")
+	sb.WriteString(" = ")
+	if ms.ExpressionStatement != nil {
+		sb.WriteString(ms.ExpressionStatement.String())
+	}
+	return sb.String()
+}
+
+func (ms *MacroStatement) GetName() string {
+	return ms.Name.Literal
+}
+
+func (ms *MacroStatement) StatementType() StatementType {
+	return MACRO_STATEMENT
+}
@@ -1,8 +1,6 @@
 package ast
 
 import (
-	"errors"
-	"fmt"
 	"strings"
 
 	"github.com/Permify/permify/pkg/dsl/token"
@@ -48,6 +46,5 @@ func (sch *Schema) validateRelationTypeStatement(ref RelationTypeStatement) erro
 
 // validationError - returns a formatted error message.
 func validationError(info token.PositionInfo, message string) error {
-	msg := fmt.Sprintf("%v:%v: %s", info.LinePosition, info.ColumnPosition, strings.ToLower(strings.Replace(strings.Replace(message, "ERROR_CODE_", "", -1), "_", " ", -1)))
-	return errors.New(msg)
+	return token.NewSpacedError(info, strings.ToLower(strings.Replace(strings.Replace(message, "ERROR_CODE_", "", -1), "_", " ", -1)))
 }
@@ -3,9 +3,15 @@ package compiler
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	celparser "github.com/google/cel-go/parser"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/Permify/permify/pkg/dsl/ast"
 	"github.com/Permify/permify/pkg/dsl/token"
@@ -19,6 +25,18 @@ type Compiler struct {
 	schema *ast.Schema
 	// Whether to skip reference validation during compilation
 	withReferenceValidation bool
+	// The macros of the schema by their names
+	macros map[string]*ast.MacroStatement
+	// The macros that are being expanded, used to detect recursive macros
+	expanding map[string]bool
+	// The rules of the schema by their names
+	rules map[string]*ast.RuleStatement
+	// The rules whose calls are being inlined, used to detect recursive rules
+	calling map[string]bool
+	// The parsed expressions of the rules where the calls to other rules are inlined, by the names of the rules
+	inlined map[string]*celast.AST
+	// The rules called by the expressions of the rules, by the names of the rules
+	calls map[string][]string
 }
 
 // NewCompiler returns a new Compiler instance with the given schema and reference validation flag.
@@ -26,11 +44,21 @@ func NewCompiler(w bool, sch *ast.Schema) *Compiler {
 	return &Compiler{
 		withReferenceValidation: w,
 		schema:                  sch,
+		macros:                  map[string]*ast.MacroStatement{},
+		expanding:               map[string]bool{},
+		rules:                   map[string]*ast.RuleStatement{},
+		calling:                 map[string]bool{},
+		inlined:                 map[string]*celast.AST{},
+		calls:                   map[string][]string{},
 	}
 }
 
 // Compile compiles the schema into a list of entity definitions.
 // Returns a slice of EntityDefinition pointers and an error, if any.
+//
+// Entities that extend other entities are flattened, so that they can be serialized and compiled on their own. Calls to
+// macros in the permission expressions and calls to rules in the rule expressions are expanded in the compiled
+// definitions, while the statements are left untouched, see Serialize for the statements to be stored.
 func (t *Compiler) Compile() ([]*base.EntityDefinition, []*base.RuleDefinition, error) {
 	// If withoutReferenceValidation is not set to true, validate the schema for reference errors.
 	if t.withReferenceValidation {
@@ -40,6 +68,22 @@ func (t *Compiler) Compile() ([]*base.EntityDefinition, []*base.RuleDefinition,
 		}
 	}
 
+	// Add the inherited statements to the entities that extend other entities.
+	err := t.schema.ResolveInheritance()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Collect the macros and the rules first, so that they can be used regardless of the order of the statements.
+	for _, statement := range t.schema.Statements {
+		switch st := statement.(type) {
+		case *ast.MacroStatement:
+			t.macros[st.Name.Literal] = st
+		case *ast.RuleStatement:
+			t.rules[st.Name.Literal] = st
+		}
+	}
+
 	// Create an empty slice to hold the entity definitions.
 	entities := make([]*base.EntityDefinition, 0, len(t.schema.Statements))
 	rules := make([]*base.RuleDefinition, 0, len(t.schema.Statements))
@@ -79,6 +123,9 @@ func (t *Compiler) Compile() ([]*base.EntityDefinition, []*base.RuleDefinition,
 
 			// Append the RuleDefinition to the slice of rule definitions.
 			rules = append(rules, ruleDef)
+		case *ast.MacroStatement:
+			// Macros are expanded where they are used, they have no definitions of their own.
+			continue
 		default:
 			return nil, nil, errors.New("invalid statement")
 		}
@@ -87,6 +134,49 @@ func (t *Compiler) Compile() ([]*base.EntityDefinition, []*base.RuleDefinition,
 	return entities, rules, nil
 }
 
+// Serialize returns the statement in the form it is stored in, so that it can be compiled on its own. The calls to
+// macros in the permissions of an entity are replaced with the expanded expressions, and the calls to other rules in
+// the expression of a rule are replaced with the expressions of the called rules. The schema must have been compiled.
+func (t *Compiler) Serialize(st ast.Statement) (string, error) {
+	switch s := st.(type) {
+	case *ast.EntityStatement:
+		serialized := *s
+		serialized.PermissionStatements = make([]ast.Statement, 0, len(s.PermissionStatements))
+		for _, ps := range s.PermissionStatements {
+			if permission, ok := ps.(*ast.PermissionStatement); ok {
+				if es, ok := permission.ExpressionStatement.(*ast.ExpressionStatement); ok {
+					expanded := *permission
+					expanded.ExpressionStatement = &ast.ExpressionStatement{Expression: t.expandMacros(es.Expression)}
+					ps = &expanded
+				}
+			}
+			serialized.PermissionStatements = append(serialized.PermissionStatements, ps)
+		}
+		return serialized.String(), nil
+	case *ast.RuleStatement:
+		if len(t.calls[s.Name.Literal]) == 0 {
+			return s.String(), nil
+		}
+
+		inlined, ok := t.inlined[s.Name.Literal]
+		if !ok {
+			return "", fmt.Errorf("rule %s is not compiled", s.Name.Literal)
+		}
+
+		expression, err := celparser.Unparse(inlined.Expr(), inlined.SourceInfo())
+		if err != nil {
+			return "", err
+		}
+
+		serialized := *s
+		serialized.Expression = expression
+		serialized.ExpressionTokens = nil
+		return serialized.String(), nil
+	default:
+		return st.String(), nil
+	}
+}
+
 // compile - compiles an EntityStatement into an EntityDefinition
 func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinition, error) {
 	// Initialize the entity definition
@@ -141,6 +231,14 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 			Type: typ,
 		}
 
+		// Compile the default value of the attribute, if any
+		if st.DefaultValue != nil {
+			attributeDefinition.DefaultValue, err = compileDefaultValue(typ, st)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		entityDefinition.Attributes[attributeDefinition.GetName()] = attributeDefinition
 		entityDefinition.References[attributeDefinition.GetName()] = base.EntityDefinition_REFERENCE_ATTRIBUTE
 	}
@@ -154,7 +252,8 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 		}
 
 		// Compile the child expression
-		ch, err := t.compileExpressionStatement(entityDefinition.GetName(), st.ExpressionStatement.(*ast.ExpressionStatement))
+		es := st.ExpressionStatement.(*ast.ExpressionStatement)
+		ch, err := t.compileExpressionStatement(entityDefinition.GetName(), es)
 		if err != nil {
 			return nil, err
 		}
@@ -175,62 +274,49 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 // It takes an *ast.RuleStatement as input, processes its arguments, and
 // returns a *base.RuleDefinition or an error.
 func (t *Compiler) compileRule(sc *ast.RuleStatement) (*base.RuleDefinition, error) {
-	// Initialize a new base.RuleDefinition with the name and body from the rule statement.
-	// The Arguments field is initialized as an empty map.
-	ruleDefinition := &base.RuleDefinition{
-		Name:      sc.Name.Literal,
-		Arguments: map[string]base.AttributeType{},
+	// Replace the calls to other rules with their expressions, the statement itself is left untouched.
+	inlined, err := t.inlineRule(sc)
+	if err != nil {
+		return nil, err
 	}
 
-	var envOptions []cel.EnvOption
-	envOptions = append(envOptions, cel.Variable("context", cel.DynType))
-
-	// Iterate over the arguments in the rule statement.
-	for name, ty := range sc.Arguments {
-		// For each argument, use the getArgumentTypeIfExist function to determine the attribute type.
-		typ, err := getArgumentTypeIfExist(ty)
-		// If the attribute type is not recognized, return an error.
-		if err != nil {
-			return nil, err
-		}
-
-		cType, err := utils.GetCelType(typ)
-		if err != nil {
-			return nil, err
-		}
+	// Variables used within this expression environment.
+	env, arguments, err := t.ruleEnv(sc)
+	if err != nil {
+		return nil, err
+	}
 
-		// Add the argument name and its corresponding attribute type to the Arguments map in the rule definition.
-		ruleDefinition.Arguments[name.Literal] = typ
-		envOptions = append(envOptions, cel.Variable(name.Literal, cType))
+	expr, err := celast.ExprToProto(inlined.Expr())
+	if err != nil {
+		return nil, err
 	}
 
-	// Variables used within this expression environment.
-	env, err := cel.NewEnv(envOptions...)
+	info, err := celast.SourceInfoToProto(inlined.SourceInfo())
 	if err != nil {
 		return nil, err
 	}
 
-	// Compile and type-check the expression.
-	compiledExp, issues := env.Compile(sc.Expression)
+	// Type-check the expression.
+	compiledExp, issues := env.Check(cel.ParsedExprToAst(&exprpb.ParsedExpr{Expr: expr, SourceInfo: info}))
 	if issues != nil && issues.Err() != nil {
-		pi := sc.Name.PositionInfo
-		pi.LinePosition++
-		return nil, compileError(pi, issues.Err().Error())
+		return nil, ruleError(sc, issues)
 	}
 
 	if compiledExp.OutputType() != cel.BoolType {
 		return nil, compileError(sc.Name.PositionInfo, fmt.Sprintf("rule expression must result in a boolean type not %s", compiledExp.OutputType().String()))
 	}
 
-	expr, err := cel.AstToCheckedExpr(compiledExp)
+	checked, err := cel.AstToCheckedExpr(compiledExp)
 	if err != nil {
 		return nil, err
 	}
 
-	ruleDefinition.Expression = expr
-
 	// Return the completed rule definition and no error.
-	return ruleDefinition, nil
+	return &base.RuleDefinition{
+		Name:       sc.Name.Literal,
+		Arguments:  arguments,
+		Expression: checked,
+	}, nil
 }
 
 // compileExpressionStatement compiles an ExpressionStatement into a Child node that can be used to construct an PermissionDefinition.
@@ -317,6 +403,11 @@ func (t *Compiler) compileLeaf(entityName string, expression ast.Expression) (*b
 		// Type assertion to get the underlying Call.
 		call := expression.(*ast.Call)
 
+		// If the call refers to a macro, compile its expanded expression.
+		if macro, ok := t.macros[call.Name.Literal]; ok {
+			return t.compileMacroCall(entityName, call, macro)
+		}
+
 		// Compile the call and return the result.
 		return t.compileCall(entityName, call)
 
@@ -440,6 +531,9 @@ func (t *Compiler) compileCall(entityName string, call *ast.Call) (*base.Child,
 		return nil, compileError(call.Name.PositionInfo, base.ErrorCode_ERROR_CODE_MISSING_ARGUMENT.String())
 	}
 
+	// Keep the names of the rule's arguments the call's arguments are passed to.
+	bound := map[string]bool{}
+
 	// Loop through each argument in the call.
 	for _, argument := range call.Arguments {
 
@@ -452,6 +546,13 @@ func (t *Compiler) compileCall(entityName string, call *ast.Call) (*base.Child,
 			}, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
 		}
 
+		// The argument is passed to the rule's argument with the name of its attribute, so the names must be unique.
+		name := argument.Idents[len(argument.Idents)-1]
+		if bound[name.Literal] {
+			return nil, compileError(name.PositionInfo, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+		}
+		bound[name.Literal] = true
+
 		// If the argument has only one identifier, it is a computed attribute.
 		if len(argument.Idents) == 1 {
 
@@ -484,9 +585,36 @@ func (t *Compiler) compileCall(entityName string, call *ast.Call) (*base.Child,
 			continue
 		}
 
+		// If the argument has two identifiers, it is an attribute of the entities related by the relation.
+		if len(argument.Idents) == 2 {
+
+			// If reference validation is enabled, check if the attribute exists on every related entity with the type of the rule's argument.
+			if t.withReferenceValidation {
+				err := t.validateTupleToAttributeReference(entityName, argument, types)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			// Append the tuple to computed attribute to the arguments slice.
+			arguments = append(arguments, &base.Argument{
+				Type: &base.Argument_TupleToComputedAttribute{
+					TupleToComputedAttribute: &base.TupleToComputedAttribute{
+						TupleSet: &base.TupleSet{
+							Relation: argument.Idents[0].Literal,
+						},
+						Computed: &base.ComputedAttribute{
+							Name: argument.Idents[1].Literal,
+						},
+					},
+				},
+			})
+			continue
+		}
+
 		// If the argument has more than two identifiers, it indicates an unsupported relation walk.
 		// Return an error in this case.
-		return nil, compileError(argument.Idents[1].PositionInfo, base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_WALK.String())
+		return nil, compileError(argument.Idents[2].PositionInfo, base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_WALK.String())
 	}
 
 	// Set the child's type to be a leaf with the compiled call information.
@@ -501,6 +629,102 @@ func (t *Compiler) compileCall(entityName string, call *ast.Call) (*base.Child,
 	return child, nil
 }
 
+// compileMacroCall compiles a call to a macro by compiling the expression of the macro, where the parameters are
+// replaced with the arguments of the call, in the context of the calling entity. Errors in the expanded expression
+// point to both the call and the definition of the macro.
+func (t *Compiler) compileMacroCall(entityName string, call *ast.Call, macro *ast.MacroStatement) (*base.Child, error) {
+	// A macro cannot use itself, directly or through other macros.
+	if t.expanding[macro.Name.Literal] {
+		return nil, token.NewSpacedError(call.Name.PositionInfo, fmt.Sprintf("recursive use of macro %s", macro.Name.Literal))
+	}
+
+	// Every parameter of the macro must be given an argument.
+	if len(call.Arguments) != len(macro.Parameters) {
+		return nil, token.NewSpacedError(call.Name.PositionInfo, fmt.Sprintf("macro %s expects %d arguments, got %d", macro.Name.Literal, len(macro.Parameters), len(call.Arguments)))
+	}
+
+	t.expanding[macro.Name.Literal] = true
+	defer delete(t.expanding, macro.Name.Literal)
+
+	child, err := t.compileChildren(entityName, expandMacro(call, macro))
+	if err != nil {
+		return nil, token.NewSpacedError(call.Name.PositionInfo, fmt.Sprintf("in macro %s defined at %s: %v", macro.Name.Literal, macro.Name.PositionInfo.String(), err))
+	}
+
+	return child, nil
+}
+
+// expandMacros returns the given expression where the calls to macros are replaced with their expanded expressions.
+// The expression must have been compiled, so that the macros are known to be used correctly.
+func (t *Compiler) expandMacros(expression ast.Expression) ast.Expression {
+	switch e := expression.(type) {
+	case *ast.InfixExpression:
+		return &ast.InfixExpression{
+			Op:       e.Op,
+			Left:     t.expandMacros(e.Left),
+			Operator: e.Operator,
+			Right:    t.expandMacros(e.Right),
+		}
+	case *ast.Call:
+		if macro, ok := t.macros[e.Name.Literal]; ok {
+			return t.expandMacros(expandMacro(e, macro))
+		}
+	}
+	return expression
+}
+
+// expandMacro returns a copy of the expression of the macro, where the parameters are replaced with the arguments
+// of the call. A parameter can be the first part of an identifier, e.g. "parent.view" with the argument "folder"
+// becomes "folder.view".
+func expandMacro(call *ast.Call, macro *ast.MacroStatement) ast.Expression {
+	arguments := map[string]ast.Identifier{}
+	for i, parameter := range macro.Parameters {
+		if i < len(call.Arguments) {
+			arguments[parameter.Literal] = call.Arguments[i]
+		}
+	}
+	return substitute(macro.ExpressionStatement.(*ast.ExpressionStatement).Expression, arguments)
+}
+
+// substitute returns a copy of the expression, where the identifiers starting with the given parameters are
+// replaced with the arguments.
+func substitute(expression ast.Expression, arguments map[string]ast.Identifier) ast.Expression {
+	switch e := expression.(type) {
+	case *ast.InfixExpression:
+		return &ast.InfixExpression{
+			Op:       e.Op,
+			Left:     substitute(e.Left, arguments),
+			Operator: e.Operator,
+			Right:    substitute(e.Right, arguments),
+		}
+	case *ast.Identifier:
+		ident := substituteIdentifier(*e, arguments)
+		return &ident
+	case *ast.Call:
+		call := &ast.Call{Name: e.Name, Arguments: make([]ast.Identifier, 0, len(e.Arguments))}
+		for _, argument := range e.Arguments {
+			call.Arguments = append(call.Arguments, substituteIdentifier(argument, arguments))
+		}
+		return call
+	default:
+		return expression
+	}
+}
+
+// substituteIdentifier returns a copy of the identifier, where its first part is replaced with the argument if it
+// is a parameter.
+func substituteIdentifier(ident ast.Identifier, arguments map[string]ast.Identifier) ast.Identifier {
+	if len(ident.Idents) == 0 {
+		return ident
+	}
+	argument, ok := arguments[ident.Idents[0].Literal]
+	if !ok {
+		return ast.Identifier{Idents: append([]token.Token{}, ident.Idents...)}
+	}
+	idents := append([]token.Token{}, argument.Idents...)
+	return ast.Identifier{Idents: append(idents, ident.Idents[1:]...)}
+}
+
 // compileComputedUserSetIdentifier takes a string that represents a user set relation
 // and compiles it into a base.Leaf object containing that relation. It returns the resulting Leaf and no error.
 func (t *Compiler) compileComputedUserSetIdentifier(r string) (l *base.Leaf, err error) {
@@ -603,10 +827,111 @@ func (t *Compiler) validateTupleToUserSetReference(entityName string, identifier
 	return nil
 }
 
+// validateTupleToAttributeReference checks if the attribute of the argument exists on every entity type of the relation
+// of the argument, with the type of the rule's argument of the same name.
+func (t *Compiler) validateTupleToAttributeReference(entityName string, argument ast.Identifier, types map[string]string) error {
+	relationTypes, exist := t.schema.GetReferences().GetRelationReferenceTypesIfExist(utils.Key(entityName, argument.Idents[0].Literal))
+	if !exist {
+		return compileError(argument.Idents[0].PositionInfo, base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+	}
+
+	typeInfo, exist := types[argument.Idents[1].Literal]
+	if !exist {
+		return compileError(argument.Idents[1].PositionInfo, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+
+	for _, relationType := range relationTypes {
+		atyp, exist := t.schema.GetReferences().GetAttributeReferenceTypeIfExist(utils.Key(relationType.Type.Literal, argument.Idents[1].Literal))
+		if !exist {
+			return compileError(argument.Idents[1].PositionInfo, base.ErrorCode_ERROR_CODE_ATTRIBUTE_DEFINITION_NOT_FOUND.String())
+		}
+
+		if typeInfo != atyp.String() {
+			return compileError(argument.Idents[1].PositionInfo, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+		}
+	}
+
+	return nil
+}
+
 // compileError creates an error with the given message and position information.
 func compileError(info token.PositionInfo, message string) error {
-	msg := fmt.Sprintf("%v:%v: %s", info.LinePosition, info.ColumnPosition, strings.ToLower(strings.Replace(strings.Replace(message, "ERROR_CODE_", "", -1), "_", " ", -1)))
-	return errors.New(msg)
+	return token.NewSpacedError(info, strings.ToLower(strings.Replace(strings.Replace(message, "ERROR_CODE_", "", -1), "_", " ", -1)))
+}
+
+// compileDefaultValue compiles the default value of an attribute into an Any message holding the value message of
+// the attribute type, such as base.BooleanValue or base.StringArrayValue. The values must match the attribute type,
+// integers are accepted for doubles.
+func compileDefaultValue(typ base.AttributeType, st *ast.AttributeStatement) (*anypb.Any, error) {
+	invalid := func(pi token.PositionInfo) error {
+		return token.NewSpacedError(pi, fmt.Sprintf("default value of attribute %s must be of type %s", st.Name.Literal, st.AttributeType.String()))
+	}
+
+	if st.DefaultValue.IsArray != st.AttributeType.IsArray {
+		pi := st.Name.PositionInfo
+		if len(st.DefaultValue.Values) > 0 {
+			pi = st.DefaultValue.Values[0].PositionInfo
+		}
+		return nil, invalid(pi)
+	}
+
+	var (
+		strs     []string
+		integers []int32
+		doubles  []float64
+		booleans []bool
+	)
+
+	for _, value := range st.DefaultValue.Values {
+		switch typ {
+		case base.AttributeType_ATTRIBUTE_TYPE_STRING, base.AttributeType_ATTRIBUTE_TYPE_STRING_ARRAY:
+			if value.Type != token.STRING {
+				return nil, invalid(value.PositionInfo)
+			}
+			strs = append(strs, value.Literal)
+		case base.AttributeType_ATTRIBUTE_TYPE_INTEGER, base.AttributeType_ATTRIBUTE_TYPE_INTEGER_ARRAY:
+			i, err := strconv.ParseInt(value.Literal, 10, 32)
+			if value.Type != token.INTEGER || err != nil {
+				return nil, invalid(value.PositionInfo)
+			}
+			integers = append(integers, int32(i))
+		case base.AttributeType_ATTRIBUTE_TYPE_DOUBLE, base.AttributeType_ATTRIBUTE_TYPE_DOUBLE_ARRAY:
+			d, err := strconv.ParseFloat(value.Literal, 64)
+			if (value.Type != token.DOUBLE && value.Type != token.INTEGER) || err != nil {
+				return nil, invalid(value.PositionInfo)
+			}
+			doubles = append(doubles, d)
+		case base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN, base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN_ARRAY:
+			if value.Type != token.BOOLEAN {
+				return nil, invalid(value.PositionInfo)
+			}
+			booleans = append(booleans, value.Literal == "true")
+		}
+	}
+
+	var msg proto.Message
+	switch typ {
+	case base.AttributeType_ATTRIBUTE_TYPE_STRING:
+		msg = &base.StringValue{Data: strs[0]}
+	case base.AttributeType_ATTRIBUTE_TYPE_STRING_ARRAY:
+		msg = &base.StringArrayValue{Data: strs}
+	case base.AttributeType_ATTRIBUTE_TYPE_INTEGER:
+		msg = &base.IntegerValue{Data: integers[0]}
+	case base.AttributeType_ATTRIBUTE_TYPE_INTEGER_ARRAY:
+		msg = &base.IntegerArrayValue{Data: integers}
+	case base.AttributeType_ATTRIBUTE_TYPE_DOUBLE:
+		msg = &base.DoubleValue{Data: doubles[0]}
+	case base.AttributeType_ATTRIBUTE_TYPE_DOUBLE_ARRAY:
+		msg = &base.DoubleArrayValue{Data: doubles}
+	case base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN:
+		msg = &base.BooleanValue{Data: booleans[0]}
+	case base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN_ARRAY:
+		msg = &base.BooleanArrayValue{Data: booleans}
+	default:
+		return nil, invalid(st.Name.PositionInfo)
+	}
+
+	return anypb.New(msg)
 }
 
 // getArgumentTypeIfExist takes a token and checks its literal value against
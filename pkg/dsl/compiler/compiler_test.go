@@ -1,7 +1,7 @@
 package compiler
 
 import (
-	"errors"
+	"strings"
 	"testing"
 
 	"github.com/google/cel-go/cel"
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/Permify/permify/pkg/dsl/ast"
 	"github.com/Permify/permify/pkg/dsl/parser"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -359,7 +360,7 @@ var _ = Describe("compiler", func() {
 			c := NewCompiler(true, sch)
 
 			_, _, err = c.Compile()
-			Expect(err).Should(Equal(errors.New("9:26: undefined relation reference")))
+			Expect(err).Should(MatchError("9:26: undefined relation reference"))
 		})
 
 		It("Case 6", func() {
@@ -389,7 +390,7 @@ var _ = Describe("compiler", func() {
 			c := NewCompiler(true, sch)
 
 			_, _, err = c.Compile()
-			Expect(err).Should(Equal(errors.New("18:40: not supported relation walk")))
+			Expect(err).Should(MatchError("18:40: not supported relation walk"))
 		})
 
 		It("Case 7", func() {
@@ -2116,5 +2117,709 @@ var _ = Describe("compiler", func() {
 
 			Expect(err.Error()).Should(Equal("15:29: schema compile"))
 		})
+
+		It("Case 23", func() {
+			sch, err := parser.NewParser(`
+			macro viewable(owner, org, parent) = owner or org.admin or (parent.view not banned)
+
+			entity user {}
+
+			entity organization {
+				relation admin @user
+			}
+
+			entity folder {
+				relation owner @user
+				relation org @organization
+				relation banned @user
+
+				permission view = viewable(owner, org, parent)
+			}
+
+			entity document {
+				relation writer @user
+				relation organization @organization
+				relation folder @folder
+				relation banned @user
+
+				permission view = viewable(writer, organization, folder) or organization.admin
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("15:24: in macro viewable defined at 2:11: 15:45: undefined relation reference"))
+		})
+
+		It("Case 24", func() {
+			sch, err := parser.NewParser(`
+			macro viewable(owner, org, parent) = owner or org.admin or (parent.view not banned)
+
+			entity user {}
+
+			entity organization {
+				relation admin @user
+			}
+
+			entity folder {
+				relation owner @user
+				relation org @organization
+				relation banned @user
+
+				permission view = owner or org.admin
+			}
+
+			entity document {
+				relation writer @user
+				relation organization @organization
+				relation folder @folder
+				relation banned @user
+
+				permission view = viewable(writer, organization, folder) or organization.admin
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+			is, _, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			expected, err := parser.NewParser(`
+			entity user {}
+
+			entity organization {
+				relation admin @user
+			}
+
+			entity folder {
+				relation owner @user
+				relation org @organization
+				relation banned @user
+
+				permission view = owner or org.admin
+			}
+
+			entity document {
+				relation writer @user
+				relation organization @organization
+				relation folder @folder
+				relation banned @user
+
+				permission view = (writer or organization.admin or (folder.view not banned)) or organization.admin
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			es, _, err := NewCompiler(true, expected).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(is).Should(Equal(es))
+
+			// the statement is left untouched
+			Expect(sch.Statements[4].(*ast.EntityStatement).PermissionStatements[0].String()).Should(Equal("\tpermission view = (viewable(writer, organization, folder) or organization.admin)"))
+
+			// the calls to the macros are replaced, so that the entity can be serialized without the macro
+			serialized, err := c.Serialize(sch.Statements[4])
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(serialized).Should(ContainSubstring("\tpermission view = (((writer or organization.admin) or (folder.view not banned)) or organization.admin)"))
+		})
+
+		It("Case 25", func() {
+			sch, err := parser.NewParser(`
+			macro owned(owner) = owner or admin
+			macro editable(owner, reviewer) = owned(owner) or reviewer
+
+			entity user {}
+
+			entity document {
+				relation writer @user
+				relation reviewer @user
+
+				permission edit = editable(writer)
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("11:24: macro editable expects 2 arguments, got 1"))
+
+			sch, err = parser.NewParser(`
+			macro owned(owner) = owner or admin
+			macro editable(owner, reviewer) = owned(owner) or reviewer
+
+			entity user {}
+
+			entity document {
+				relation writer @user
+				relation reviewer @user
+
+				permission edit = editable(writer, reviewer)
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("11:24: in macro editable defined at 3:11: 3:39: in macro owned defined at 2:11: 2:35: undefined relation reference"))
+		})
+
+		It("Case 26", func() {
+			sch, err := parser.NewParser(`
+			macro a(x) = x or b(x)
+			macro b(x) = a(x)
+
+			entity user {}
+
+			entity document {
+				relation owner @user
+
+				permission view = a(owner)
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("10:24: in macro a defined at 2:11: 2:23: in macro b defined at 3:11: 3:18: recursive use of macro a"))
+		})
+		It("Case 27", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity resource {
+				relation owner @user
+				relation viewer @user
+				attribute public boolean
+
+				permission edit = owner
+				permission view = edit or viewer or public
+			}
+
+			entity sheet extends resource {
+				relation editor @user
+
+				permission edit = owner or editor
+			}
+
+			entity chart extends sheet {}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			is, _, err := NewCompiler(true, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			expected, err := parser.NewParser(`
+			entity user {}
+
+			entity resource {
+				relation owner @user
+				relation viewer @user
+				attribute public boolean
+
+				permission edit = owner
+				permission view = edit or viewer or public
+			}
+
+			entity sheet {
+				relation owner @user
+				relation viewer @user
+				relation editor @user
+				attribute public boolean
+
+				permission view = edit or viewer or public
+				permission edit = owner or editor
+			}
+
+			entity chart {
+				relation owner @user
+				relation viewer @user
+				relation editor @user
+				attribute public boolean
+
+				permission view = edit or viewer or public
+				permission edit = owner or editor
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			es, _, err := NewCompiler(true, expected).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(is).Should(Equal(es))
+
+			// the flattened entities can be compiled without their parents
+			_, _, err = NewCompiler(false, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			chart, err := parser.NewParser(sch.Statements[3].String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cs, _, err := NewCompiler(false, chart).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cs).Should(Equal(es[3:]))
+		})
+
+		It("Case 28", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity sheet extends resource {
+				relation editor @user
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("4:26: entity sheet extends undefined entity resource"))
+
+			sch, err = parser.NewParser(`
+			entity user {}
+
+			entity resource extends sheet {}
+
+			entity sheet extends resource {}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("4:29: cyclic inheritance of entity resource"))
+
+			sch, err = parser.NewParser(`
+			entity user {}
+
+			entity resource {
+				relation owner @user
+			}
+
+			entity sheet extends resource {
+				permission owner = editor
+				relation editor @user
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("9:17: permission sheet#owner cannot override relation owner of entity resource"))
+		})
+
+		It("Case 29", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity document {
+				relation viewer @user
+
+				attribute is_public boolean = true
+				attribute ratings double[] = [1, 2.5]
+				attribute level integer
+
+				permission view = is_public or viewer
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+			is, _, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			attributes := is[1].GetAttributes()
+			Expect(attributes["level"].GetDefaultValue()).Should(BeNil())
+
+			var public base.BooleanValue
+			Expect(attributes["is_public"].GetDefaultValue().UnmarshalTo(&public)).ShouldNot(HaveOccurred())
+			Expect(public.GetData()).Should(BeTrue())
+
+			var ratings base.DoubleArrayValue
+			Expect(attributes["ratings"].GetDefaultValue().UnmarshalTo(&ratings)).ShouldNot(HaveOccurred())
+			Expect(ratings.GetData()).Should(Equal([]float64{1, 2.5}))
+
+			// the default values survive the serialization of the entity
+			document, err := parser.NewParser(sch.Statements[1].String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ds, _, err := NewCompiler(false, document).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ds[0].GetAttributes()["is_public"].GetDefaultValue().GetTypeUrl()).Should(Equal("type.googleapis.com/base.v1.BooleanValue"))
+		})
+
+		It("Case 30", func() {
+			sch, err := parser.NewParser(`
+			entity document {
+				attribute is_public boolean = "yes"
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("3:36: default value of attribute is_public must be of type boolean"))
+
+			sch, err = parser.NewParser(`
+			entity document {
+				attribute tags string[] = "draft"
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("3:32: default value of attribute tags must be of type string[]"))
+		})
+
+		It("Case 31", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity document {
+				attribute level integer
+
+				permission view = check_level(level)
+			}
+
+			rule check_level(level integer) {
+				in_range(level, 1) && is_public(string(level))
+			}
+
+			rule in_range(x integer, min integer) {
+				x >= min && x < 10
+			}
+
+			rule is_public(classification string) {
+				classification != "secret"
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+			_, rs, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rs).Should(HaveLen(3))
+			Expect(c.RuleCalls()["check_level"]).Should(Equal([]string{"in_range", "is_public"}))
+
+			// the statement is left untouched
+			st, ok := sch.Statements[2].(*ast.RuleStatement)
+			Expect(ok).Should(BeTrue())
+			Expect(strings.TrimSpace(st.Expression)).Should(Equal(`in_range(level, 1) && is_public(string(level))`))
+
+			// the calls are replaced with the expressions of the called rules in the serialized rule
+			serialized, err := c.Serialize(st)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(serialized).Should(ContainSubstring(`level >= 1 && level < 10 && string(level) != "secret"`))
+
+			// the rule can be compiled on its own
+			checkLevel, err := parser.NewParser(serialized).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, cs, err := NewCompiler(false, checkLevel).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cs[0].GetArguments()).Should(Equal(map[string]base.AttributeType{
+				"level": base.AttributeType_ATTRIBUTE_TYPE_INTEGER,
+			}))
+		})
+
+		It("Case 32", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			rule first(x integer) {
+				second(x)
+			}
+
+			rule second(x integer) {
+				x > 1 && first(x)
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("9:15: recursive call of rule first"))
+
+			sch, err = parser.NewParser(`
+			entity user {}
+
+			rule first(x integer) {
+				second(x)
+			}
+
+			rule second(x integer, y integer) {
+				x > y
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("5:6: rule second expects 2 arguments, got 1"))
+		})
+
+		It("Case 33", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity folder {
+				attribute classification string
+			}
+
+			entity document {
+				relation parent @folder
+
+				permission view = is_public(parent.classification)
+			}
+
+			rule is_public(classification string) {
+				classification == "public"
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			is, _, err := NewCompiler(true, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(is[2].GetPermissions()["view"].GetChild().GetLeaf().GetCall()).Should(Equal(&base.Call{
+				RuleName: "is_public",
+				Arguments: []*base.Argument{
+					{
+						Type: &base.Argument_TupleToComputedAttribute{
+							TupleToComputedAttribute: &base.TupleToComputedAttribute{
+								TupleSet: &base.TupleSet{
+									Relation: "parent",
+								},
+								Computed: &base.ComputedAttribute{
+									Name: "classification",
+								},
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("Case 34", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity document {
+				relation owner @user
+
+				permission view = is_public(owner.classification)
+			}
+
+			rule is_public(classification string) {
+				classification == "public"
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("7:40: attribute definition not found"))
+
+			sch, err = parser.NewParser(`
+			entity user {
+				attribute classification integer
+			}
+
+			entity document {
+				relation owner @user
+
+				permission view = is_public(owner.classification)
+			}
+
+			rule is_public(classification string) {
+				classification == "public"
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("9:40: invalid argument"))
+		})
+
+		It("Case 35", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity network {
+				attribute ranges string[]
+				attribute min_version string
+
+				permission access = in_office(ranges, min_version)
+			}
+
+			rule in_office(ranges string[], min_version string) {
+				inCidr(context.data.ip, ranges) && dayOfWeek(now()) != "sunday" && semverCompare(context.data.version, min_version) >= 0
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, rs, err := NewCompiler(true, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rs).Should(HaveLen(1))
+
+			sch, err = parser.NewParser(`
+			entity user {}
+
+			entity network {
+				attribute ranges string[]
+
+				permission access = in_office(ranges)
+			}
+
+			rule in_office(ranges string[]) {
+				inCidr(context.data.ip, ranges, true)
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("Case 36", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity document {
+				attribute scores integer[]
+				attribute min integer
+
+				permission view = any_above(scores, min)
+			}
+
+			rule any_above(scores integer[], min integer) {
+				above(scores, min)
+			}
+
+			rule above(values integer[], limit integer) {
+				values.exists(min, min > limit)
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+			_, rs, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// the variable of the comprehension is renamed, so that it does not capture the argument
+			var anyAbove *base.RuleDefinition
+			for _, r := range rs {
+				if r.GetName() == "any_above" {
+					anyAbove = r
+				}
+			}
+
+			env, err := cel.NewEnv(
+				cel.Variable("scores", cel.ListType(cel.IntType)),
+				cel.Variable("min", cel.IntType),
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			prg, err := env.Program(cel.CheckedExprToAst(anyAbove.GetExpression()))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			out, _, err := prg.Eval(map[string]interface{}{"scores": []int64{1, 2}, "min": 1})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Value()).Should(BeTrue())
+
+			out, _, err = prg.Eval(map[string]interface{}{"scores": []int64{1, 2}, "min": 2})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Value()).Should(BeFalse())
+
+			// the serialized rule keeps the comprehension and the renamed variable
+			serialized, err := c.Serialize(sch.Statements[2])
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(serialized).Should(ContainSubstring(`scores.exists(min_1, min_1 > min)`))
+
+			own, err := parser.NewParser(serialized).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(false, own).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("Case 37", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			rule first(name string) {
+				second(name) || second(context.data.level)
+			}
+
+			rule second(level integer) {
+				level > 1
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("5:6: rule second expects argument level of type integer, got string"))
+
+			sch, err = parser.NewParser(`
+			entity user {}
+
+			rule first(levels integer[]) {
+				second(levels, context.data.level)
+			}
+
+			rule second(levels integer[], level integer) {
+				level in levels
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			sch, err = parser.NewParser(`
+			entity user {}
+
+			rule first(levels string[]) {
+				levels.size() > 0 && second(levels)
+			}
+
+			rule second(levels integer[]) {
+				1 in levels
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("5:27: rule second expects argument levels of type integer[], got string[]"))
+		})
 	})
 })
@@ -0,0 +1,526 @@
+package compiler
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/types"
+	celparser "github.com/google/cel-go/parser"
+
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/functions"
+	"github.com/Permify/permify/pkg/dsl/token"
+	"github.com/Permify/permify/pkg/dsl/utils"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// RuleCalls returns the names of the rules called by the expression of each rule. The schema must have been compiled.
+func (t *Compiler) RuleCalls() map[string][]string {
+	return t.calls
+}
+
+// ruleEnv returns the CEL environment of the expression of the rule and the types of its parameters. The rules of the
+// schema are declared as functions accepting any arguments, so that their calls can be type-checked before they are
+// inlined.
+func (t *Compiler) ruleEnv(sc *ast.RuleStatement) (*cel.Env, map[string]base.AttributeType, error) {
+	envOptions := []cel.EnvOption{
+		cel.Variable("context", cel.DynType),
+		functions.Library(),
+		cel.EnableMacroCallTracking(),
+	}
+
+	arguments := map[string]base.AttributeType{}
+	for name, ty := range sc.Arguments {
+		// For each argument, use the getArgumentTypeIfExist function to determine the attribute type.
+		typ, err := getArgumentTypeIfExist(ty)
+		// If the attribute type is not recognized, return an error.
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cType, err := utils.GetCelType(typ)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		arguments[name.Literal] = typ
+		envOptions = append(envOptions, cel.Variable(name.Literal, cType))
+	}
+
+	for name, rule := range t.rules {
+		params := make([]*cel.Type, len(rule.Arguments))
+		for i := range params {
+			params[i] = cel.DynType
+		}
+		envOptions = append(envOptions, cel.Function(name, cel.Overload(name+"_rule", params, cel.BoolType)))
+	}
+
+	env, err := cel.NewEnv(envOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return env, arguments, nil
+}
+
+// inlineRule returns the parsed expression of the rule, where the calls to other rules are replaced with the
+// expressions of the called rules. The calls are checked against the parameters of the called rules, and the
+// errors point to the calls.
+func (t *Compiler) inlineRule(sc *ast.RuleStatement) (*celast.AST, error) {
+	if inlined, ok := t.inlined[sc.Name.Literal]; ok {
+		return inlined, nil
+	}
+
+	env, _, err := t.ruleEnv(sc)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, issues := env.Parse(sc.Expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, ruleError(sc, issues)
+	}
+
+	// The calls are collected in post-order, so that the calls in the arguments are inlined before the calls
+	// they are passed to.
+	var calls []celast.Expr
+	celast.PostOrderVisit(parsed.NativeRep().Expr(), celast.NewExprVisitor(func(e celast.Expr) {
+		if e.Kind() == celast.CallKind && !e.AsCall().IsMemberFunction() {
+			if _, ok := t.rules[e.AsCall().FunctionName()]; ok {
+				calls = append(calls, e)
+			}
+		}
+	}))
+
+	t.calling[sc.Name.Literal] = true
+	defer delete(t.calling, sc.Name.Literal)
+
+	callees := map[string]*celast.AST{}
+	for _, call := range calls {
+		rule := t.rules[call.AsCall().FunctionName()]
+		position := callPosition(sc, parsed.NativeRep().SourceInfo(), call)
+
+		// A rule cannot call itself, directly or through other rules.
+		if t.calling[rule.Name.Literal] {
+			return nil, token.NewSpacedError(position, fmt.Sprintf("recursive call of rule %s", rule.Name.Literal))
+		}
+
+		if len(call.AsCall().Args()) != len(rule.Arguments) {
+			return nil, token.NewSpacedError(position, fmt.Sprintf("rule %s expects %d arguments, got %d", rule.Name.Literal, len(rule.Arguments), len(call.AsCall().Args())))
+		}
+
+		callee, err := t.inlineRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		callees[rule.Name.Literal] = callee
+	}
+
+	checked, issues := env.Check(parsed)
+	if issues != nil && issues.Err() != nil {
+		return nil, ruleError(sc, issues)
+	}
+
+	// The arguments must be assignable to the parameters of the called rules.
+	for _, call := range calls {
+		rule := t.rules[call.AsCall().FunctionName()]
+		for i, param := range rule.Parameters() {
+			typ, err := getArgumentTypeIfExist(rule.Arguments[param])
+			if err != nil {
+				return nil, err
+			}
+
+			cType, err := utils.GetCelType(typ)
+			if err != nil {
+				return nil, err
+			}
+
+			argType := checked.NativeRep().GetType(call.AsCall().Args()[i].ID())
+			if !assignable(cType, argType) {
+				return nil, token.NewSpacedError(callPosition(sc, parsed.NativeRep().SourceInfo(), call), fmt.Sprintf("rule %s expects argument %s of type %s, got %s", rule.Name.Literal, param.Literal, typeName(cType), typeName(argType)))
+			}
+		}
+	}
+
+	inlined := parsed.NativeRep()
+	in := &inliner{
+		fac:     celast.NewExprFactory(),
+		info:    inlined.SourceInfo(),
+		id:      celast.MaxID(inlined),
+		renamed: map[int64]map[string]string{},
+	}
+
+	var called []string
+	for _, call := range calls {
+		rule := t.rules[call.AsCall().FunctionName()]
+		params := rule.Parameters()
+
+		arguments := make(map[string]celast.Expr, len(params))
+		for i, param := range params {
+			arguments[param.Literal] = call.AsCall().Args()[i]
+		}
+
+		in.replace(call, in.instantiate(callees[rule.Name.Literal], arguments))
+		if !slices.Contains(called, rule.Name.Literal) {
+			called = append(called, rule.Name.Literal)
+		}
+	}
+	in.syncMacroCalls(inlined.Expr())
+
+	t.inlined[sc.Name.Literal] = inlined
+	t.calls[sc.Name.Literal] = called
+	return inlined, nil
+}
+
+// inliner inlines the expressions of the called rules into the parsed expression of a rule. The macro calls of the
+// source info are kept in sync with the expression, so that the expression can be unparsed.
+type inliner struct {
+	fac celast.ExprFactory
+	// info is the source info of the expression the calls are inlined into
+	info *celast.SourceInfo
+	// id is the last expression id in use
+	id int64
+	// renamed are the variables of the comprehensions renamed to avoid capturing the identifiers of the arguments,
+	// by the ids of the comprehensions
+	renamed map[int64]map[string]string
+}
+
+// instantiate returns a copy of the expression of the called rule, where its parameters are replaced with the
+// arguments. The variables of the comprehensions of the rule that have the names of identifiers in the arguments
+// are renamed first, so that the arguments keep referring to the variables of the caller.
+func (in *inliner) instantiate(callee *celast.AST, arguments map[string]celast.Expr) celast.Expr {
+	body := in.copy(callee.Expr(), callee.SourceInfo())
+
+	free := map[string]bool{}
+	names := identifiers(body)
+	for _, argument := range arguments {
+		freeIdents(argument, map[string]int{}, func(ident celast.Expr) {
+			free[ident.AsIdent()] = true
+		})
+		for name := range identifiers(argument) {
+			names[name] = true
+		}
+	}
+	in.rename(body, free, names)
+
+	var params []celast.Expr
+	freeIdents(body, map[string]int{}, func(ident celast.Expr) {
+		if _, ok := arguments[ident.AsIdent()]; ok {
+			params = append(params, ident)
+		}
+	})
+
+	for _, param := range params {
+		in.replace(param, in.copy(arguments[param.AsIdent()], in.info))
+	}
+
+	return body
+}
+
+// copy returns a copy of the expression with new ids, and copies the macro calls of the expression from the given
+// source info.
+func (in *inliner) copy(e celast.Expr, info *celast.SourceInfo) celast.Expr {
+	ids := map[int64]int64{}
+	renumber := func(id int64) int64 {
+		if id == 0 {
+			return 0
+		}
+		if renumbered, ok := ids[id]; ok {
+			return renumbered
+		}
+		in.id++
+		ids[id] = in.id
+		return in.id
+	}
+
+	copied := in.fac.CopyExpr(e)
+	copied.RenumberIDs(renumber)
+
+	celast.PostOrderVisit(e, celast.NewExprVisitor(func(n celast.Expr) {
+		if call, ok := info.GetMacroCall(n.ID()); ok {
+			macro := in.fac.CopyExpr(call)
+			macro.RenumberIDs(renumber)
+			in.info.SetMacroCall(renumber(n.ID()), macro)
+		}
+	}))
+
+	return copied
+}
+
+// replace replaces the expression with the other one in place, keeping the id of the replaced expression.
+func (in *inliner) replace(e, other celast.Expr) {
+	e.SetKindCase(other)
+	if call, ok := in.info.GetMacroCall(other.ID()); ok {
+		in.info.ClearMacroCall(other.ID())
+		in.info.SetMacroCall(e.ID(), call)
+	}
+	if renamed, ok := in.renamed[other.ID()]; ok {
+		delete(in.renamed, other.ID())
+		in.renamed[e.ID()] = renamed
+	}
+}
+
+// rename renames the variables of the comprehensions in the expression that have one of the given names.
+func (in *inliner) rename(e celast.Expr, names, used map[string]bool) {
+	switch e.Kind() {
+	case celast.CallKind:
+		if e.AsCall().IsMemberFunction() {
+			in.rename(e.AsCall().Target(), names, used)
+		}
+		for _, arg := range e.AsCall().Args() {
+			in.rename(arg, names, used)
+		}
+	case celast.SelectKind:
+		in.rename(e.AsSelect().Operand(), names, used)
+	case celast.ListKind:
+		for _, elem := range e.AsList().Elements() {
+			in.rename(elem, names, used)
+		}
+	case celast.MapKind:
+		for _, entry := range e.AsMap().Entries() {
+			in.rename(entry.AsMapEntry().Key(), names, used)
+			in.rename(entry.AsMapEntry().Value(), names, used)
+		}
+	case celast.StructKind:
+		for _, field := range e.AsStruct().Fields() {
+			in.rename(field.AsStructField().Value(), names, used)
+		}
+	case celast.ComprehensionKind:
+		c := e.AsComprehension()
+		for _, sub := range []celast.Expr{c.IterRange(), c.AccuInit(), c.LoopCondition(), c.LoopStep(), c.Result()} {
+			in.rename(sub, names, used)
+		}
+
+		iterVar, accuVar := c.IterVar(), c.AccuVar()
+		if names[iterVar] {
+			iterVar = fresh(iterVar, used)
+			renameIdents(in.fac, c.IterVar(), iterVar, c.LoopCondition(), c.LoopStep())
+			in.renamed[e.ID()] = map[string]string{c.IterVar(): iterVar}
+		}
+		if names[accuVar] && accuVar != celparser.AccumulatorName {
+			accuVar = fresh(accuVar, used)
+			renameIdents(in.fac, c.AccuVar(), accuVar, c.LoopCondition(), c.LoopStep(), c.Result())
+			if in.renamed[e.ID()] == nil {
+				in.renamed[e.ID()] = map[string]string{}
+			}
+			in.renamed[e.ID()][c.AccuVar()] = accuVar
+		}
+
+		if iterVar != c.IterVar() || accuVar != c.AccuVar() {
+			e.SetKindCase(in.fac.NewComprehension(e.ID(), c.IterRange(), iterVar, accuVar, c.AccuInit(), c.LoopCondition(), c.LoopStep(), c.Result()))
+		}
+	}
+}
+
+// syncMacroCalls updates the macro calls of the source info after the expression is changed. The expressions of the
+// macro calls that are in the expression are replaced with the ones in the expression, the renamed variables are
+// renamed, and the macro calls that are no longer in the expression are removed.
+func (in *inliner) syncMacroCalls(root celast.Expr) {
+	nodes := map[int64]celast.Expr{}
+	celast.PostOrderVisit(root, celast.NewExprVisitor(func(e celast.Expr) {
+		nodes[e.ID()] = e
+	}))
+
+	for id, call := range in.info.MacroCalls() {
+		if _, ok := nodes[id]; !ok {
+			in.info.ClearMacroCall(id)
+			continue
+		}
+		in.info.SetMacroCall(id, in.sync(call, nodes, in.renamed[id]))
+	}
+}
+
+// sync returns the expression of a macro call, where the expressions are replaced with the ones with the same id in
+// the expression of the rule, and the variables of the macro are renamed.
+func (in *inliner) sync(e celast.Expr, nodes map[int64]celast.Expr, renamed map[string]string) celast.Expr {
+	if node, ok := nodes[e.ID()]; ok && e.ID() != 0 && e.Kind() != celast.UnspecifiedExprKind {
+		return node
+	}
+
+	switch e.Kind() {
+	case celast.IdentKind:
+		if name, ok := renamed[e.AsIdent()]; ok {
+			return in.fac.NewIdent(e.ID(), name)
+		}
+	case celast.CallKind:
+		call := e.AsCall()
+		args := make([]celast.Expr, len(call.Args()))
+		for i, arg := range call.Args() {
+			args[i] = in.sync(arg, nodes, renamed)
+		}
+		if call.IsMemberFunction() {
+			return in.fac.NewMemberCall(e.ID(), call.FunctionName(), in.sync(call.Target(), nodes, renamed), args...)
+		}
+		return in.fac.NewCall(e.ID(), call.FunctionName(), args...)
+	case celast.SelectKind:
+		sel := e.AsSelect()
+		if sel.IsTestOnly() {
+			return in.fac.NewPresenceTest(e.ID(), in.sync(sel.Operand(), nodes, renamed), sel.FieldName())
+		}
+		return in.fac.NewSelect(e.ID(), in.sync(sel.Operand(), nodes, renamed), sel.FieldName())
+	case celast.ListKind:
+		list := e.AsList()
+		elems := make([]celast.Expr, len(list.Elements()))
+		for i, elem := range list.Elements() {
+			elems[i] = in.sync(elem, nodes, renamed)
+		}
+		return in.fac.NewList(e.ID(), elems, list.OptionalIndices())
+	}
+	return e
+}
+
+// freeIdents calls the function for the identifiers in the expression that are not bound by the comprehensions
+// around them, the given variables are bound by the comprehensions the expression is in.
+func freeIdents(e celast.Expr, bound map[string]int, fn func(ident celast.Expr)) {
+	switch e.Kind() {
+	case celast.IdentKind:
+		if bound[e.AsIdent()] == 0 {
+			fn(e)
+		}
+	case celast.CallKind:
+		if e.AsCall().IsMemberFunction() {
+			freeIdents(e.AsCall().Target(), bound, fn)
+		}
+		for _, arg := range e.AsCall().Args() {
+			freeIdents(arg, bound, fn)
+		}
+	case celast.SelectKind:
+		freeIdents(e.AsSelect().Operand(), bound, fn)
+	case celast.ListKind:
+		for _, elem := range e.AsList().Elements() {
+			freeIdents(elem, bound, fn)
+		}
+	case celast.MapKind:
+		for _, entry := range e.AsMap().Entries() {
+			freeIdents(entry.AsMapEntry().Key(), bound, fn)
+			freeIdents(entry.AsMapEntry().Value(), bound, fn)
+		}
+	case celast.StructKind:
+		for _, field := range e.AsStruct().Fields() {
+			freeIdents(field.AsStructField().Value(), bound, fn)
+		}
+	case celast.ComprehensionKind:
+		c := e.AsComprehension()
+		freeIdents(c.IterRange(), bound, fn)
+		freeIdents(c.AccuInit(), bound, fn)
+		bound[c.IterVar()]++
+		bound[c.AccuVar()]++
+		freeIdents(c.LoopCondition(), bound, fn)
+		freeIdents(c.LoopStep(), bound, fn)
+		bound[c.IterVar()]--
+		freeIdents(c.Result(), bound, fn)
+		bound[c.AccuVar()]--
+	}
+}
+
+// renameIdents renames the identifiers with the given name in the expressions that are not bound by the
+// comprehensions in them.
+func renameIdents(fac celast.ExprFactory, name, renamed string, exprs ...celast.Expr) {
+	for _, e := range exprs {
+		freeIdents(e, map[string]int{}, func(ident celast.Expr) {
+			if ident.AsIdent() == name {
+				ident.SetKindCase(fac.NewIdent(ident.ID(), renamed))
+			}
+		})
+	}
+}
+
+// identifiers returns the names of the identifiers and the variables of the comprehensions in the expression.
+func identifiers(e celast.Expr) map[string]bool {
+	names := map[string]bool{}
+	celast.PostOrderVisit(e, celast.NewExprVisitor(func(n celast.Expr) {
+		switch n.Kind() {
+		case celast.IdentKind:
+			names[n.AsIdent()] = true
+		case celast.ComprehensionKind:
+			names[n.AsComprehension().IterVar()] = true
+			names[n.AsComprehension().AccuVar()] = true
+		}
+	}))
+	return names
+}
+
+// fresh returns a name starting with the given one that is not used, and marks it as used.
+func fresh(name string, used map[string]bool) string {
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s_%d", name, i)
+		if !used[candidate] {
+			used[candidate] = true
+			return candidate
+		}
+	}
+}
+
+// assignable reports whether a value of the given type can be passed to a parameter of the other type. Dynamic
+// values, such as the values of the context, are checked when the rule is evaluated.
+func assignable(param, arg *types.Type) bool {
+	if arg == nil || arg.Kind() == types.DynKind || arg.Kind() == types.TypeParamKind || param.IsAssignableType(arg) {
+		return true
+	}
+	if param.Kind() != arg.Kind() || len(param.Parameters()) != len(arg.Parameters()) {
+		return false
+	}
+	for i, p := range param.Parameters() {
+		if !assignable(p, arg.Parameters()[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// typeName returns the name of the CEL type in the schema language, such as integer[].
+func typeName(t *types.Type) string {
+	switch t.Kind() {
+	case types.BoolKind:
+		return "boolean"
+	case types.IntKind:
+		return "integer"
+	case types.DoubleKind:
+		return "double"
+	case types.StringKind:
+		return "string"
+	case types.ListKind:
+		return typeName(t.Parameters()[0]) + "[]"
+	default:
+		return t.String()
+	}
+}
+
+// callPosition returns the position of the name of the called rule in the schema.
+func callPosition(sc *ast.RuleStatement, info *celast.SourceInfo, call celast.Expr) token.PositionInfo {
+	position := sc.Name.PositionInfo
+
+	offsets, ok := info.GetOffsetRange(call.ID())
+	if !ok {
+		return position
+	}
+
+	// The offset of a call is the offset of its parenthesis, the name is the last identifier before it.
+	var start int32
+	for _, tkn := range sc.ExpressionTokens {
+		if start > offsets.Start {
+			break
+		}
+		if tkn.Type == token.IDENT {
+			position = tkn.PositionInfo
+		}
+		literal := tkn.Literal
+		if tkn.Type == token.STRING {
+			literal = strconv.Quote(tkn.Literal)
+		}
+		start += int32(utf8.RuneCountInString(literal))
+	}
+
+	return position
+}
+
+// ruleError returns the error of the CEL expression of the rule, which points to the line after the name of the rule.
+func ruleError(sc *ast.RuleStatement, issues *cel.Issues) error {
+	pi := sc.Name.PositionInfo
+	pi.LinePosition++
+	return compileError(pi, issues.Err().Error())
+}
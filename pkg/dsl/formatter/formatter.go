@@ -0,0 +1,669 @@
+package formatter
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/lexer"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	"github.com/Permify/permify/pkg/dsl/token"
+)
+
+const (
+	// Width is the maximum width of a line, longer permission expressions are wrapped after their operators.
+	Width = 80
+
+	// indent is the indentation of the statements inside entities and rules.
+	indent = "    "
+)
+
+// comment represents a comment of the source, which is attached to the closest statement.
+type comment struct {
+	// text is the comment including its delimiters.
+	text string
+	// line is the line the comment starts at.
+	line int
+	// index is the index of the comment in the tokens of the source.
+	index int
+	// trailing is true if the comment follows a statement on the same line.
+	trailing bool
+}
+
+// span represents a statement of the source with the range of its tokens and its comments.
+type span struct {
+	statement ast.Statement
+	start     int
+	end       int
+	leading   []comment
+	trailing  []comment
+}
+
+// formatter holds the source being formatted, its tokens and its comments.
+type formatter struct {
+	lines     []string
+	tokens    []token.Token
+	positions map[token.PositionInfo]int
+	comments  []comment
+	out       strings.Builder
+}
+
+// Format returns the canonical form of the given schema. Imports come first, the definitions keep their order,
+// and the statements of entities are grouped as relations, attributes and permissions. Comments are preserved and
+// stay attached to the statement they precede or follow on the same line.
+func Format(input string) (string, error) {
+	input = strings.ReplaceAll(input, "\r\n", "\n")
+
+	sch, err := parser.NewParser(input).Parse()
+	if err != nil {
+		return "", err
+	}
+
+	f := &formatter{
+		lines:     strings.Split(input, "\n"),
+		positions: map[token.PositionInfo]int{},
+	}
+	f.tokenize(input)
+	f.format(sch)
+
+	return f.out.String(), nil
+}
+
+// tokenize collects the tokens and the comments of the source.
+func (f *formatter) tokenize(input string) {
+	l := lexer.NewLexer(input)
+	for {
+		tok := l.NextToken()
+		if tok.Type == token.EOF {
+			break
+		}
+		f.positions[tok.PositionInfo] = len(f.tokens)
+		f.tokens = append(f.tokens, tok)
+	}
+
+	for i, tok := range f.tokens {
+		var text string
+		switch tok.Type {
+		case token.SINGLE_LINE_COMMENT:
+			text = strings.TrimRight("//"+tok.Literal, " \t")
+		case token.MULTI_LINE_COMMENT:
+			text = "/*" + tok.Literal + "*/"
+		default:
+			continue
+		}
+		f.comments = append(f.comments, comment{
+			text:     text,
+			line:     tok.PositionInfo.LinePosition,
+			index:    i,
+			trailing: f.isTrailing(i),
+		})
+	}
+}
+
+// isTrailing returns true if a token other than a comment precedes the token at the given index on its line.
+func (f *formatter) isTrailing(i int) bool {
+	for j := i - 1; j >= 0; j-- {
+		switch f.tokens[j].Type {
+		case token.SPACE, token.TAB, token.SINGLE_LINE_COMMENT, token.MULTI_LINE_COMMENT:
+			continue
+		case token.NEWLINE:
+			return f.tokens[j].Literal == ";"
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// index returns the index of the given token in the tokens of the source.
+func (f *formatter) index(tok token.Token) int {
+	return f.positions[tok.PositionInfo]
+}
+
+// closing returns the index of the right curly bracket that closes the first left curly bracket after the given index.
+func (f *formatter) closing(from int) int {
+	depth := 0
+	for i := from; i < len(f.tokens); i++ {
+		switch f.tokens[i].Type {
+		case token.LCB:
+			depth++
+		case token.RCB:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(f.tokens) - 1
+}
+
+// format writes the formatted schema.
+func (f *formatter) format(sch *ast.Schema) {
+	var spans []*span
+	for _, imp := range sch.Imports {
+		spans = append(spans, &span{statement: imp, start: f.index(imp.Import), end: f.index(imp.Path)})
+	}
+	for _, st := range sch.Statements {
+		switch s := st.(type) {
+		case *ast.EntityStatement:
+			start := f.index(s.Entity)
+			spans = append(spans, &span{statement: s, start: start, end: f.closing(start)})
+		case *ast.RuleStatement:
+			start := f.index(s.Rule)
+			spans = append(spans, &span{statement: s, start: start, end: f.closing(start)})
+		case *ast.MacroStatement:
+			spans = append(spans, &span{statement: s, start: f.index(s.Macro)})
+		}
+	}
+
+	// a macro ends with its last token before the next statement or the end of the source
+	sorted := byPosition(spans)
+	for i, s := range sorted {
+		if _, ok := s.statement.(*ast.MacroStatement); ok {
+			next := len(f.tokens)
+			if i+1 < len(sorted) {
+				next = sorted[i+1].start
+			}
+			s.end = f.previousSignificant(next)
+		}
+	}
+
+	// comments inside entities belong to their statements, comments inside rules are kept in their bodies, and
+	// comments inside macros are moved around them
+	var outside []comment
+	for _, c := range f.comments {
+		s := enclosing(spans, c.index)
+		if _, macro := statementOf(s).(*ast.MacroStatement); s == nil || macro {
+			outside = append(outside, c)
+		}
+	}
+
+	dangling := attach(byPosition(spans), outside)
+
+	for i, s := range spans {
+		if i > 0 {
+			_, imp := s.statement.(*ast.ImportStatement)
+			_, prev := spans[i-1].statement.(*ast.ImportStatement)
+			if !imp || !prev || f.blankBefore(f.firstLine(s)) {
+				f.out.WriteString("\n")
+			}
+		}
+
+		f.leading(s, "", true)
+
+		switch st := s.statement.(type) {
+		case *ast.ImportStatement:
+			f.out.WriteString(st.String())
+		case *ast.EntityStatement:
+			f.entity(st, s)
+		case *ast.RuleStatement:
+			f.rule(st, s)
+		case *ast.MacroStatement:
+			f.macro(st)
+		}
+
+		f.trailing(s.trailing)
+		f.out.WriteString("\n")
+	}
+
+	for i, c := range dangling {
+		if (i > 0 || len(spans) > 0) && f.blankBefore(c.line) {
+			f.out.WriteString("\n")
+		}
+		f.out.WriteString(c.text)
+		f.out.WriteString("\n")
+	}
+}
+
+// entity writes the entity statement and the statements inside it.
+func (f *formatter) entity(st *ast.EntityStatement, s *span) {
+	var groups [][]*span
+	var members []*span
+	for _, statements := range [][]ast.Statement{st.RelationStatements, st.AttributeStatements, st.PermissionStatements} {
+		var group []*span
+		for _, ms := range statements {
+			m := &span{statement: ms, start: f.index(keyword(ms))}
+			group = append(group, m)
+			members = append(members, m)
+		}
+		if len(group) > 0 {
+			groups = append(groups, group)
+		}
+	}
+
+	// the end of a statement is its last token before the next statement or the end of the entity
+	members = byPosition(members)
+	for i, m := range members {
+		next := s.end
+		if i+1 < len(members) {
+			next = members[i+1].start
+		}
+		m.end = f.previousSignificant(next)
+	}
+
+	var body, header []comment
+	for _, c := range f.comments {
+		if c.index <= s.start || c.index >= s.end {
+			continue
+		}
+		if c.trailing && (len(members) == 0 || c.index < members[0].start) {
+			header = append(header, c)
+			continue
+		}
+		body = append(body, c)
+	}
+	dangling := attach(members, body)
+
+	f.out.WriteString("entity ")
+	f.out.WriteString(st.Name.Literal)
+	if st.Extends.Literal != "" {
+		f.out.WriteString(" extends ")
+		f.out.WriteString(st.Extends.Literal)
+	}
+
+	if len(members) == 0 && len(dangling) == 0 && len(header) == 0 {
+		f.out.WriteString(" {}")
+		return
+	}
+
+	f.out.WriteString(" {")
+	f.trailing(header)
+	f.out.WriteString("\n")
+
+	started := false
+	for i, group := range groups {
+		if i > 0 {
+			f.out.WriteString("\n")
+			started = false
+		}
+		for _, m := range group {
+			f.leading(m, indent, !started)
+			f.member(m.statement)
+			f.trailing(m.trailing)
+			f.out.WriteString("\n")
+			started = true
+		}
+	}
+
+	for _, c := range dangling {
+		if started && f.blankBefore(c.line) {
+			f.out.WriteString("\n")
+		}
+		f.out.WriteString(indent)
+		f.out.WriteString(c.text)
+		f.out.WriteString("\n")
+		started = true
+	}
+
+	f.out.WriteString("}")
+}
+
+// member writes a relation, attribute or permission statement.
+func (f *formatter) member(st ast.Statement) {
+	f.out.WriteString(indent)
+	switch s := st.(type) {
+	case *ast.RelationStatement:
+		types := make([]string, 0, len(s.RelationTypes))
+		for _, rt := range s.RelationTypes {
+			types = append(types, rt.String())
+		}
+		f.out.WriteString("relation ")
+		f.out.WriteString(s.Name.Literal)
+		f.out.WriteString(" ")
+		f.out.WriteString(strings.Join(types, " "))
+	case *ast.AttributeStatement:
+		f.out.WriteString("attribute ")
+		f.out.WriteString(s.Name.Literal)
+		f.out.WriteString(" ")
+		f.out.WriteString(s.AttributeType.String())
+		if s.DefaultValue != nil {
+			f.out.WriteString(" = ")
+			f.out.WriteString(s.DefaultValue.String())
+		}
+	case *ast.PermissionStatement:
+		prefix := s.Permission.Literal + " " + s.Name.Literal + " = "
+		f.out.WriteString(prefix)
+		if es, ok := s.ExpressionStatement.(*ast.ExpressionStatement); ok && es.Expression != nil {
+			lines := wrap(expression(es.Expression, ""), len(indent)+len(prefix), indent+indent)
+			f.out.WriteString(strings.Join(lines, "\n"+indent+indent))
+		}
+	}
+}
+
+// rule writes the rule statement, the body of the rule is kept as it is apart from its indentation.
+func (f *formatter) rule(st *ast.RuleStatement, s *span) {
+	literals := make([]string, 0, len(st.Arguments))
+	for _, argument := range st.Parameters() {
+		typ := st.Arguments[argument]
+		literals = append(literals, argument.Literal+" "+typ.String())
+	}
+
+	f.out.WriteString("rule ")
+	f.out.WriteString(st.Name.Literal)
+	f.out.WriteString("(")
+	f.out.WriteString(strings.Join(literals, ", "))
+	f.out.WriteString(") {\n")
+
+	open := s.start
+	for open < s.end && f.tokens[open].Type != token.LCB {
+		open++
+	}
+
+	for _, line := range body(f.source(f.tokens[open], f.tokens[s.end])) {
+		if line != "" {
+			f.out.WriteString(indent)
+			f.out.WriteString(line)
+		}
+		f.out.WriteString("\n")
+	}
+
+	f.out.WriteString("}")
+}
+
+// macro writes the macro statement, its expression is wrapped like the expressions of permissions.
+func (f *formatter) macro(st *ast.MacroStatement) {
+	parameters := make([]string, 0, len(st.Parameters))
+	for _, parameter := range st.Parameters {
+		parameters = append(parameters, parameter.Literal)
+	}
+
+	prefix := "macro " + st.Name.Literal + "(" + strings.Join(parameters, ", ") + ") = "
+	f.out.WriteString(prefix)
+	if es, ok := st.ExpressionStatement.(*ast.ExpressionStatement); ok && es.Expression != nil {
+		lines := wrap(expression(es.Expression, ""), len(prefix), indent)
+		f.out.WriteString(strings.Join(lines, "\n"+indent))
+	}
+}
+
+// leading writes the leading comments of the statement. Blank lines between the comments and the statement are
+// preserved, unless the statement is the first one of its block.
+func (f *formatter) leading(s *span, prefix string, first bool) {
+	for i, c := range s.leading {
+		if (i > 0 || !first) && f.blankBefore(c.line) {
+			f.out.WriteString("\n")
+		}
+		f.out.WriteString(prefix)
+		f.out.WriteString(c.text)
+		f.out.WriteString("\n")
+	}
+	if (len(s.leading) > 0 || !first) && f.blankBefore(f.tokens[s.start].PositionInfo.LinePosition) {
+		f.out.WriteString("\n")
+	}
+}
+
+// trailing writes the comments that follow a statement on the same line.
+func (f *formatter) trailing(comments []comment) {
+	for _, c := range comments {
+		f.out.WriteString(" ")
+		f.out.WriteString(c.text)
+	}
+}
+
+// firstLine returns the line of the first leading comment of the statement, or the line of the statement.
+func (f *formatter) firstLine(s *span) int {
+	if len(s.leading) > 0 {
+		return s.leading[0].line
+	}
+	return f.tokens[s.start].PositionInfo.LinePosition
+}
+
+// blankBefore returns true if the line before the given line of the source is blank.
+func (f *formatter) blankBefore(line int) bool {
+	return line >= 2 && line-2 < len(f.lines) && strings.TrimSpace(f.lines[line-2]) == ""
+}
+
+// previousSignificant returns the index of the last token before the given index that is not a comment,
+// a whitespace or a newline.
+func (f *formatter) previousSignificant(i int) int {
+	for j := i - 1; j >= 0; j-- {
+		switch f.tokens[j].Type {
+		case token.SPACE, token.TAB, token.NEWLINE, token.SINGLE_LINE_COMMENT, token.MULTI_LINE_COMMENT:
+			continue
+		default:
+			return j
+		}
+	}
+	return i
+}
+
+// source returns the source between the given curly brackets, excluding the brackets.
+func (f *formatter) source(open, closing token.Token) string {
+	startLine, startColumn := open.PositionInfo.LinePosition-1, open.PositionInfo.ColumnPosition-1
+	endLine, endColumn := closing.PositionInfo.LinePosition-1, closing.PositionInfo.ColumnPosition-2
+
+	if startLine == endLine {
+		return f.lines[startLine][startColumn:endColumn]
+	}
+
+	var sb strings.Builder
+	sb.WriteString(f.lines[startLine][startColumn:])
+	for i := startLine + 1; i < endLine; i++ {
+		sb.WriteString("\n")
+		sb.WriteString(f.lines[i])
+	}
+	sb.WriteString("\n")
+	sb.WriteString(f.lines[endLine][:endColumn])
+	return sb.String()
+}
+
+// keyword returns the keyword token of a relation, attribute or permission statement.
+func keyword(st ast.Statement) token.Token {
+	switch s := st.(type) {
+	case *ast.RelationStatement:
+		return s.Relation
+	case *ast.AttributeStatement:
+		return s.Attribute
+	case *ast.PermissionStatement:
+		return s.Permission
+	default:
+		return token.Token{}
+	}
+}
+
+// statementOf returns the statement of the span, or nil if there is no span.
+func statementOf(s *span) ast.Statement {
+	if s == nil {
+		return nil
+	}
+	return s.statement
+}
+
+// byPosition returns the spans sorted by their position in the source.
+func byPosition(spans []*span) []*span {
+	sorted := make([]*span, len(spans))
+	copy(sorted, spans)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].start < sorted[j].start })
+	return sorted
+}
+
+// enclosing returns the span that contains the token at the given index.
+func enclosing(spans []*span, i int) *span {
+	for _, s := range spans {
+		if s.start <= i && i <= s.end {
+			return s
+		}
+	}
+	return nil
+}
+
+// attach attaches the comments to the given spans, which are sorted by their position. A trailing comment belongs
+// to the statement it follows, and any other comment belongs to the statement it precedes or is placed in.
+// The comments after the last statement are returned.
+func attach(spans []*span, comments []comment) (dangling []comment) {
+	for _, c := range comments {
+		if c.trailing {
+			var previous *span
+			for _, s := range spans {
+				if s.start <= c.index {
+					previous = s
+				}
+			}
+			if previous != nil {
+				previous.trailing = append(previous.trailing, c)
+				continue
+			}
+		}
+
+		var next *span
+		for _, s := range spans {
+			if c.index <= s.end {
+				next = s
+				break
+			}
+		}
+		if next == nil {
+			dangling = append(dangling, c)
+			continue
+		}
+		next.leading = append(next.leading, c)
+	}
+	return dangling
+}
+
+// expression returns the tokens of the expression. Parentheses are omitted for chains of the same operator,
+// since the operators are left associative, and are kept where different operators are mixed.
+func expression(e ast.Expression, parent ast.Operator) []string {
+	switch ex := e.(type) {
+	case *ast.InfixExpression:
+		tokens := expression(ex.Left, ex.Operator)
+		tokens = append(tokens, ex.Operator.String())
+		if ex.Right.IsInfix() {
+			tokens = append(tokens, "(")
+			tokens = append(tokens, expression(ex.Right, "")...)
+			tokens = append(tokens, ")")
+		} else {
+			tokens = append(tokens, expression(ex.Right, ex.Operator)...)
+		}
+		if parent != "" && parent != ex.Operator {
+			tokens = append([]string{"("}, append(tokens, ")")...)
+		}
+		return tokens
+	default:
+		return []string{e.String()}
+	}
+}
+
+// piece is a part of an expression that can be placed on a separate line.
+type piece struct {
+	text string
+	// depth is the number of parentheses enclosing the line break after the piece.
+	depth int
+}
+
+// wrap returns the lines of an expression whose first line starts at the given column and whose other lines are
+// indented with the given continuation. The parser allows line breaks after operators and left parentheses only,
+// the breaks at the outermost level are preferred.
+func wrap(tokens []string, column int, continuation string) []string {
+	var pieces []piece
+	var current strings.Builder
+	depth := 0
+	for _, tok := range tokens {
+		if current.Len() > 0 && !strings.HasSuffix(current.String(), "(") && tok != ")" {
+			current.WriteString(" ")
+		}
+		current.WriteString(tok)
+		switch tok {
+		case "(":
+			depth++
+			pieces = append(pieces, piece{text: current.String(), depth: depth})
+			current.Reset()
+		case ")":
+			depth--
+		case ast.AND.String(), ast.OR.String(), ast.NOT.String():
+			pieces = append(pieces, piece{text: current.String(), depth: depth})
+			current.Reset()
+		}
+	}
+	if current.Len() > 0 {
+		pieces = append(pieces, piece{text: current.String()})
+	}
+
+	maximum := 0
+	for _, p := range pieces {
+		if p.depth > maximum {
+			maximum = p.depth
+		}
+	}
+
+	var lines []string
+	for allowed := 0; allowed <= maximum; allowed++ {
+		lines = fill(pieces, column, len(continuation), allowed)
+		fits := true
+		for i, line := range lines {
+			width := len(continuation) + len(line)
+			if i == 0 {
+				width = column + len(line)
+			}
+			if width > Width {
+				fits = false
+			}
+		}
+		if fits {
+			break
+		}
+	}
+	return lines
+}
+
+// fill places the pieces on lines greedily, breaking the lines only after the pieces at the allowed depth or less.
+func fill(pieces []piece, column, continuation, allowed int) []string {
+	var lines []string
+	line := ""
+	width := column
+	for i, p := range pieces {
+		sep := " "
+		if i == 0 || strings.HasSuffix(line, "(") {
+			sep = ""
+		}
+		if i > 0 && pieces[i-1].depth <= allowed && width+len(sep)+len(p.text) > Width {
+			lines = append(lines, line)
+			line, width, sep = "", continuation, ""
+		}
+		line += sep + p.text
+		width += len(sep) + len(p.text)
+	}
+	return append(lines, line)
+}
+
+// body returns the lines of the body of a rule without their common indentation and surrounding blank lines.
+func body(source string) []string {
+	raw := strings.Split(source, "\n")
+
+	lines := []string{strings.TrimSpace(raw[0])}
+	minimum := -1
+	for _, line := range raw[1:] {
+		line = strings.TrimRight(expandTabs(line), " ")
+		lines = append(lines, line)
+		if line == "" {
+			continue
+		}
+		if n := len(line) - len(strings.TrimLeft(line, " ")); minimum < 0 || n < minimum {
+			minimum = n
+		}
+	}
+	for i := 1; i < len(lines); i++ {
+		if len(lines[i]) >= minimum && minimum > 0 {
+			lines[i] = lines[i][minimum:]
+		}
+	}
+
+	// drop the surrounding blank lines and collapse the consecutive ones
+	var result []string
+	for _, line := range lines {
+		if line == "" && (len(result) == 0 || result[len(result)-1] == "") {
+			continue
+		}
+		result = append(result, line)
+	}
+	for len(result) > 0 && result[len(result)-1] == "" {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+// expandTabs replaces the tabs in the indentation of the line with spaces.
+func expandTabs(line string) string {
+	trimmed := strings.TrimLeft(line, " \t")
+	prefix := line[:len(line)-len(trimmed)]
+	return strings.ReplaceAll(prefix, "\t", indent) + trimmed
+}
@@ -0,0 +1,208 @@
+package formatter
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/parser"
+)
+
+// TestFormatter -
+func TestFormatter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "formatter-suite")
+}
+
+// permissions - returns the fully parenthesized expressions of the permissions in the schema.
+func permissions(schema string) []string {
+	sch, err := parser.NewParser(schema).Parse()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var result []string
+	for _, st := range sch.Statements {
+		if es, ok := st.(*ast.EntityStatement); ok {
+			for _, ps := range es.PermissionStatements {
+				result = append(result, ps.String())
+			}
+		}
+	}
+	return result
+}
+
+var _ = Describe("formatter", func() {
+	Context("Format", func() {
+		It("Case 1 - Normalizes spacing and groups the statements of entities", func() {
+			formatted, err := Format(`entity user {}
+entity organization {
+	permission view = admin or member
+   relation admin    @user
+        relation member @user   @team#member
+	attribute public boolean
+
+
+}
+import "./team.perm"`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(formatted).Should(Equal(`import "./team.perm"
+
+entity user {}
+
+entity organization {
+    relation admin @user
+    relation member @user @team#member
+
+    attribute public boolean
+
+    permission view = admin or member
+}
+`))
+		})
+
+		It("Case 2 - Preserves comments", func() {
+			formatted, err := Format(`// schema of the organizations
+
+// users
+entity user {} // no relations
+
+entity organization { // the organization
+	// admins of the organization
+
+	relation admin @user
+	relation member @user /* members */
+
+	// the admins and the members
+	permission view = admin or member
+	// more to come
+}
+// end of the schema`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(formatted).Should(Equal(`// schema of the organizations
+
+// users
+entity user {} // no relations
+
+entity organization { // the organization
+    // admins of the organization
+
+    relation admin @user
+    relation member @user /* members */
+
+    // the admins and the members
+    permission view = admin or member
+    // more to come
+}
+// end of the schema
+`))
+		})
+
+		It("Case 3 - Uses minimal parentheses and keeps the meaning of the expressions", func() {
+			schema := `entity repository {
+	relation owner @user
+	relation admin @user
+	relation member @user
+	relation banned @user
+
+	permission a = (owner or admin) or (member or banned)
+	permission b = ((owner and admin)) and member
+	permission c = owner or admin and member
+	permission d = owner not (admin or member)
+}`
+			formatted, err := Format(schema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(formatted).Should(ContainSubstring("    permission a = owner or admin or (member or banned)\n"))
+			Expect(formatted).Should(ContainSubstring("    permission b = owner and admin and member\n"))
+			Expect(formatted).Should(ContainSubstring("    permission c = (owner or admin) and member\n"))
+			Expect(formatted).Should(ContainSubstring("    permission d = owner not (admin or member)\n"))
+
+			Expect(permissions(formatted)).Should(Equal(permissions(schema)))
+		})
+
+		It("Case 4 - Wraps long permission expressions after their operators", func() {
+			schema := `entity repository {
+	relation parent @organization
+	relation owner @user
+	relation maintainer @user
+	permission edit = owner or maintainer or parent.admin or parent.member or parent.billing_manager or parent.security_manager
+}`
+			formatted, err := Format(schema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(formatted).Should(ContainSubstring(`    permission edit = owner or maintainer or parent.admin or parent.member or
+        parent.billing_manager or parent.security_manager
+`))
+
+			Expect(permissions(formatted)).Should(Equal(permissions(schema)))
+		})
+
+		It("Case 5 - Keeps the bodies and the argument order of rules", func() {
+			formatted, err := Format(`rule check_balance(balance double, amount double) {
+		// the balance must cover the amount
+		(balance >= amount) &&
+			(amount <= 5000)
+}
+
+rule is_public(public boolean) { public == true }`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(formatted).Should(Equal(`rule check_balance(balance double, amount double) {
+    // the balance must cover the amount
+    (balance >= amount) &&
+        (amount <= 5000)
+}
+
+rule is_public(public boolean) {
+    public == true
+}
+`))
+		})
+
+		It("Case 6 - Formats macros and wraps their expressions", func() {
+			formatted, err := Format(`// shared by the documents
+macro   viewable(owner,org , parent)=owner or org.admin or parent.view // common
+macro editable(writer, organization) = writer or organization.admin or organization.maintainer or organization.member
+entity user {}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(formatted).Should(Equal(`// shared by the documents
+macro viewable(owner, org, parent) = owner or org.admin or parent.view // common
+
+macro editable(writer, organization) = writer or organization.admin or
+    organization.maintainer or organization.member
+
+entity user {}
+`))
+		})
+
+		It("Case 7 - Is idempotent", func() {
+			formatted, err := Format(`
+	// organizations
+entity organization {
+	relation admin @user // admins
+	permission view = admin or (admin and admin)
+}
+
+rule check(a integer) {
+	a > 5
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			again, err := Format(formatted)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again).Should(Equal(formatted))
+		})
+
+		It("Case 8 - Returns the parse errors", func() {
+			_, err := Format(`entity organization {
+	relation admin user
+}`)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("2:22:expected next token to be SIGN, got IDENT instead"))
+		})
+	})
+})
@@ -0,0 +1,300 @@
+package functions
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common"
+	"github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+)
+
+// stringsVersion is the version of the string extensions, it is pinned so that upgrading cel-go does not change
+// the functions available to the rules.
+const stringsVersion = 3
+
+// Library returns the functions available to the rules in addition to the standard CEL functions. The library must
+// be registered both in the environment the rules are compiled in and in the environment they are evaluated in.
+//
+// Every function is deterministic, its result depends only on its arguments, so that the results of the checks can
+// be cached. For this reason now() is the time given in the request as context.data.now, rather than the clock of
+// the server evaluating the rule.
+//
+// Time:
+//
+//	now() -> timestamp                             // timestamp(context.data.now)
+//	dayOfWeek(timestamp) -> string                 // "monday", ..., "sunday" in UTC
+//	dayOfWeek(timestamp, string) -> string         // in the given time zone, such as "Europe/Istanbul"
+//
+// Networks:
+//
+//	isIp(string) -> bool                           // whether the string is an IPv4 or IPv6 address
+//	inCidr(string, string) -> bool                 // whether the address is in the CIDR range
+//	inCidr(string, list(string)) -> bool           // whether the address is in any of the CIDR ranges
+//
+// Versions:
+//
+//	semverCompare(string, string) -> int           // -1, 0 or 1 by the semantic versioning precedence
+//
+// Sets:
+//
+//	sets.union(list(T), list(T)) -> list(T)        // the elements in either list
+//	sets.intersection(list(T), list(T)) -> list(T) // the elements of the first list in the second list
+//	sets.difference(list(T), list(T)) -> list(T)   // the elements of the first list not in the second list
+//
+// The string and set extensions of CEL, such as lowerAscii(), split(), sets.contains() and sets.intersects(),
+// are available as well.
+func Library() cel.EnvOption {
+	return cel.Lib(library{})
+}
+
+// library is the cel.Library of the functions.
+type library struct{}
+
+// CompileOptions returns the declarations and the bindings of the functions.
+func (library) CompileOptions() []cel.EnvOption {
+	list := cel.ListType(cel.TypeParamType("T"))
+
+	return []cel.EnvOption{
+		ext.Strings(ext.StringsVersion(stringsVersion)),
+		ext.Sets(),
+		cel.Macros(cel.GlobalMacro("now", 0, now)),
+		cel.Function("dayOfWeek",
+			cel.Overload("day_of_week_timestamp", []*cel.Type{cel.TimestampType}, cel.StringType,
+				cel.UnaryBinding(func(ts ref.Val) ref.Val {
+					return dayOfWeek(ts, types.String("UTC"))
+				}),
+			),
+			cel.Overload("day_of_week_timestamp_string", []*cel.Type{cel.TimestampType, cel.StringType}, cel.StringType,
+				cel.BinaryBinding(dayOfWeek),
+			),
+		),
+		cel.Function("isIp",
+			cel.Overload("is_ip_string", []*cel.Type{cel.StringType}, cel.BoolType,
+				cel.UnaryBinding(func(ip ref.Val) ref.Val {
+					_, err := netip.ParseAddr(string(ip.(types.String)))
+					return types.Bool(err == nil)
+				}),
+			),
+		),
+		cel.Function("inCidr",
+			cel.Overload("in_cidr_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(func(ip, cidr ref.Val) ref.Val {
+					return inCidr(ip, types.NewStringList(types.DefaultTypeAdapter, []string{string(cidr.(types.String))}))
+				}),
+			),
+			cel.Overload("in_cidr_string_list", []*cel.Type{cel.StringType, cel.ListType(cel.StringType)}, cel.BoolType,
+				cel.BinaryBinding(inCidr),
+			),
+		),
+		cel.Function("semverCompare",
+			cel.Overload("semver_compare_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.IntType,
+				cel.BinaryBinding(semverCompare),
+			),
+		),
+		cel.Function("sets.union",
+			cel.Overload("sets_union_list_list", []*cel.Type{list, list}, list,
+				cel.BinaryBinding(func(first, second ref.Val) ref.Val {
+					return union(first.(traits.Lister), second.(traits.Lister))
+				}),
+			),
+		),
+		cel.Function("sets.intersection",
+			cel.Overload("sets_intersection_list_list", []*cel.Type{list, list}, list,
+				cel.BinaryBinding(func(first, second ref.Val) ref.Val {
+					return filter(first.(traits.Lister), second.(traits.Lister), true)
+				}),
+			),
+		),
+		cel.Function("sets.difference",
+			cel.Overload("sets_difference_list_list", []*cel.Type{list, list}, list,
+				cel.BinaryBinding(func(first, second ref.Val) ref.Val {
+					return filter(first.(traits.Lister), second.(traits.Lister), false)
+				}),
+			),
+		),
+	}
+}
+
+// ProgramOptions returns no options, the functions are bound in their declarations.
+func (library) ProgramOptions() []cel.ProgramOption {
+	return nil
+}
+
+// now expands now() to timestamp(context.data.now).
+func now(eh cel.MacroExprFactory, _ ast.Expr, _ []ast.Expr) (ast.Expr, *common.Error) {
+	return eh.NewCall("timestamp", eh.NewSelect(eh.NewSelect(eh.NewIdent("context"), "data"), "now")), nil
+}
+
+// dayOfWeek returns the lowercase name of the day of the timestamp in the time zone.
+func dayOfWeek(ts, zone ref.Val) ref.Val {
+	location, err := time.LoadLocation(string(zone.(types.String)))
+	if err != nil {
+		return types.NewErr("dayOfWeek: unknown time zone %s", zone)
+	}
+	return types.String(strings.ToLower(ts.(types.Timestamp).In(location).Weekday().String()))
+}
+
+// inCidr reports whether the address is in any of the CIDR ranges. Addresses that cannot be parsed are in no
+// range, while ranges that cannot be parsed are errors.
+func inCidr(ip, cidrs ref.Val) ref.Val {
+	addr, err := netip.ParseAddr(string(ip.(types.String)))
+	if err != nil {
+		return types.False
+	}
+
+	it := cidrs.(traits.Lister).Iterator()
+	for it.HasNext() == types.True {
+		cidr := it.Next()
+		prefix, err := netip.ParsePrefix(string(cidr.(types.String)))
+		if err != nil {
+			return types.NewErr("inCidr: invalid CIDR range %s", cidr)
+		}
+		if prefix.Contains(addr.Unmap()) {
+			return types.True
+		}
+	}
+
+	return types.False
+}
+
+// semverCompare compares the semantic versions, which may start with "v".
+func semverCompare(first, second ref.Val) ref.Val {
+	a, err := parseSemver(string(first.(types.String)))
+	if err != nil {
+		return types.NewErr("semverCompare: %s", err)
+	}
+	b, err := parseSemver(string(second.(types.String)))
+	if err != nil {
+		return types.NewErr("semverCompare: %s", err)
+	}
+	return types.Int(a.compare(b))
+}
+
+// union returns the elements of the first list followed by the elements of the second list that are not in the first.
+func union(first, second traits.Lister) ref.Val {
+	var values []ref.Val
+	for it := first.Iterator(); it.HasNext() == types.True; {
+		values = append(values, it.Next())
+	}
+	for it := second.Iterator(); it.HasNext() == types.True; {
+		value := it.Next()
+		if first.Contains(value) != types.True {
+			values = append(values, value)
+		}
+	}
+	return types.NewRefValList(types.DefaultTypeAdapter, values)
+}
+
+// filter returns the elements of the first list that are, or are not, in the second list, keeping their order.
+func filter(first, second traits.Lister, in bool) ref.Val {
+	values := []ref.Val{}
+	for it := first.Iterator(); it.HasNext() == types.True; {
+		value := it.Next()
+		if (second.Contains(value) == types.True) == in {
+			values = append(values, value)
+		}
+	}
+	return types.NewRefValList(types.DefaultTypeAdapter, values)
+}
+
+// semver is a semantic version, https://semver.org. The build metadata does not take part in the precedence.
+type semver struct {
+	core       [3]uint64
+	prerelease []string
+}
+
+// parseSemver parses the version, such as "1.2.3", "v1.2.3-rc.1" or "1.2.3+build.5".
+func parseSemver(version string) (semver, error) {
+	var v semver
+
+	s := strings.TrimPrefix(version, "v")
+	s, _, _ = strings.Cut(s, "+")
+	s, prerelease, hasPrerelease := strings.Cut(s, "-")
+
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return v, fmt.Errorf("invalid semantic version %s", version)
+	}
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return v, fmt.Errorf("invalid semantic version %s", version)
+		}
+		v.core[i] = n
+	}
+
+	if hasPrerelease {
+		v.prerelease = strings.Split(prerelease, ".")
+		for _, identifier := range v.prerelease {
+			if identifier == "" {
+				return v, fmt.Errorf("invalid semantic version %s", version)
+			}
+		}
+	}
+
+	return v, nil
+}
+
+// compare returns -1, 0 or 1 as the version has lower, equal or higher precedence than the other version.
+func (v semver) compare(other semver) int {
+	for i := range v.core {
+		if v.core[i] != other.core[i] {
+			return order(v.core[i] < other.core[i])
+		}
+	}
+
+	// a version without a prerelease has higher precedence than the same version with a prerelease
+	switch {
+	case len(v.prerelease) == 0 && len(other.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(other.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.prerelease) && i < len(other.prerelease); i++ {
+		a, b := v.prerelease[i], other.prerelease[i]
+		if a == b {
+			continue
+		}
+
+		// numeric identifiers are compared numerically and have lower precedence than alphanumeric identifiers
+		x, errA := strconv.ParseUint(a, 10, 64)
+		y, errB := strconv.ParseUint(b, 10, 64)
+		switch {
+		case errA == nil && errB == nil:
+			return order(x < y)
+		case errA == nil:
+			return -1
+		case errB == nil:
+			return 1
+		default:
+			return order(a < b)
+		}
+	}
+
+	switch {
+	case len(v.prerelease) < len(other.prerelease):
+		return -1
+	case len(v.prerelease) > len(other.prerelease):
+		return 1
+	}
+	return 0
+}
+
+// order returns -1 if the first is less than the second, 1 otherwise.
+func order(less bool) int {
+	if less {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,156 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFunctions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "functions suite")
+}
+
+// eval compiles the expression in an environment with the library and the context, and evaluates it with the
+// given values of the context data.
+func eval(expression string, data map[string]interface{}) (interface{}, error) {
+	env, err := cel.NewEnv(cel.Variable("context", cel.DynType), Library())
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	prg, err := env.Program(compiled)
+	if err != nil {
+		return nil, err
+	}
+
+	out, _, err := prg.Eval(map[string]interface{}{
+		"context": map[string]interface{}{
+			"data": data,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Value(), nil
+}
+
+var _ = Describe("functions", func() {
+	Context("Time", func() {
+		It("Case 1: now is the time in the context", func() {
+			data := map[string]interface{}{"now": "2024-03-08T10:00:00Z"}
+
+			out, err := eval(`now() > timestamp("2024-03-01T00:00:00Z")`, data)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out).Should(Equal(true))
+
+			out, err = eval(`now() - duration("240h") < timestamp("2024-03-01T00:00:00Z")`, data)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out).Should(Equal(true))
+
+			_, err = eval(`now() > timestamp("2024-03-01T00:00:00Z")`, map[string]interface{}{})
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("Case 2: day of week", func() {
+			data := map[string]interface{}{"now": "2024-03-08T23:30:00Z"}
+
+			out, err := eval(`dayOfWeek(now())`, data)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out).Should(Equal("friday"))
+
+			out, err = eval(`dayOfWeek(now(), "Europe/Istanbul")`, data)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out).Should(Equal("saturday"))
+
+			_, err = eval(`dayOfWeek(now(), "Nowhere/City")`, data)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("Strings", func() {
+		It("Case 1: string extensions", func() {
+			out, err := eval(`"Admin,Editor".lowerAscii().split(",") == ["admin", "editor"]`, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out).Should(Equal(true))
+
+			out, err = eval(`"  docs ".trim().startsWith("do")`, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out).Should(Equal(true))
+		})
+	})
+
+	Context("Networks", func() {
+		It("Case 1: ip addresses and ranges", func() {
+			tests := map[string]bool{
+				`isIp("10.0.0.1")`:                                       true,
+				`isIp("2001:db8::1")`:                                    true,
+				`isIp("10.0.0")`:                                         false,
+				`inCidr("10.1.2.3", "10.0.0.0/8")`:                       true,
+				`inCidr("11.1.2.3", "10.0.0.0/8")`:                       false,
+				`inCidr("::ffff:10.1.2.3", "10.0.0.0/8")`:                true,
+				`inCidr("2001:db8::1", ["10.0.0.0/8", "2001:db8::/32"])`: true,
+				`inCidr("not an ip", ["10.0.0.0/8"])`:                    false,
+			}
+
+			for expression, expected := range tests {
+				out, err := eval(expression, nil)
+				Expect(err).ShouldNot(HaveOccurred(), expression)
+				Expect(out).Should(Equal(expected), expression)
+			}
+
+			_, err := eval(`inCidr("10.1.2.3", "10.0.0.0/33")`, nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("Versions", func() {
+		It("Case 1: semantic version precedence", func() {
+			tests := map[string]int64{
+				`semverCompare("1.2.3", "1.2.3")`:                    0,
+				`semverCompare("v1.2.3", "1.2.3+build.5")`:           0,
+				`semverCompare("1.10.0", "1.9.0")`:                   1,
+				`semverCompare("1.0.0-rc.1", "1.0.0")`:               -1,
+				`semverCompare("1.0.0-alpha", "1.0.0-alpha.1")`:      -1,
+				`semverCompare("1.0.0-alpha.1", "1.0.0-alpha.beta")`: -1,
+				`semverCompare("1.0.0-beta.11", "1.0.0-beta.2")`:     1,
+				`semverCompare("1.0.0-rc.1", "1.0.0-beta.11")`:       1,
+			}
+
+			for expression, expected := range tests {
+				out, err := eval(expression, nil)
+				Expect(err).ShouldNot(HaveOccurred(), expression)
+				Expect(out).Should(Equal(expected), expression)
+			}
+
+			_, err := eval(`semverCompare("1.2", "1.2.3")`, nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("Sets", func() {
+		It("Case 1: set operations on lists", func() {
+			tests := map[string]bool{
+				`sets.union(["a", "b"], ["b", "c"]) == ["a", "b", "c"]`: true,
+				`sets.intersection([1, 2, 3], [3, 2]) == [2, 3]`:        true,
+				`sets.difference(["a", "b", "c"], ["b"]) == ["a", "c"]`: true,
+				`size(sets.intersection(["a"], ["b"])) == 0`:            true,
+				`sets.contains(["a", "b", "c"], ["c", "a"])`:            true,
+				`sets.intersects(["a", "b"], ["c"])`:                    false,
+			}
+
+			for expression, expected := range tests {
+				out, err := eval(expression, nil)
+				Expect(err).ShouldNot(HaveOccurred(), expression)
+				Expect(out).Should(Equal(expected), expression)
+			}
+		})
+	})
+})
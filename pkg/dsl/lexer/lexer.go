@@ -6,6 +6,8 @@ import (
 
 // Lexer - represents a lexical analyzer for the input source code.
 type Lexer struct {
+	// The file of the input source code, empty if the schema is not split into files.
+	file string
 	// The input source code to be analyzed.
 	input string
 	// The current position in the input source code.
@@ -27,6 +29,19 @@ func NewLexer(input string) (l *Lexer) {
 	return
 }
 
+// NewFileLexer - creates a new Lexer instance for the input source code of the given file.
+// The file is included in the position info of the tokens.
+func NewFileLexer(file, input string) (l *Lexer) {
+	l = NewLexer(input)
+	l.file = file
+	return
+}
+
+// GetFile - returns the file of the input source code.
+func (l *Lexer) GetFile() string {
+	return l.file
+}
+
 // GetLinePosition - returns the current line position of the Lexer in the input source code.
 func (l *Lexer) GetLinePosition() int {
 	return l.linePosition
@@ -62,90 +77,90 @@ func (l *Lexer) NextToken() (tok token.Token) {
 	// switch statement to determine the type of token based on the current character
 	switch l.ch {
 	case '\t':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.TAB, l.ch)
+		tok = token.New(l.positionInfo(), token.TAB, l.ch)
 	case ' ':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.SPACE, l.ch)
+		tok = token.New(l.positionInfo(), token.SPACE, l.ch)
 	case '\n':
 		l.newLine()
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.NEWLINE, l.ch)
+		tok = token.New(l.positionInfo(), token.NEWLINE, l.ch)
 	case '\r':
 		l.newLine()
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.NEWLINE, l.ch)
+		tok = token.New(l.positionInfo(), token.NEWLINE, l.ch)
 	case ';':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.NEWLINE, l.ch)
+		tok = token.New(l.positionInfo(), token.NEWLINE, l.ch)
 	case ':':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.COLON, l.ch)
+		tok = token.New(l.positionInfo(), token.COLON, l.ch)
 	case '=':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.ASSIGN, l.ch)
+		tok = token.New(l.positionInfo(), token.ASSIGN, l.ch)
 	case '@':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.SIGN, l.ch)
+		tok = token.New(l.positionInfo(), token.SIGN, l.ch)
 	case '(':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.LP, l.ch)
+		tok = token.New(l.positionInfo(), token.LP, l.ch)
 	case ')':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.RP, l.ch)
+		tok = token.New(l.positionInfo(), token.RP, l.ch)
 	case '{':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.LCB, l.ch)
+		tok = token.New(l.positionInfo(), token.LCB, l.ch)
 	case '}':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.RCB, l.ch)
+		tok = token.New(l.positionInfo(), token.RCB, l.ch)
 	case '[':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.LSB, l.ch)
+		tok = token.New(l.positionInfo(), token.LSB, l.ch)
 	case ']':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.RSB, l.ch)
+		tok = token.New(l.positionInfo(), token.RSB, l.ch)
 	case '+':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.PLUS, l.ch)
+		tok = token.New(l.positionInfo(), token.PLUS, l.ch)
 	case '-':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.MINUS, l.ch)
+		tok = token.New(l.positionInfo(), token.MINUS, l.ch)
 	case '*':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.TIMES, l.ch)
+		tok = token.New(l.positionInfo(), token.TIMES, l.ch)
 	case '%':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.MOD, l.ch)
+		tok = token.New(l.positionInfo(), token.MOD, l.ch)
 	case '^':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.POW, l.ch)
+		tok = token.New(l.positionInfo(), token.POW, l.ch)
 	case '>':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.GT, l.ch)
+		tok = token.New(l.positionInfo(), token.GT, l.ch)
 	case '<':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.LT, l.ch)
+		tok = token.New(l.positionInfo(), token.LT, l.ch)
 	case '!':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.EXCL, l.ch)
+		tok = token.New(l.positionInfo(), token.EXCL, l.ch)
 	case '?':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.QM, l.ch)
+		tok = token.New(l.positionInfo(), token.QM, l.ch)
 	case ',':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.COMMA, l.ch)
+		tok = token.New(l.positionInfo(), token.COMMA, l.ch)
 	case '#':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.HASH, l.ch)
+		tok = token.New(l.positionInfo(), token.HASH, l.ch)
 	case '.':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.DOT, l.ch)
+		tok = token.New(l.positionInfo(), token.DOT, l.ch)
 	case '\'':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.APOS, l.ch)
+		tok = token.New(l.positionInfo(), token.APOS, l.ch)
 	case '&':
-		tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.AMPERSAND, l.ch)
+		tok = token.New(l.positionInfo(), token.AMPERSAND, l.ch)
 	case 0:
-		tok = token.Token{PositionInfo: positionInfo(l.linePosition, l.columnPosition), Type: token.EOF, Literal: ""}
+		tok = token.Token{PositionInfo: l.positionInfo(), Type: token.EOF, Literal: ""}
 	case '/':
 		switch l.peekChar() {
 		case '/':
-			tok.PositionInfo = positionInfo(l.linePosition, l.columnPosition)
+			tok.PositionInfo = l.positionInfo()
 			tok.Literal = l.lexSingleLineComment()
 			tok.Type = token.SINGLE_LINE_COMMENT
 			return
 		case '*':
-			tok.PositionInfo = positionInfo(l.linePosition, l.columnPosition)
+			tok.PositionInfo = l.positionInfo()
 			tok.Literal = l.lexMultiLineComment()
 			tok.Type = token.MULTI_LINE_COMMENT
 			return
 		default:
-			tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.DIVIDE, l.ch)
+			tok = token.New(l.positionInfo(), token.DIVIDE, l.ch)
 		}
 	case '"':
 		// check if the character is a double quote, indicating a string
-		tok.PositionInfo = positionInfo(l.linePosition, l.columnPosition)
+		tok.PositionInfo = l.positionInfo()
 		tok.Literal = l.lexString()
 		tok.Type = token.STRING
 		return
 	default:
 		// check if the character is a letter, and if so, lex the identifier and look up the keyword
 		if isLetter(l.ch) {
-			tok.PositionInfo = positionInfo(l.linePosition, l.columnPosition)
+			tok.PositionInfo = l.positionInfo()
 			tok.Literal = l.lexIdent()
 			if tok.Literal == "true" || tok.Literal == "false" {
 				tok.Type = token.BOOLEAN
@@ -155,7 +170,7 @@ func (l *Lexer) NextToken() (tok token.Token) {
 			return
 		} else if isDigit(l.ch) {
 			var isDouble bool
-			tok.PositionInfo = positionInfo(l.linePosition, l.columnPosition)
+			tok.PositionInfo = l.positionInfo()
 			tok.Literal, isDouble = l.lexNumber()
 			if isDouble {
 				tok.Type = token.DOUBLE
@@ -165,7 +180,7 @@ func (l *Lexer) NextToken() (tok token.Token) {
 			return
 		} else {
 			// if none of the above cases match, create an illegal token with the current character
-			tok = token.New(positionInfo(l.linePosition, l.columnPosition), token.ILLEGAL, l.ch)
+			tok = token.New(l.positionInfo(), token.ILLEGAL, l.ch)
 		}
 	}
 	// read the next character and return the token
@@ -282,10 +297,11 @@ func isDigit(ch byte) bool {
 	return '0' <= ch && ch <= '9'
 }
 
-// positionInfo - returns a token.PositionInfo struct with the current line and column position.
-func positionInfo(line, column int) token.PositionInfo {
+// positionInfo - returns a token.PositionInfo struct with the current file, line and column position.
+func (l *Lexer) positionInfo() token.PositionInfo {
 	return token.PositionInfo{
-		LinePosition:   line,
-		ColumnPosition: column,
+		File:           l.file,
+		LinePosition:   l.linePosition,
+		ColumnPosition: l.columnPosition,
 	}
 }
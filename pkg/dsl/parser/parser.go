@@ -3,6 +3,7 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/Permify/permify/pkg/dsl/ast"
@@ -38,7 +39,7 @@ type Parser struct {
 	// the next token after currentToken
 	peekToken token.Token
 	// a slice of error messages that are generated during parsing
-	errors []string
+	errors []error
 	// a map that associates prefix parsing functions with token types
 	prefixParseFns map[token.Type]prefixParseFn
 	// a map that associates infix parsing functions with token types
@@ -60,7 +61,7 @@ func NewParser(str string) (p *Parser) {
 	// initialize a new Parser object with the given input string and default values for other fields
 	p = &Parser{
 		l:          lexer.NewLexer(str), // create a new Lexer object with the input string
-		errors:     []string{},          // initialize an empty slice of error messages
+		errors:     []error{},           // initialize an empty slice of errors
 		references: ast.NewReferences(), // initialize an empty map for relational references
 	}
 
@@ -77,6 +78,14 @@ func NewParser(str string) (p *Parser) {
 	return p // return the newly created Parser object and no error
 }
 
+// NewFileParser creates a new Parser object for the input string of the given file.
+// The file is included in the position info of the tokens and in the error messages.
+func NewFileParser(file, str string) (p *Parser) {
+	p = NewParser(str)
+	p.l = lexer.NewFileLexer(file, str)
+	return p
+}
+
 // next retrieves the next non-ignored token from the Parser's lexer and updates the Parser's currentToken and peekToken fields
 func (p *Parser) next() {
 	for {
@@ -154,7 +163,7 @@ func (p *Parser) Error() error {
 		return nil
 	}
 	// if there are errors, return the first error message in the errors slice as an error type
-	return errors.New(p.errors[0])
+	return p.errors[0]
 }
 
 // Parse reads and parses the input string and returns an AST representation of the schema, along with any errors encountered during parsing
@@ -172,8 +181,13 @@ func (p *Parser) Parse() (*ast.Schema, error) {
 			return nil, p.Error()
 		}
 		if stmt != nil {
-			// add the parsed statement to the schema's Statements field if it is not nil
-			schema.Statements = append(schema.Statements, stmt)
+			if imp, ok := stmt.(*ast.ImportStatement); ok {
+				// import statements are resolved by the schema loader, keep them apart from the definitions
+				schema.Imports = append(schema.Imports, imp)
+			} else {
+				// add the parsed statement to the schema's Statements field if it is not nil
+				schema.Statements = append(schema.Statements, stmt)
+			}
 		}
 
 		// move to the next token in the input string
@@ -224,12 +238,47 @@ func (p *Parser) parseStatement() (ast.Statement, error) {
 	case token.RULE:
 		// if the currentToken is RULE, parse a RuleStatement
 		return p.parseRuleStatement()
+	case token.MACRO:
+		// if the currentToken is MACRO, parse a MacroStatement
+		return p.parseMacroStatement()
+	case token.IMPORT:
+		// if the currentToken is IMPORT, parse an ImportStatement
+		return p.parseImportStatement()
 	default:
 		return nil, nil
 	}
 }
 
-// parseEntityStatement method parses an ENTITY statement and returns an EntityStatement AST node
+// parseImportStatement method parses an IMPORT statement in the form of:
+//
+//	import "path/to/file.perm"
+//
+// and returns an ImportStatement AST node.
+func (p *Parser) parseImportStatement() (*ast.ImportStatement, error) {
+	// create a new ImportStatement object and set its Import field to the currentToken
+	stmt := &ast.ImportStatement{Import: p.currentToken}
+
+	// expect the next token to be a string token, and set the ImportStatement's Path field to the string's value
+	if !p.expectAndNext(token.STRING) {
+		return nil, p.Error()
+	}
+	stmt.Path = p.currentToken
+
+	// the path of the imported file cannot be empty
+	if stmt.Path.Literal == "" {
+		p.currentError(token.STRING)
+		return nil, p.Error()
+	}
+
+	// return the parsed ImportStatement and nil for the error value
+	return stmt, nil
+}
+
+// parseEntityStatement method parses an ENTITY statement, optionally extending another entity, such as:
+//
+//	entity sheet extends resource { ... }
+//
+// and returns an EntityStatement AST node
 func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 	// create a new EntityStatement object and set its Entity field to the currentToken
 	stmt := &ast.EntityStatement{Entity: p.currentToken}
@@ -246,6 +295,15 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 		return nil, p.Error()
 	}
 
+	// if the next token is EXTENDS, expect the name of the parent entity, whose statements are inherited
+	if p.peekTokenIs(token.EXTENDS) {
+		p.next()
+		if !p.expectAndNext(token.IDENT) {
+			return nil, p.Error()
+		}
+		stmt.Extends = p.currentToken
+	}
+
 	// expect the next token to be a left brace token, indicating the start of the entity's body
 	if !p.expectAndNext(token.LCB) {
 		return nil, p.Error()
@@ -395,9 +453,15 @@ func (p *Parser) parseRuleStatement() (*ast.RuleStatement, error) {
 	// Combine all the body tokens into a single string
 	var bodyStr strings.Builder
 	for _, t := range bodyTokens {
+		// The lexer unquotes the string literals, they are quoted again for the CEL expression.
+		if t.Type == token.STRING {
+			bodyStr.WriteString(strconv.Quote(t.Literal))
+			continue
+		}
 		bodyStr.WriteString(t.Literal)
 	}
 	stmt.Expression = bodyStr.String()
+	stmt.ExpressionTokens = bodyTokens
 
 	// Expect and consume the closing curly bracket '}'.
 	if !p.expectAndNext(token.RCB) {
@@ -444,6 +508,17 @@ func (p *Parser) parseAttributeStatement(entityName string) (*ast.AttributeState
 
 	stmt.AttributeType = atstmt
 
+	// if the next token is an ASSIGN token, parse the default value of the attribute
+	if p.peekTokenIs(token.ASSIGN) {
+		p.next()
+		p.next()
+		dv, err := p.parseDefaultValueStatement()
+		if err != nil {
+			return nil, p.Error()
+		}
+		stmt.DefaultValue = dv
+	}
+
 	key := utils.Key(entityName, stmt.Name.Literal)
 	// add the relation reference to the Parser's relationReferences and relationalReferences maps
 	err := p.references.AddAttributeReferences(key, atstmt)
@@ -456,6 +531,154 @@ func (p *Parser) parseAttributeStatement(entityName string) (*ast.AttributeState
 	return stmt, nil
 }
 
+// parseMacroStatement is responsible for parsing a macro statement in the form:
+//
+//	macro name(param1, param2) = EXPRESSION
+//
+// The parameters are relation, attribute or permission names that are substituted with the arguments of the
+// calls to the macro. This method assumes the current token points to the 'macro' token when it is called.
+func (p *Parser) parseMacroStatement() (*ast.MacroStatement, error) {
+	// Create a new MacroStatement
+	stmt := &ast.MacroStatement{Macro: p.currentToken}
+
+	// Expect the next token to be an identifier (the name of the macro).
+	if !p.expectAndNext(token.IDENT) {
+		return nil, p.Error()
+	}
+	stmt.Name = p.currentToken
+
+	// Expect the next token to be a left parenthesis '(' starting the parameter list.
+	if !p.expectAndNext(token.LP) {
+		return nil, p.Error()
+	}
+
+	var parameters []string
+
+	// Loop over the tokens until a right parenthesis ')' is encountered.
+	for !p.peekTokenIs(token.RP) {
+		// Expect the token to be the parameter's identifier.
+		if !p.expectAndNext(token.IDENT) {
+			return nil, p.Error()
+		}
+
+		// Parameters of a macro must be unique.
+		for _, parameter := range parameters {
+			if parameter == p.currentToken.Literal {
+				p.duplicationError(utils.Key(stmt.Name.Literal, parameter))
+				return nil, p.Error()
+			}
+		}
+
+		stmt.Parameters = append(stmt.Parameters, p.currentToken)
+		parameters = append(parameters, p.currentToken.Literal)
+
+		// If the next token is a comma, there are more parameters to parse.
+		if p.peekTokenIs(token.COMMA) {
+			p.next()
+			continue
+		} else if !p.peekTokenIs(token.RP) {
+			// If the next token is not a comma, it must be a closing parenthesis.
+			p.peekError(token.RP)
+			return nil, p.Error()
+		}
+	}
+
+	// Consume the right parenthesis.
+	p.next()
+
+	// Expect the next token to be an ASSIGN token, indicating the start of the expression of the macro.
+	if !p.expectAndNext(token.ASSIGN) {
+		return nil, p.Error()
+	}
+
+	p.next()
+
+	// Parse the expression statement and set it as the MacroStatement's ExpressionStatement field.
+	ex, err := p.parseExpressionStatement()
+	if err != nil {
+		return nil, p.Error()
+	}
+	stmt.ExpressionStatement = ex
+
+	// Register the parsed macro in the parser's references.
+	err = p.references.AddMacroReference(stmt.Name.Literal, parameters)
+	if err != nil {
+		// If there's an error (e.g., a duplicate macro), return an error.
+		p.duplicationError(stmt.Name.Literal)
+		return nil, p.Error()
+	}
+
+	// Return the successfully parsed MacroStatement.
+	return stmt, nil
+}
+
+// parseDefaultValueStatement parses the default value of an attribute, which is a literal or an array of literals
+// such as:
+//
+//	true
+//	-1.5
+//	["draft", "published"]
+func (p *Parser) parseDefaultValueStatement() (*ast.DefaultValueStatement, error) {
+	stmt := &ast.DefaultValueStatement{}
+
+	// if the current token is not a left square bracket, the value is a single literal
+	if !p.currentTokenIs(token.LSB) {
+		value, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Values = append(stmt.Values, value)
+		return stmt, nil
+	}
+
+	stmt.IsArray = true
+
+	// loop over the literals until a right square bracket is encountered
+	for !p.peekTokenIs(token.RSB) {
+		p.next()
+		value, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Values = append(stmt.Values, value)
+
+		// if the next token is a comma, there are more literals to parse
+		if p.peekTokenIs(token.COMMA) {
+			p.next()
+			continue
+		} else if !p.peekTokenIs(token.RSB) {
+			p.peekError(token.RSB)
+			return nil, p.Error()
+		}
+	}
+
+	// consume the right square bracket
+	p.next()
+
+	return stmt, nil
+}
+
+// parseLiteral parses a string, integer, double or boolean literal. A minus sign before a number is merged into the
+// literal of the number.
+func (p *Parser) parseLiteral() (token.Token, error) {
+	if p.currentTokenIs(token.MINUS) {
+		minus := p.currentToken
+		p.next()
+		if !p.currentTokenIs(token.INTEGER, token.DOUBLE) {
+			p.currentError(token.INTEGER, token.DOUBLE)
+			return token.Token{}, p.Error()
+		}
+		return token.Token{PositionInfo: minus.PositionInfo, Type: p.currentToken.Type, Literal: "-" + p.currentToken.Literal}, nil
+	}
+
+	if !p.currentTokenIs(token.STRING, token.INTEGER, token.DOUBLE, token.BOOLEAN) {
+		p.currentError(token.STRING, token.INTEGER, token.DOUBLE, token.BOOLEAN)
+		return token.Token{}, p.Error()
+	}
+
+	return p.currentToken, nil
+}
+
 // parseRelationStatement method parses a RELATION statement and returns a RelationStatement AST node
 func (p *Parser) parseRelationStatement(entityName string) (*ast.RelationStatement, error) {
 	// create a new RelationStatement object and set its Relation field to the currentToken
@@ -861,16 +1084,14 @@ func (p *Parser) registerInfix(tokenType token.Type, fn infixParseFn) {
 // duplicationError adds an error message to the parser's error list indicating that a duplication was found.
 // It takes a key string as an argument that is used to identify the source of the duplication in the input.
 func (p *Parser) duplicationError(key string) {
-	msg := fmt.Sprintf("%v:%v:duplication found for %s", p.l.GetLinePosition(), p.l.GetColumnPosition(), key)
-	p.errors = append(p.errors, msg)
+	p.errors = append(p.errors, token.NewError(p.position(), fmt.Sprintf("duplication found for %s", key)))
 }
 
 // noPrefixParseFnError adds an error message to the parser's error list indicating that no prefix parsing
 // function was found for a given token type.
 // It takes a token type as an argument that indicates the type of the token for which a parsing function is missing.
 func (p *Parser) noPrefixParseFnError(t token.Type) {
-	msg := fmt.Sprintf("%v:%v:no prefix parse function for %s found", p.l.GetLinePosition(), p.l.GetColumnPosition(), t)
-	p.errors = append(p.errors, msg)
+	p.errors = append(p.errors, token.NewError(p.position(), fmt.Sprintf("no prefix parse function for %s found", t)))
 }
 
 // peekError adds an error message to the parser's error list indicating that the next token in the input
@@ -878,8 +1099,7 @@ func (p *Parser) noPrefixParseFnError(t token.Type) {
 // It takes one or more token types as arguments that indicate the expected types.
 func (p *Parser) peekError(t ...token.Type) {
 	expected := strings.Join(tokenTypesToStrings(t), ", ")
-	msg := fmt.Sprintf("%v:%v:expected next token to be %s, got %s instead", p.l.GetLinePosition(), p.l.GetColumnPosition(), expected, p.peekToken.Type)
-	p.errors = append(p.errors, msg)
+	p.errors = append(p.errors, token.NewError(p.position(), fmt.Sprintf("expected next token to be %s, got %s instead", expected, p.peekToken.Type)))
 }
 
 // currentError adds an error message to the parser's error list indicating that the current token in the input
@@ -887,9 +1107,16 @@ func (p *Parser) peekError(t ...token.Type) {
 // It takes one or more token types as arguments that indicate the expected types.
 func (p *Parser) currentError(t ...token.Type) {
 	expected := strings.Join(tokenTypesToStrings(t), ", ")
-	msg := fmt.Sprintf("%v:%v:expected token to be %s, got %s instead", p.l.GetLinePosition(),
-		p.l.GetColumnPosition(), expected, p.currentToken.Type)
-	p.errors = append(p.errors, msg)
+	p.errors = append(p.errors, token.NewError(p.position(), fmt.Sprintf("expected token to be %s, got %s instead", expected, p.currentToken.Type)))
+}
+
+// position returns the current position of the lexer, used as the position of the errors.
+func (p *Parser) position() token.PositionInfo {
+	return token.PositionInfo{
+		File:           p.l.GetFile(),
+		LinePosition:   p.l.GetLinePosition(),
+		ColumnPosition: p.l.GetColumnPosition(),
+	}
 }
 
 // tokenTypesToStrings converts a slice of token types to a slice of their string representations.
@@ -1041,5 +1041,165 @@ rule confidentiality_level_low(confidentiality_level double) {
 			// Ensure the error message contains the expected string
 			Expect(err.Error()).Should(ContainSubstring("7:15:expected token to be RELATION, PERMISSION, ATTRIBUTE, got OR instead"))
 		})
+
+		It("Case 30 - Import statements", func() {
+			pr := NewParser(`
+			import "user.perm"
+			import "teams/organization.perm"
+
+			entity repository {
+				relation owner @user
+				relation parent @organization
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(schema.Imports).Should(HaveLen(2))
+			Expect(schema.Imports[0].Path.Literal).Should(Equal("user.perm"))
+			Expect(schema.Imports[1].Path.Literal).Should(Equal("teams/organization.perm"))
+			Expect(schema.Imports[1].String()).Should(Equal(`import "teams/organization.perm"`))
+
+			Expect(schema.Statements).Should(HaveLen(1))
+			Expect(schema.Statements[0].GetName()).Should(Equal("repository"))
+		})
+
+		It("Case 31 - Import statement without path - should fail", func() {
+			pr := NewFileParser("repository.perm", `
+			import user
+
+			entity repository {}
+			`)
+
+			_, err := pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("repository.perm:2:16:expected next token to be STRING, got IDENT instead"))
+		})
+
+		It("Case 32 - Macro statements", func() {
+			pr := NewParser(`
+			macro viewable(owner, parent) = owner or parent.admin or (parent.view not banned)
+
+			entity document {
+				relation owner @user
+				relation parent @folder
+				relation banned @user
+
+				permission view = viewable(owner, parent)
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(schema.Statements).Should(HaveLen(2))
+
+			st, ok := schema.Statements[0].(*ast.MacroStatement)
+			Expect(ok).Should(BeTrue())
+			Expect(st.Name.Literal).Should(Equal("viewable"))
+			Expect(st.Parameters).Should(HaveLen(2))
+			Expect(st.Parameters[0].Literal).Should(Equal("owner"))
+			Expect(st.Parameters[1].Literal).Should(Equal("parent"))
+			Expect(st.String()).Should(Equal("macro viewable(owner, parent) = ((owner or parent.admin) or (parent.view not banned))"))
+
+			parameters, exist := schema.GetReferences().GetMacroParametersIfMacroExist("viewable")
+			Expect(exist).Should(BeTrue())
+			Expect(parameters).Should(Equal([]string{"owner", "parent"}))
+		})
+
+		It("Case 33 - Macro with duplicate parameters - should fail", func() {
+			pr := NewParser(`
+			macro viewable(owner, owner) = owner
+			`)
+
+			_, err := pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("2:33:duplication found for viewable#owner"))
+		})
+
+		It("Case 34 - Entity extending another entity", func() {
+			pr := NewParser(`
+			entity resource {
+				relation owner @user
+				permission view = owner
+			}
+
+			entity sheet extends resource {
+				relation editor @user
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(schema.Statements).Should(HaveLen(2))
+
+			st, ok := schema.Statements[1].(*ast.EntityStatement)
+			Expect(ok).Should(BeTrue())
+			Expect(st.Name.Literal).Should(Equal("sheet"))
+			Expect(st.Extends.Literal).Should(Equal("resource"))
+			Expect(st.RelationStatements).Should(HaveLen(1))
+
+			err = schema.ResolveInheritance()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(st.RelationStatements).Should(HaveLen(2))
+			Expect(st.PermissionStatements).Should(HaveLen(1))
+			Expect(schema.GetReferences().IsRelationReferenceExist("sheet#owner")).Should(BeTrue())
+
+			typ, exist := schema.GetReferences().GetReferenceType("sheet#view")
+			Expect(exist).Should(BeTrue())
+			Expect(typ).Should(Equal(ast.PERMISSION))
+		})
+
+		It("Case 35 - Entity extending without a parent - should fail", func() {
+			pr := NewParser(`
+			entity sheet extends {
+				relation editor @user
+			}
+			`)
+
+			_, err := pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("2:27:expected next token to be IDENT, got LCB instead"))
+		})
+
+		It("Case 36 - Attributes with default values", func() {
+			pr := NewParser(`
+			entity document {
+				attribute is_public boolean = true
+				attribute level integer = -3
+				attribute tags string[] = ["draft", "internal"]
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			st, ok := schema.Statements[0].(*ast.EntityStatement)
+			Expect(ok).Should(BeTrue())
+			Expect(st.AttributeStatements).Should(HaveLen(3))
+
+			Expect(st.AttributeStatements[0].String()).Should(Equal("\tattribute is_public boolean = true"))
+			Expect(st.AttributeStatements[1].String()).Should(Equal("\tattribute level integer = -3"))
+			Expect(st.AttributeStatements[2].String()).Should(Equal("\tattribute tags string[] = [\"draft\", \"internal\"]"))
+
+			as, ok := st.AttributeStatements[2].(*ast.AttributeStatement)
+			Expect(ok).Should(BeTrue())
+			Expect(as.DefaultValue.IsArray).Should(BeTrue())
+			Expect(as.DefaultValue.Values).Should(HaveLen(2))
+		})
+
+		It("Case 37 - Attribute with a missing default value - should fail", func() {
+			pr := NewParser(`
+			entity document {
+				attribute is_public boolean =
+			}
+			`)
+
+			_, err := pr.Parse()
+			Expect(err).Should(HaveOccurred())
+		})
 	})
 })
@@ -1,13 +1,59 @@
 package token
 
+import (
+	"fmt"
+)
+
 // PositionInfo - represents the current position in the input source code.
 type PositionInfo struct {
+	// The file of the input source code, empty if the schema is not split into files.
+	File string
 	// The current line position in the input source code.
 	LinePosition int
 	// The current column position in the input source code.
 	ColumnPosition int
 }
 
+// String - returns the position in the form of "line:column", prefixed with the file if it is known.
+func (p PositionInfo) String() string {
+	if p.File != "" {
+		return fmt.Sprintf("%s:%v:%v", p.File, p.LinePosition, p.ColumnPosition)
+	}
+	return fmt.Sprintf("%v:%v", p.LinePosition, p.ColumnPosition)
+}
+
+// Column - returns the column of the position counted from 1. The column positions of the lexer are counted after
+// the character at the position is read, so they are one past the column of the character.
+func (p PositionInfo) Column() int {
+	return max(p.ColumnPosition-1, 1)
+}
+
+// Error - represents an error at a position in the input source code, such as the errors of the parser and of the
+// compiler, so that the position can be read without parsing the error string.
+type Error struct {
+	// The position of the error in the input source code.
+	PositionInfo PositionInfo
+	// The message of the error, without the position.
+	Message string
+	// The separator of the position and the message in the error string.
+	separator string
+}
+
+// NewError - creates a new Error rendered as "line:column:message", as the errors of the parser.
+func NewError(positionInfo PositionInfo, message string) *Error {
+	return &Error{PositionInfo: positionInfo, Message: message, separator: ":"}
+}
+
+// NewSpacedError - creates a new Error rendered as "line:column: message", as the errors of the compiler.
+func NewSpacedError(positionInfo PositionInfo, message string) *Error {
+	return &Error{PositionInfo: positionInfo, Message: message, separator: ": "}
+}
+
+// Error - returns the position followed by the message.
+func (e *Error) Error() string {
+	return e.PositionInfo.String() + e.separator + e.Message
+}
+
 // Type - defines a custom type for tokens.
 type Type string
 
@@ -40,15 +86,18 @@ func New(positionInfo PositionInfo, typ Type, ch byte) Token {
 // keywords - maps string keywords to their corresponding Type.
 var keywords = map[string]Type{
 	"entity":     ENTITY,
+	"extends":    EXTENDS,
 	"relation":   RELATION,
 	"action":     PERMISSION,
 	"permission": PERMISSION,
 	"rule":       RULE,
+	"macro":      MACRO,
 	"attribute":  ATTRIBUTE,
 	"and":        AND,
 	"or":         OR,
 	"not":        NOT,
 	"in":         IN,
+	"import":     IMPORT,
 }
 
 // ignores - maps ignored token types to an empty struct.
@@ -109,14 +158,17 @@ const (
 		Keywords
 	*/
 	ENTITY     = "ENTITY"
+	EXTENDS    = "EXTENDS"
 	RELATION   = "RELATION"
 	PERMISSION = "PERMISSION"
 	ATTRIBUTE  = "ATTRIBUTE"
 	RULE       = "RULE"
+	MACRO      = "MACRO"
 	AND        = "AND"
 	OR         = "OR"
 	NOT        = "NOT"
 	IN         = "IN"
+	IMPORT     = "IMPORT"
 
 	/*
 		Comments
@@ -1,6 +1,8 @@
 package token
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -125,4 +127,21 @@ var _ = Describe("token", func() {
 			}
 		})
 	})
+
+	Context("Error", func() {
+		It("Case 1", func() {
+			pi := PositionInfo{File: "user.perm", LinePosition: 3, ColumnPosition: 12}
+
+			err := NewError(pi, "duplication found for user")
+			Expect(err.Error()).Should(Equal("user.perm:3:12:duplication found for user"))
+			Expect(NewSpacedError(PositionInfo{LinePosition: 3, ColumnPosition: 12}, "undefined relation reference").Error()).
+				Should(Equal("3:12: undefined relation reference"))
+
+			var positioned *Error
+			Expect(errors.As(fmt.Errorf("wrapped: %w", err), &positioned)).Should(BeTrue())
+			Expect(positioned.PositionInfo).Should(Equal(pi))
+			Expect(positioned.Message).Should(Equal("duplication found for user"))
+			Expect(positioned.PositionInfo.Column()).Should(Equal(11))
+		})
+	})
 })
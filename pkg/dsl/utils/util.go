@@ -10,6 +10,7 @@ import (
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
 
+	"github.com/Permify/permify/pkg/dsl/functions"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
@@ -25,8 +26,10 @@ func Key(v1, v2 string) string {
 // ArgumentsAsCelEnv converts a map of attributes to a CEL environment.
 // It iterates through the map, retrieves the CEL type for each attribute,
 // and appends it to an array of CEL environment options.
+// The environment includes the function library the rules are compiled with.
 func ArgumentsAsCelEnv(arguments map[string]base.AttributeType) (*cel.Env, error) {
-	opts := make([]cel.EnvOption, 0, len(arguments))
+	opts := make([]cel.EnvOption, 0, len(arguments)+1)
+	opts = append(opts, functions.Library())
 	for name, typ := range arguments {
 		typ, err := GetCelType(typ)
 		if err != nil {
@@ -0,0 +1,345 @@
+package lsp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/token"
+	"github.com/Permify/permify/pkg/dsl/utils"
+)
+
+// symbol - definition of an entity, relation, attribute, permission, rule or macro in the schema.
+type symbol struct {
+	// kind - kind of the definition, e.g. entity or relation.
+	kind ast.ReferenceType
+	// key - name of entities, rules and macros, entity_name#name for the definitions inside entities.
+	key string
+	// entity - name of the entity the definition belongs to, empty for entities, rules and macros.
+	entity string
+	// name - token of the name of the definition.
+	name token.Token
+	// statement - statement of the definition, used for hover.
+	statement ast.Statement
+}
+
+// occurrence - appearance of a symbol in the schema, either its definition or a reference to it.
+type occurrence struct {
+	symbol *symbol
+	token  token.Token
+}
+
+// index - symbols of a schema and all their occurrences, built from the parsed schema.
+type index struct {
+	schema      *ast.Schema
+	symbols     map[string]*symbol
+	occurrences []occurrence
+}
+
+// newIndex - creates new index from the given schema, which may be merged from multiple files.
+func newIndex(sch *ast.Schema) *index {
+	idx := &index{
+		schema:  sch,
+		symbols: map[string]*symbol{},
+	}
+
+	// Collect the definitions first, so that the references can be resolved regardless of the order of the statements.
+	for _, st := range sch.Statements {
+		switch s := st.(type) {
+		case *ast.EntityStatement:
+			idx.define(ast.ENTITY, s.Name.Literal, "", s.Name, s)
+			for _, rs := range s.RelationStatements {
+				if r, ok := rs.(*ast.RelationStatement); ok {
+					idx.define(ast.RELATION, utils.Key(s.Name.Literal, r.Name.Literal), s.Name.Literal, r.Name, r)
+				}
+			}
+			for _, as := range s.AttributeStatements {
+				if a, ok := as.(*ast.AttributeStatement); ok {
+					idx.define(ast.ATTRIBUTE, utils.Key(s.Name.Literal, a.Name.Literal), s.Name.Literal, a.Name, a)
+				}
+			}
+			for _, ps := range s.PermissionStatements {
+				if p, ok := ps.(*ast.PermissionStatement); ok {
+					idx.define(ast.PERMISSION, utils.Key(s.Name.Literal, p.Name.Literal), s.Name.Literal, p.Name, p)
+				}
+			}
+		case *ast.RuleStatement:
+			idx.define(ast.RULE, s.Name.Literal, "", s.Name, s)
+		case *ast.MacroStatement:
+			idx.define(ast.MACRO, s.Name.Literal, "", s.Name, s)
+		}
+	}
+
+	// Resolve the references in relation types and permission expressions.
+	for _, st := range sch.Statements {
+		if ms, ok := st.(*ast.MacroStatement); ok {
+			// The identifiers of macros belong to the entities that use them, only the calls are resolved.
+			if ex, ok := ms.ExpressionStatement.(*ast.ExpressionStatement); ok {
+				idx.calls(ex.Expression)
+			}
+			continue
+		}
+
+		es, ok := st.(*ast.EntityStatement)
+		if !ok {
+			continue
+		}
+		if es.Extends.Literal != "" {
+			idx.reference(es.Extends.Literal, es.Extends)
+		}
+		for _, rs := range es.RelationStatements {
+			if r, ok := rs.(*ast.RelationStatement); ok {
+				for _, rt := range r.RelationTypes {
+					idx.reference(rt.Type.Literal, rt.Type)
+					if rt.Relation.Literal != "" {
+						idx.reference(utils.Key(rt.Type.Literal, rt.Relation.Literal), rt.Relation)
+					}
+				}
+			}
+		}
+		for _, ps := range es.PermissionStatements {
+			if p, ok := ps.(*ast.PermissionStatement); ok {
+				if ex, ok := p.ExpressionStatement.(*ast.ExpressionStatement); ok {
+					idx.expression(es.Name.Literal, ex.Expression)
+				}
+			}
+		}
+	}
+
+	return idx
+}
+
+// define - adds a definition to the index.
+func (idx *index) define(kind ast.ReferenceType, key, entity string, name token.Token, st ast.Statement) {
+	sym := &symbol{kind: kind, key: key, entity: entity, name: name, statement: st}
+	idx.symbols[key] = sym
+	idx.occurrences = append(idx.occurrences, occurrence{symbol: sym, token: name})
+}
+
+// reference - adds a reference to the symbol with the given key, unknown symbols are ignored.
+func (idx *index) reference(key string, tkn token.Token) {
+	if sym, ok := idx.symbols[key]; ok {
+		idx.occurrences = append(idx.occurrences, occurrence{symbol: sym, token: tkn})
+	}
+}
+
+// expression - adds the references in a permission expression of the given entity.
+func (idx *index) expression(entity string, expression ast.Expression) {
+	switch e := expression.(type) {
+	case *ast.InfixExpression:
+		idx.expression(entity, e.Left)
+		idx.expression(entity, e.Right)
+	case *ast.Identifier:
+		idx.identifier(entity, e)
+	case *ast.Call:
+		idx.reference(e.Name.Literal, e.Name)
+		for i := range e.Arguments {
+			idx.identifier(entity, &e.Arguments[i])
+		}
+	}
+}
+
+// calls - adds the references to the rules and macros called in an expression.
+func (idx *index) calls(expression ast.Expression) {
+	switch e := expression.(type) {
+	case *ast.InfixExpression:
+		idx.calls(e.Left)
+		idx.calls(e.Right)
+	case *ast.Call:
+		idx.reference(e.Name.Literal, e.Name)
+	}
+}
+
+// identifier - adds the references in an identifier such as "owner" or "parent.admin".
+func (idx *index) identifier(entity string, ident *ast.Identifier) {
+	if len(ident.Idents) == 0 {
+		return
+	}
+
+	first := ident.Idents[0]
+	idx.reference(idx.member(entity, first.Literal), first)
+
+	if len(ident.Idents) < 2 {
+		return
+	}
+
+	// The second part is defined in one of the entity types of the relation.
+	second := ident.Idents[1]
+	for _, typ := range idx.relationTypes(idx.member(entity, first.Literal)) {
+		key := idx.member(typ, second.Literal)
+		if _, ok := idx.symbols[key]; ok {
+			idx.reference(key, second)
+			return
+		}
+	}
+}
+
+// member - returns the key of the member of the given entity with the given name, which may be inherited from one
+// of the parents of the entity.
+func (idx *index) member(entity, name string) string {
+	seen := map[string]bool{}
+	for e := entity; e != "" && !seen[e]; {
+		seen[e] = true
+		key := utils.Key(e, name)
+		if _, ok := idx.symbols[key]; ok {
+			return key
+		}
+		sym, ok := idx.symbols[e]
+		if !ok {
+			break
+		}
+		es, ok := sym.statement.(*ast.EntityStatement)
+		if !ok {
+			break
+		}
+		e = es.Extends.Literal
+	}
+	return utils.Key(entity, name)
+}
+
+// relationTypes - returns the entity types of the relation with the given key.
+func (idx *index) relationTypes(key string) []string {
+	types, ok := idx.schema.GetReferences().GetRelationReferenceTypesIfExist(key)
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, t.Type.Literal)
+	}
+	return names
+}
+
+// at - returns the occurrence at the given zero based position of the given file.
+func (idx *index) at(file string, pos Position) (occurrence, bool) {
+	for _, o := range idx.occurrences {
+		r := tokenRange(o.token)
+		if o.token.PositionInfo.File == file && r.Start.Line == pos.Line && r.Start.Character <= pos.Character && pos.Character <= r.End.Character {
+			return o, true
+		}
+	}
+	return occurrence{}, false
+}
+
+// references - returns all occurrences of the given symbol.
+func (idx *index) references(sym *symbol, includeDefinition bool) []occurrence {
+	var result []occurrence
+	for _, o := range idx.occurrences {
+		if o.symbol != sym {
+			continue
+		}
+		if !includeDefinition && o.token == sym.name {
+			continue
+		}
+		result = append(result, o)
+	}
+	return result
+}
+
+// entityAt - returns the name of the entity whose definition encloses the given line of the given file.
+func (idx *index) entityAt(file string, line int) string {
+	entity := ""
+	start := -1
+	for _, sym := range idx.symbols {
+		if sym.kind != ast.ENTITY || sym.name.PositionInfo.File != file {
+			continue
+		}
+		l := tokenRange(sym.name).Start.Line
+		if l <= line && l > start {
+			entity, start = sym.key, l
+		}
+	}
+	return entity
+}
+
+// members - returns the relations, attributes and permissions of the given entity.
+func (idx *index) members(entity string) []*symbol {
+	var result []*symbol
+	for _, sym := range idx.symbols {
+		if sym.entity == entity {
+			result = append(result, sym)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].key < result[j].key })
+	return result
+}
+
+// entities - returns all entities of the schema.
+func (idx *index) entities() []*symbol {
+	var result []*symbol
+	for _, sym := range idx.symbols {
+		if sym.kind == ast.ENTITY {
+			result = append(result, sym)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].key < result[j].key })
+	return result
+}
+
+// hover - returns the markdown description of the given symbol.
+func (sym *symbol) hover() string {
+	var sb strings.Builder
+	sb.WriteString("```perm\n")
+	switch s := sym.statement.(type) {
+	case *ast.EntityStatement:
+		sb.WriteString(fmt.Sprintf("entity %s", s.Name.Literal))
+		if s.Extends.Literal != "" {
+			sb.WriteString(fmt.Sprintf(" extends %s", s.Extends.Literal))
+		}
+	case *ast.RelationStatement:
+		sb.WriteString(s.String())
+	case *ast.AttributeStatement:
+		sb.WriteString(s.String())
+	case *ast.PermissionStatement:
+		sb.WriteString(s.String())
+	case *ast.RuleStatement:
+		sb.WriteString(s.String())
+	case *ast.MacroStatement:
+		sb.WriteString(s.String())
+	}
+	sb.WriteString("\n```")
+	if sym.entity != "" {
+		sb.WriteString(fmt.Sprintf("\n\n%s of entity `%s`", sym.kind, sym.entity))
+	}
+	if sym.kind == ast.RELATION {
+		if types := relationSubjectTypes(sym.statement); types != "" {
+			sb.WriteString(fmt.Sprintf("\n\nsubject types: %s", types))
+		}
+	}
+	return sb.String()
+}
+
+// relationSubjectTypes - returns the subject types of a relation statement, e.g. "`user`, `organization#member`".
+func relationSubjectTypes(st ast.Statement) string {
+	r, ok := st.(*ast.RelationStatement)
+	if !ok {
+		return ""
+	}
+	types := make([]string, 0, len(r.RelationTypes))
+	for _, rt := range r.RelationTypes {
+		if rt.Relation.Literal != "" {
+			types = append(types, fmt.Sprintf("`%s#%s`", rt.Type.Literal, rt.Relation.Literal))
+		} else {
+			types = append(types, fmt.Sprintf("`%s`", rt.Type.Literal))
+		}
+	}
+	return strings.Join(types, ", ")
+}
+
+// tokenRange - returns the zero based range of the given token. Columns of the lexer start at 2.
+func tokenRange(tkn token.Token) Range {
+	line := tkn.PositionInfo.LinePosition - 1
+	start := tkn.PositionInfo.ColumnPosition - 2
+	if line < 0 {
+		line = 0
+	}
+	if start < 0 {
+		start = 0
+	}
+	return Range{
+		Start: Position{Line: line, Character: start},
+		End:   Position{Line: line, Character: start + len(tkn.Literal)},
+	}
+}
@@ -0,0 +1,527 @@
+package lsp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	"github.com/Permify/permify/pkg/dsl/token"
+	"github.com/Permify/permify/pkg/dsl/utils"
+	"github.com/Permify/permify/pkg/schema"
+)
+
+var (
+	// identifier - valid names of entities, relations, attributes, permissions and rules.
+	identifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// completion contexts, matched against the text of the line before the cursor.
+	afterRelationType = regexp.MustCompile(`@([a-zA-Z_][a-zA-Z0-9_]*)#[a-zA-Z0-9_]*$`)
+	afterAt           = regexp.MustCompile(`@[a-zA-Z0-9_]*$`)
+	afterDot          = regexp.MustCompile(`([a-zA-Z_][a-zA-Z0-9_]*)\.[a-zA-Z0-9_]*$`)
+
+	keywords = []string{"entity", "extends", "relation", "attribute", "permission", "action", "rule", "macro", "import", "and", "or", "not"}
+)
+
+// Server - language server for the Permify schema language. It keeps the open documents in memory and
+// analyzes them with the parser and the compiler on every change.
+type Server struct {
+	conn    *conn
+	name    string
+	version string
+
+	// documents - content of the open documents by their file names.
+	documents map[string]string
+	// uris - uris of the open documents by their file names.
+	uris map[string]string
+	// indexes - index of the last successfully parsed state of the open documents by their file names,
+	// so that navigation and completion keep working while the document is being edited.
+	indexes map[string]*index
+
+	shutdown bool
+}
+
+// NewServer - creates new language server.
+func NewServer(name, version string) *Server {
+	return &Server{
+		name:      name,
+		version:   version,
+		documents: map[string]string{},
+		uris:      map[string]string{},
+		indexes:   map[string]*index{},
+	}
+}
+
+// Run - serves the language server protocol on the given reader and writer, e.g. stdin and stdout,
+// until the client sends the exit notification or closes the input.
+func (s *Server) Run(r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+
+	for {
+		req, err := s.conn.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				if err = s.conn.write(response{JSONRPC: "2.0", Error: &responseError{Code: codeParseError, Message: err.Error()}}); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		if req.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("exit without shutdown")
+			}
+			return nil
+		}
+
+		result, rerr := s.handle(req)
+
+		// notifications are not answered
+		if req.ID == nil {
+			continue
+		}
+
+		if err = s.conn.write(response{JSONRPC: "2.0", ID: req.ID, Result: result, Error: rerr}); err != nil {
+			return err
+		}
+	}
+}
+
+// handle - dispatches the request to its handler.
+func (s *Server) handle(req *request) (interface{}, *responseError) {
+	var (
+		result interface{}
+		err    error
+	)
+
+	switch req.Method {
+	case "initialize":
+		result = s.initialize()
+	case "initialized":
+	case "shutdown":
+		s.shutdown = true
+	case "textDocument/didOpen":
+		params := DidOpenTextDocumentParams{}
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			s.open(params.TextDocument.URI, params.TextDocument.Text)
+		}
+	case "textDocument/didChange":
+		params := DidChangeTextDocumentParams{}
+		if err = json.Unmarshal(req.Params, &params); err == nil && len(params.ContentChanges) > 0 {
+			s.open(params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+		}
+	case "textDocument/didClose":
+		params := DidCloseTextDocumentParams{}
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			s.close(params.TextDocument.URI)
+		}
+	case "textDocument/definition":
+		params := TextDocumentPositionParams{}
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = s.definition(params)
+		}
+	case "textDocument/references":
+		params := ReferenceParams{}
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = s.references(params)
+		}
+	case "textDocument/hover":
+		params := TextDocumentPositionParams{}
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = s.hover(params)
+		}
+	case "textDocument/completion":
+		params := TextDocumentPositionParams{}
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = s.completion(params)
+		}
+	case "textDocument/rename":
+		params := RenameParams{}
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result, err = s.rename(params)
+			if err != nil {
+				return nil, &responseError{Code: codeRequestFailed, Message: err.Error()}
+			}
+		}
+	default:
+		if req.ID != nil {
+			return nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", req.Method)}
+		}
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+
+	return result, nil
+}
+
+// initialize - returns the capabilities of the server.
+func (s *Server) initialize() InitializeResult {
+	return InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync:   textDocumentSyncFull,
+			DefinitionProvider: true,
+			ReferencesProvider: true,
+			HoverProvider:      true,
+			RenameProvider:     true,
+			CompletionProvider: CompletionOptions{
+				TriggerCharacters: []string{".", "@", "#"},
+			},
+		},
+		ServerInfo: ServerInfo{
+			Name:    s.name,
+			Version: s.version,
+		},
+	}
+}
+
+// open - stores the content of the document and analyzes all open documents, since a document may be
+// imported by the others.
+func (s *Server) open(uri, text string) {
+	name := uriToName(uri)
+	s.documents[name] = text
+	s.uris[name] = uri
+	s.analyzeAll()
+}
+
+// close - removes the document and clears its diagnostics.
+func (s *Server) close(uri string) {
+	name := uriToName(uri)
+	delete(s.documents, name)
+	delete(s.uris, name)
+	delete(s.indexes, name)
+	s.publish(uri, []Diagnostic{})
+	s.analyzeAll()
+}
+
+// analyzeAll - analyzes the open documents and publishes their diagnostics.
+func (s *Server) analyzeAll() {
+	names := make([]string, 0, len(s.documents))
+	for name := range s.documents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		s.publish(s.uris[name], s.analyze(name))
+	}
+}
+
+// analyze - parses the document together with its imports, compiles it and updates its index.
+// It returns the diagnostics of the document.
+func (s *Server) analyze(name string) []Diagnostic {
+	files := map[string]string{}
+	s.collect(name, s.documents[name], files)
+
+	modules, err := schema.NewInlineSchemaLoader().ResolveModules(schema.Module{Name: name, Content: s.documents[name]}, files)
+	if err != nil {
+		return []Diagnostic{s.diagnostic(name, err)}
+	}
+
+	sch, err := schema.Parse(modules...)
+	if err != nil {
+		return []Diagnostic{s.diagnostic(name, err)}
+	}
+
+	s.indexes[name] = newIndex(sch)
+
+	if _, _, err = compiler.NewCompiler(true, sch).Compile(); err != nil {
+		return []Diagnostic{s.diagnostic(name, err)}
+	}
+
+	return []Diagnostic{}
+}
+
+// collect - adds the files imported by the given file to the files recursively. Open documents are preferred
+// over the files on the disk, so that unsaved changes are taken into account.
+func (s *Server) collect(name, content string, files map[string]string) {
+	sch, err := parser.NewFileParser(name, content).Parse()
+	if err != nil {
+		return
+	}
+
+	for _, imp := range sch.Imports {
+		if path.IsAbs(imp.Path.Literal) || strings.Contains(imp.Path.Literal, "://") {
+			continue
+		}
+
+		imported := path.Join(path.Dir(name), imp.Path.Literal)
+		if _, ok := files[imported]; ok {
+			continue
+		}
+
+		text, ok := s.documents[imported]
+		if !ok {
+			b, err := os.ReadFile(imported)
+			if err != nil {
+				continue
+			}
+			text = string(b)
+		}
+
+		files[imported] = text
+		s.collect(imported, text, files)
+	}
+}
+
+// diagnostic - converts the error of the parser or the compiler to a diagnostic of the given document.
+// Errors of imported files are reported at the beginning of the document.
+func (s *Server) diagnostic(name string, err error) Diagnostic {
+	d := Diagnostic{
+		Severity: SeverityError,
+		Source:   "permify",
+		Message:  err.Error(),
+	}
+
+	var positioned *token.Error
+	if !errors.As(err, &positioned) {
+		return d
+	}
+
+	pi := positioned.PositionInfo
+	if pi.File != "" && pi.File != name {
+		d.Message = fmt.Sprintf("%s: %s", pi.File, strings.TrimPrefix(err.Error(), pi.File+":"))
+		return d
+	}
+
+	d.Message = positioned.Message
+	d.Range = wordRange(s.documents[name], pi.LinePosition-1, pi.Column()-1)
+	return d
+}
+
+// publish - sends the diagnostics of the document to the client.
+func (s *Server) publish(uri string, diagnostics []Diagnostic) {
+	_ = s.conn.write(notification{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params:  PublishDiagnosticsParams{URI: uri, Diagnostics: diagnostics},
+	})
+}
+
+// lookup - returns the index of the document and the occurrence at the given position.
+func (s *Server) lookup(params TextDocumentPositionParams) (*index, occurrence, bool) {
+	name := uriToName(params.TextDocument.URI)
+	idx, ok := s.indexes[name]
+	if !ok {
+		return nil, occurrence{}, false
+	}
+	o, ok := idx.at(name, params.Position)
+	return idx, o, ok
+}
+
+// definition - returns the location of the definition of the symbol at the given position.
+func (s *Server) definition(params TextDocumentPositionParams) interface{} {
+	_, o, ok := s.lookup(params)
+	if !ok {
+		return nil
+	}
+	return s.location(o.symbol.name.PositionInfo.File, tokenRange(o.symbol.name))
+}
+
+// references - returns the locations of all occurrences of the symbol at the given position.
+func (s *Server) references(params ReferenceParams) []Location {
+	idx, o, ok := s.lookup(params.TextDocumentPositionParams)
+	if !ok {
+		return []Location{}
+	}
+
+	locations := []Location{}
+	for _, ref := range idx.references(o.symbol, params.Context.IncludeDeclaration) {
+		locations = append(locations, s.location(ref.token.PositionInfo.File, tokenRange(ref.token)))
+	}
+	return locations
+}
+
+// hover - returns the definition of the symbol at the given position, including the subject types of relations.
+func (s *Server) hover(params TextDocumentPositionParams) interface{} {
+	_, o, ok := s.lookup(params)
+	if !ok {
+		return nil
+	}
+	r := tokenRange(o.token)
+	return Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: o.symbol.hover()},
+		Range:    &r,
+	}
+}
+
+// completion - returns the entities after "@", the relations of the entity after "@entity#" and the
+// members of the subject types of a relation after "relation.". Otherwise, the keywords and the members of the
+// enclosing entity are returned.
+func (s *Server) completion(params TextDocumentPositionParams) []CompletionItem {
+	name := uriToName(params.TextDocument.URI)
+	items := []CompletionItem{}
+
+	idx, ok := s.indexes[name]
+	if !ok {
+		for _, k := range keywords {
+			items = append(items, CompletionItem{Label: k, Kind: CompletionKindKeyword})
+		}
+		return items
+	}
+
+	prefix := linePrefix(s.documents[name], params.Position)
+
+	if m := afterRelationType.FindStringSubmatch(prefix); m != nil {
+		for _, sym := range idx.members(m[1]) {
+			if sym.kind == ast.RELATION {
+				items = append(items, sym.completion())
+			}
+		}
+		return items
+	}
+
+	if afterAt.MatchString(prefix) {
+		for _, sym := range idx.entities() {
+			items = append(items, sym.completion())
+		}
+		return items
+	}
+
+	entity := idx.entityAt(name, params.Position.Line)
+
+	if m := afterDot.FindStringSubmatch(prefix); m != nil {
+		seen := map[string]bool{}
+		for _, typ := range idx.relationTypes(utils.Key(entity, m[1])) {
+			for _, sym := range idx.members(typ) {
+				if sym.kind == ast.ATTRIBUTE || seen[sym.name.Literal] {
+					continue
+				}
+				seen[sym.name.Literal] = true
+				items = append(items, sym.completion())
+			}
+		}
+		return items
+	}
+
+	for _, k := range keywords {
+		items = append(items, CompletionItem{Label: k, Kind: CompletionKindKeyword})
+	}
+	for _, sym := range idx.members(entity) {
+		items = append(items, sym.completion())
+	}
+	for _, sym := range idx.symbols {
+		if sym.kind == ast.RULE || sym.kind == ast.MACRO {
+			items = append(items, sym.completion())
+		}
+	}
+	return items
+}
+
+// rename - renames the symbol at the given position and all its references, which may be in multiple files.
+func (s *Server) rename(params RenameParams) (interface{}, error) {
+	if !identifier.MatchString(params.NewName) {
+		return nil, fmt.Errorf("invalid name: %s", params.NewName)
+	}
+
+	idx, o, ok := s.lookup(params.TextDocumentPositionParams)
+	if !ok {
+		return nil, nil
+	}
+
+	edit := WorkspaceEdit{Changes: map[string][]TextEdit{}}
+	for _, ref := range idx.references(o.symbol, true) {
+		uri := s.uri(ref.token.PositionInfo.File)
+		edit.Changes[uri] = append(edit.Changes[uri], TextEdit{Range: tokenRange(ref.token), NewText: params.NewName})
+	}
+	return edit, nil
+}
+
+// location - returns the location in the file with the given name.
+func (s *Server) location(name string, r Range) Location {
+	return Location{URI: s.uri(name), Range: r}
+}
+
+// uri - returns the uri of the file with the given name.
+func (s *Server) uri(name string) string {
+	if uri, ok := s.uris[name]; ok {
+		return uri
+	}
+	return (&url.URL{Scheme: "file", Path: name}).String()
+}
+
+// completion - returns the completion item of the symbol.
+func (sym *symbol) completion() CompletionItem {
+	item := CompletionItem{Label: sym.name.Literal, Detail: string(sym.kind)}
+	switch sym.kind {
+	case ast.ENTITY:
+		item.Kind = CompletionKindClass
+	case ast.RELATION:
+		item.Kind = CompletionKindField
+		item.Detail = fmt.Sprintf("relation %s", relationSubjectTypes(sym.statement))
+	case ast.ATTRIBUTE, ast.PERMISSION:
+		item.Kind = CompletionKindProperty
+	case ast.RULE, ast.MACRO:
+		item.Kind = CompletionKindFunction
+	}
+	return item
+}
+
+// uriToName - returns the file name of the given uri, uris other than file uris are used as they are.
+func uriToName(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	return u.Path
+}
+
+// linePrefix - returns the text of the line before the given position.
+func linePrefix(text string, pos Position) string {
+	lines := strings.Split(text, "\n")
+	if pos.Line < 0 || pos.Line >= len(lines) {
+		return ""
+	}
+	line := lines[pos.Line]
+	if pos.Character < len(line) {
+		line = line[:pos.Character]
+	}
+	return line
+}
+
+// wordRange - returns the range of the word starting at the given position, or a single character range
+// if there is no word at the position.
+func wordRange(text string, line, character int) Range {
+	if line < 0 {
+		line = 0
+	}
+	if character < 0 {
+		character = 0
+	}
+
+	end := character + 1
+	lines := strings.Split(text, "\n")
+	if line < len(lines) {
+		l := lines[line]
+		for i := character; i < len(l) && (l[i] == '_' || isAlphaNumeric(l[i])); i++ {
+			end = i + 1
+		}
+	}
+
+	return Range{
+		Start: Position{Line: line, Character: character},
+		End:   Position{Line: line, Character: end},
+	}
+}
+
+// isAlphaNumeric - returns true if the byte is a letter or a digit.
+func isAlphaNumeric(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
@@ -0,0 +1,238 @@
+package lsp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const uri = "file:///workspace/schema.perm"
+
+const document = `entity user {}
+
+entity organization {
+    relation admin @user
+    relation member @user
+}
+
+entity repository {
+    relation parent @organization
+    relation owner @user
+    permission edit = owner or parent.admin
+}
+`
+
+// session - sends the given messages to a new server and returns the messages written by the server.
+func session(messages ...interface{}) []map[string]json.RawMessage {
+	input := &bytes.Buffer{}
+	for _, m := range messages {
+		b, err := json.Marshal(m)
+		Expect(err).ShouldNot(HaveOccurred())
+		fmt.Fprintf(input, "Content-Length: %d\r\n\r\n%s", len(b), b)
+	}
+
+	output := &bytes.Buffer{}
+	Expect(NewServer("permify", "test").Run(input, output)).Should(Succeed())
+
+	reader := newConn(output, nil)
+	var result []map[string]json.RawMessage
+	for {
+		header, err := reader.reader.ReadMIMEHeader()
+		if err != nil {
+			break
+		}
+		length, err := strconv.Atoi(header.Get("Content-Length"))
+		Expect(err).ShouldNot(HaveOccurred())
+		body := make([]byte, length)
+		_, err = io.ReadFull(reader.reader.R, body)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		msg := map[string]json.RawMessage{}
+		Expect(json.Unmarshal(body, &msg)).Should(Succeed())
+		result = append(result, msg)
+	}
+	return result
+}
+
+// call - returns a request with the given id.
+func call(id int, method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": params}
+}
+
+// notify - returns a notification.
+func notify(method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+}
+
+// open - returns the didOpen notification of the document with the given text.
+func open(text string) map[string]interface{} {
+	return notify("textDocument/didOpen", DidOpenTextDocumentParams{TextDocument: TextDocumentItem{URI: uri, LanguageID: "perm", Text: text}})
+}
+
+// position - returns the position params in the document.
+func position(line, character int) TextDocumentPositionParams {
+	return TextDocumentPositionParams{TextDocument: TextDocumentIdentifier{URI: uri}, Position: Position{Line: line, Character: character}}
+}
+
+// result - decodes the result of the response with the given id.
+func result(messages []map[string]json.RawMessage, id int, v interface{}) {
+	for _, m := range messages {
+		if string(m["id"]) == fmt.Sprint(id) {
+			Expect(m).ShouldNot(HaveKey("error"))
+			Expect(json.Unmarshal(m["result"], v)).Should(Succeed())
+			return
+		}
+	}
+	Fail(fmt.Sprintf("response %d not found", id))
+}
+
+// diagnostics - returns the last diagnostics published for the document.
+func diagnostics(messages []map[string]json.RawMessage) []Diagnostic {
+	var params PublishDiagnosticsParams
+	for _, m := range messages {
+		if string(m["method"]) == `"textDocument/publishDiagnostics"` {
+			Expect(json.Unmarshal(m["params"], &params)).Should(Succeed())
+		}
+	}
+	return params.Diagnostics
+}
+
+var _ = Describe("server", func() {
+	Context("lifecycle", func() {
+		It("should initialize and shutdown", func() {
+			messages := session(
+				call(1, "initialize", map[string]interface{}{}),
+				notify("initialized", map[string]interface{}{}),
+				call(2, "shutdown", nil),
+				notify("exit", nil),
+			)
+
+			var init InitializeResult
+			result(messages, 1, &init)
+			Expect(init.Capabilities.TextDocumentSync).Should(Equal(textDocumentSyncFull))
+			Expect(init.Capabilities.CompletionProvider.TriggerCharacters).Should(ContainElements(".", "@"))
+			Expect(init.ServerInfo.Name).Should(Equal("permify"))
+		})
+
+		It("should return method not found for unknown requests", func() {
+			messages := session(call(1, "workspace/symbol", map[string]interface{}{}))
+
+			Expect(messages).Should(HaveLen(1))
+			Expect(string(messages[0]["error"])).Should(ContainSubstring(fmt.Sprint(codeMethodNotFound)))
+		})
+	})
+
+	Context("diagnostics", func() {
+		It("should publish no diagnostics for a valid schema", func() {
+			Expect(diagnostics(session(open(document)))).Should(BeEmpty())
+		})
+
+		It("should publish parse errors with their ranges", func() {
+			d := diagnostics(session(open("entity user {}\n\nentity organization {\n    relation admin user\n}\n")))
+
+			Expect(d).Should(HaveLen(1))
+			Expect(d[0].Severity).Should(Equal(SeverityError))
+			Expect(d[0].Range.Start.Line).Should(Equal(3))
+			Expect(d[0].Message).Should(ContainSubstring("expected next token to be SIGN"))
+		})
+
+		It("should publish compile errors with their ranges", func() {
+			d := diagnostics(session(open("entity user {}\n\nentity organization {\n    relation admin @team\n}\n")))
+
+			Expect(d).Should(HaveLen(1))
+			Expect(d[0].Range).Should(Equal(Range{Start: Position{Line: 3, Character: 20}, End: Position{Line: 3, Character: 24}}))
+			Expect(d[0].Message).Should(ContainSubstring("relation reference not found"))
+		})
+	})
+
+	Context("navigation", func() {
+		It("should find the definition of a relation of another entity", func() {
+			var location Location
+			result(session(open(document), call(1, "textDocument/definition", position(10, 40))), 1, &location)
+
+			Expect(location).Should(Equal(Location{URI: uri, Range: Range{Start: Position{Line: 3, Character: 13}, End: Position{Line: 3, Character: 18}}}))
+		})
+
+		It("should find the definition of an entity type", func() {
+			var location Location
+			result(session(open(document), call(1, "textDocument/definition", position(8, 22))), 1, &location)
+
+			Expect(location.Range.Start).Should(Equal(Position{Line: 2, Character: 7}))
+		})
+
+		It("should find the references of a relation", func() {
+			params := ReferenceParams{TextDocumentPositionParams: position(3, 15)}
+			params.Context.IncludeDeclaration = true
+
+			var locations []Location
+			result(session(open(document), call(1, "textDocument/references", params)), 1, &locations)
+
+			Expect(locations).Should(HaveLen(2))
+			Expect(locations[1].Range.Start).Should(Equal(Position{Line: 10, Character: 38}))
+		})
+
+		It("should show the subject types of a relation on hover", func() {
+			var hover Hover
+			result(session(open(document), call(1, "textDocument/hover", position(8, 14))), 1, &hover)
+
+			Expect(hover.Contents.Value).Should(ContainSubstring("relation parent @organization"))
+			Expect(hover.Contents.Value).Should(ContainSubstring("subject types: `organization`"))
+		})
+	})
+
+	Context("completion", func() {
+		It("should complete entity names after @ while the document has syntax errors", func() {
+			var items []CompletionItem
+			text := strings.Replace(document, "relation owner @user", "relation owner @", 1)
+			change := notify("textDocument/didChange", DidChangeTextDocumentParams{
+				TextDocument:   TextDocumentIdentifier{URI: uri},
+				ContentChanges: []TextDocumentContentChangeEvent{{Text: text}},
+			})
+			result(session(open(document), change, call(1, "textDocument/completion", position(9, 20))), 1, &items)
+
+			var labels []string
+			for _, item := range items {
+				labels = append(labels, item.Label)
+			}
+			Expect(labels).Should(Equal([]string{"organization", "repository", "user"}))
+		})
+
+		It("should complete the members of the subject type after .", func() {
+			var items []CompletionItem
+			result(session(open(document), call(1, "textDocument/completion", position(10, 38))), 1, &items)
+
+			var labels []string
+			for _, item := range items {
+				labels = append(labels, item.Label)
+			}
+			Expect(labels).Should(Equal([]string{"admin", "member"}))
+		})
+	})
+
+	Context("rename", func() {
+		It("should rename the definition and all references", func() {
+			params := RenameParams{TextDocumentPositionParams: position(10, 39), NewName: "manager"}
+
+			var edit WorkspaceEdit
+			result(session(open(document), call(1, "textDocument/rename", params)), 1, &edit)
+
+			Expect(edit.Changes[uri]).Should(ConsistOf(
+				TextEdit{Range: Range{Start: Position{Line: 3, Character: 13}, End: Position{Line: 3, Character: 18}}, NewText: "manager"},
+				TextEdit{Range: Range{Start: Position{Line: 10, Character: 38}, End: Position{Line: 10, Character: 43}}, NewText: "manager"},
+			))
+		})
+
+		It("should reject invalid names", func() {
+			params := RenameParams{TextDocumentPositionParams: position(10, 39), NewName: "not valid"}
+			messages := session(open(document), call(1, "textDocument/rename", params))
+
+			Expect(string(messages[len(messages)-1]["error"])).Should(ContainSubstring("invalid name"))
+		})
+	})
+})
@@ -1523,6 +1523,114 @@ var _ interface {
 
 var _RuleDefinition_Name_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
 
+// Validate checks the field values on SchemaWarning with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaWarning) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaWarning with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaWarningMultiError, or
+// nil if none found.
+func (m *SchemaWarning) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaWarning) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rule
+
+	// no validation rules for Definition
+
+	// no validation rules for Name
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return SchemaWarningMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaWarningMultiError is an error wrapping multiple validation errors
+// returned by SchemaWarning.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaWarningMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaWarningMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaWarningMultiError) AllErrors() []error { return m }
+
+// SchemaWarningValidationError is the validation error returned by
+// SchemaWarning.Validate if the designated constraints aren't met.
+type SchemaWarningValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaWarningValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaWarningValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaWarningValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaWarningValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaWarningValidationError) ErrorName() string { return "SchemaWarningValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaWarningValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaWarning.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaWarningValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaWarningValidationError{}
+
 // Validate checks the field values on AttributeDefinition with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1569,6 +1677,35 @@ func (m *AttributeDefinition) validate(all bool) error {
 
 	// no validation rules for Type
 
+	if all {
+		switch v := interface{}(m.GetDefaultValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeDefinitionValidationError{
+					field:  "DefaultValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeDefinitionValidationError{
+					field:  "DefaultValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefaultValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeDefinitionValidationError{
+				field:  "DefaultValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return AttributeDefinitionMultiError(errors)
 	}
@@ -2331,6 +2468,47 @@ func (m *Argument) validate(all bool) error {
 			}
 		}
 
+	case *Argument_TupleToComputedAttribute:
+		if v == nil {
+			err := ArgumentValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTupleToComputedAttribute()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ArgumentValidationError{
+						field:  "TupleToComputedAttribute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ArgumentValidationError{
+						field:  "TupleToComputedAttribute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTupleToComputedAttribute()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ArgumentValidationError{
+					field:  "TupleToComputedAttribute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -2954,6 +3132,166 @@ var _ interface {
 	ErrorName() string
 } = TupleToUserSetValidationError{}
 
+// Validate checks the field values on TupleToComputedAttribute with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TupleToComputedAttribute) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TupleToComputedAttribute with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TupleToComputedAttributeMultiError, or nil if none found.
+func (m *TupleToComputedAttribute) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TupleToComputedAttribute) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTupleSet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TupleToComputedAttributeValidationError{
+					field:  "TupleSet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TupleToComputedAttributeValidationError{
+					field:  "TupleSet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTupleSet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TupleToComputedAttributeValidationError{
+				field:  "TupleSet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetComputed()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TupleToComputedAttributeValidationError{
+					field:  "Computed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TupleToComputedAttributeValidationError{
+					field:  "Computed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComputed()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TupleToComputedAttributeValidationError{
+				field:  "Computed",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TupleToComputedAttributeMultiError(errors)
+	}
+
+	return nil
+}
+
+// TupleToComputedAttributeMultiError is an error wrapping multiple validation
+// errors returned by TupleToComputedAttribute.ValidateAll() if the designated
+// constraints aren't met.
+type TupleToComputedAttributeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TupleToComputedAttributeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TupleToComputedAttributeMultiError) AllErrors() []error { return m }
+
+// TupleToComputedAttributeValidationError is the validation error returned by
+// TupleToComputedAttribute.Validate if the designated constraints aren't met.
+type TupleToComputedAttributeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TupleToComputedAttributeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TupleToComputedAttributeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TupleToComputedAttributeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TupleToComputedAttributeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TupleToComputedAttributeValidationError) ErrorName() string {
+	return "TupleToComputedAttributeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TupleToComputedAttributeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTupleToComputedAttribute.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TupleToComputedAttributeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TupleToComputedAttributeValidationError{}
+
 // Validate checks the field values on TupleSet with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -5703,6 +6041,66 @@ func (m *Tenant) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Labels
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeletion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "Deletion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "Deletion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantValidationError{
+				field:  "Deletion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return TenantMultiError(errors)
 	}
@@ -5780,6 +6178,242 @@ var _ interface {
 	ErrorName() string
 } = TenantValidationError{}
 
+// Validate checks the field values on TenantDeletion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantDeletion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantDeletion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantDeletionMultiError,
+// or nil if none found.
+func (m *TenantDeletion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantDeletion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantDeletionValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantDeletionValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantDeletionValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DeletedRows
+
+	// no validation rules for Table
+
+	if len(errors) > 0 {
+		return TenantDeletionMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantDeletionMultiError is an error wrapping multiple validation errors
+// returned by TenantDeletion.ValidateAll() if the designated constraints
+// aren't met.
+type TenantDeletionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantDeletionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantDeletionMultiError) AllErrors() []error { return m }
+
+// TenantDeletionValidationError is the validation error returned by
+// TenantDeletion.Validate if the designated constraints aren't met.
+type TenantDeletionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantDeletionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantDeletionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantDeletionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantDeletionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantDeletionValidationError) ErrorName() string { return "TenantDeletionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantDeletionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantDeletion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantDeletionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantDeletionValidationError{}
+
+// Validate checks the field values on TenantFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantFilterMultiError, or
+// nil if none found.
+func (m *TenantFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Labels
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return TenantFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantFilterMultiError is an error wrapping multiple validation errors
+// returned by TenantFilter.ValidateAll() if the designated constraints aren't met.
+type TenantFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantFilterMultiError) AllErrors() []error { return m }
+
+// TenantFilterValidationError is the validation error returned by
+// TenantFilter.Validate if the designated constraints aren't met.
+type TenantFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantFilterValidationError) ErrorName() string { return "TenantFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantFilterValidationError{}
+
 // Validate checks the field values on DataChanges with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -3753,6 +3753,8 @@ func (m *SchemaWriteRequest) validate(all bool) error {
 
 	// no validation rules for Schema
 
+	// no validation rules for Files
+
 	if len(errors) > 0 {
 		return SchemaWriteRequestMultiError(errors)
 	}
@@ -3859,6 +3861,40 @@ func (m *SchemaWriteResponse) validate(all bool) error {
 
 	// no validation rules for SchemaVersion
 
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaWriteResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaWriteResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaWriteResponseValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SchemaWriteResponseMultiError(errors)
 	}
@@ -7004,22 +7040,22 @@ var _ interface {
 	ErrorName() string
 } = AttributeReadResponseValidationError{}
 
-// Validate checks the field values on DataDeleteRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DataDeleteRequest) Validate() error {
+// Validate checks the field values on RelationshipGraphRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipGraphRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataDeleteRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RelationshipGraphRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataDeleteRequestMultiError, or nil if none found.
-func (m *DataDeleteRequest) ValidateAll() error {
+// RelationshipGraphRequestMultiError, or nil if none found.
+func (m *RelationshipGraphRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataDeleteRequest) validate(all bool) error {
+func (m *RelationshipGraphRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7027,7 +7063,7 @@ func (m *DataDeleteRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 128 {
-		err := DataDeleteRequestValidationError{
+		err := RelationshipGraphRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 128 bytes",
 		}
@@ -7037,8 +7073,8 @@ func (m *DataDeleteRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_DataDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := DataDeleteRequestValidationError{
+	if !_RelationshipGraphRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipGraphRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
 		}
@@ -7048,9 +7084,9 @@ func (m *DataDeleteRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetTupleFilter() == nil {
-		err := DataDeleteRequestValidationError{
-			field:  "TupleFilter",
+	if m.GetMetadata() == nil {
+		err := RelationshipGraphRequestValidationError{
+			field:  "Metadata",
 			reason: "value is required",
 		}
 		if !all {
@@ -7060,37 +7096,37 @@ func (m *DataDeleteRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetTupleFilter()).(type) {
+		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "TupleFilter",
+				errors = append(errors, RelationshipGraphRequestValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "TupleFilter",
+				errors = append(errors, RelationshipGraphRequestValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTupleFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DataDeleteRequestValidationError{
-				field:  "TupleFilter",
+			return RelationshipGraphRequestValidationError{
+				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetAttributeFilter() == nil {
-		err := DataDeleteRequestValidationError{
-			field:  "AttributeFilter",
+	if m.GetEntity() == nil {
+		err := RelationshipGraphRequestValidationError{
+			field:  "Entity",
 			reason: "value is required",
 		}
 		if !all {
@@ -7100,48 +7136,63 @@ func (m *DataDeleteRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetAttributeFilter()).(type) {
+		switch v := interface{}(m.GetEntity()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "AttributeFilter",
+				errors = append(errors, RelationshipGraphRequestValidationError{
+					field:  "Entity",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "AttributeFilter",
+				errors = append(errors, RelationshipGraphRequestValidationError{
+					field:  "Entity",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAttributeFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DataDeleteRequestValidationError{
-				field:  "AttributeFilter",
+			return RelationshipGraphRequestValidationError{
+				field:  "Entity",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.GetDepth() != 0 {
+
+		if m.GetDepth() > 10 {
+			err := RelationshipGraphRequestValidationError{
+				field:  "Depth",
+				reason: "value must be less than or equal to 10",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DataDeleteRequestMultiError(errors)
+		return RelationshipGraphRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataDeleteRequestMultiError is an error wrapping multiple validation errors
-// returned by DataDeleteRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DataDeleteRequestMultiError []error
+// RelationshipGraphRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipGraphRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipGraphRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataDeleteRequestMultiError) Error() string {
+func (m RelationshipGraphRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7150,11 +7201,11 @@ func (m DataDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataDeleteRequestMultiError) AllErrors() []error { return m }
+func (m RelationshipGraphRequestMultiError) AllErrors() []error { return m }
 
-// DataDeleteRequestValidationError is the validation error returned by
-// DataDeleteRequest.Validate if the designated constraints aren't met.
-type DataDeleteRequestValidationError struct {
+// RelationshipGraphRequestValidationError is the validation error returned by
+// RelationshipGraphRequest.Validate if the designated constraints aren't met.
+type RelationshipGraphRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7162,24 +7213,24 @@ type DataDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataDeleteRequestValidationError) Field() string { return e.field }
+func (e RelationshipGraphRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataDeleteRequestValidationError) Reason() string { return e.reason }
+func (e RelationshipGraphRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataDeleteRequestValidationError) Cause() error { return e.cause }
+func (e RelationshipGraphRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataDeleteRequestValidationError) Key() bool { return e.key }
+func (e RelationshipGraphRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataDeleteRequestValidationError) ErrorName() string {
-	return "DataDeleteRequestValidationError"
+func (e RelationshipGraphRequestValidationError) ErrorName() string {
+	return "RelationshipGraphRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataDeleteRequestValidationError) Error() string {
+func (e RelationshipGraphRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7191,14 +7242,14 @@ func (e DataDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataDeleteRequest.%s: %s%s",
+		"invalid %sRelationshipGraphRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataDeleteRequestValidationError{}
+var _ error = RelationshipGraphRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7206,26 +7257,27 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataDeleteRequestValidationError{}
+} = RelationshipGraphRequestValidationError{}
 
-var _DataDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _RelationshipGraphRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-// Validate checks the field values on DataDeleteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DataDeleteResponse) Validate() error {
+// Validate checks the field values on RelationshipGraphRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RelationshipGraphRequestMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataDeleteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DataDeleteResponseMultiError, or nil if none found.
-func (m *DataDeleteResponse) ValidateAll() error {
+// ValidateAll checks the field values on RelationshipGraphRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipGraphRequestMetadataMultiError, or nil if none found.
+func (m *RelationshipGraphRequestMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataDeleteResponse) validate(all bool) error {
+func (m *RelationshipGraphRequestMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7234,20 +7286,23 @@ func (m *DataDeleteResponse) validate(all bool) error {
 
 	// no validation rules for SnapToken
 
+	// no validation rules for SchemaVersion
+
 	if len(errors) > 0 {
-		return DataDeleteResponseMultiError(errors)
+		return RelationshipGraphRequestMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataDeleteResponseMultiError is an error wrapping multiple validation errors
-// returned by DataDeleteResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DataDeleteResponseMultiError []error
+// RelationshipGraphRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by
+// RelationshipGraphRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipGraphRequestMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataDeleteResponseMultiError) Error() string {
+func (m RelationshipGraphRequestMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7256,11 +7311,12 @@ func (m DataDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataDeleteResponseMultiError) AllErrors() []error { return m }
+func (m RelationshipGraphRequestMetadataMultiError) AllErrors() []error { return m }
 
-// DataDeleteResponseValidationError is the validation error returned by
-// DataDeleteResponse.Validate if the designated constraints aren't met.
-type DataDeleteResponseValidationError struct {
+// RelationshipGraphRequestMetadataValidationError is the validation error
+// returned by RelationshipGraphRequestMetadata.Validate if the designated
+// constraints aren't met.
+type RelationshipGraphRequestMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7268,24 +7324,24 @@ type DataDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataDeleteResponseValidationError) Field() string { return e.field }
+func (e RelationshipGraphRequestMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataDeleteResponseValidationError) Reason() string { return e.reason }
+func (e RelationshipGraphRequestMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataDeleteResponseValidationError) Cause() error { return e.cause }
+func (e RelationshipGraphRequestMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataDeleteResponseValidationError) Key() bool { return e.key }
+func (e RelationshipGraphRequestMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataDeleteResponseValidationError) ErrorName() string {
-	return "DataDeleteResponseValidationError"
+func (e RelationshipGraphRequestMetadataValidationError) ErrorName() string {
+	return "RelationshipGraphRequestMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataDeleteResponseValidationError) Error() string {
+func (e RelationshipGraphRequestMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7297,14 +7353,14 @@ func (e DataDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataDeleteResponse.%s: %s%s",
+		"invalid %sRelationshipGraphRequestMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataDeleteResponseValidationError{}
+var _ error = RelationshipGraphRequestMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -7312,95 +7368,114 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataDeleteResponseValidationError{}
+} = RelationshipGraphRequestMetadataValidationError{}
 
-// Validate checks the field values on RelationshipDeleteRequest with the rules
+// Validate checks the field values on RelationshipGraphResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipDeleteRequest) Validate() error {
+func (m *RelationshipGraphResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipDeleteRequest with the
+// ValidateAll checks the field values on RelationshipGraphResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipDeleteRequestMultiError, or nil if none found.
-func (m *RelationshipDeleteRequest) ValidateAll() error {
+// RelationshipGraphResponseMultiError, or nil if none found.
+func (m *RelationshipGraphResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipDeleteRequest) validate(all bool) error {
+func (m *RelationshipGraphResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := RelationshipDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetNodes() {
+		_, _ = idx, item
 
-	if !_RelationshipDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipGraphResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipGraphResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipGraphResponseValidationError{
+					field:  fmt.Sprintf("Nodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipDeleteRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetEdges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipGraphResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipGraphResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipDeleteRequestValidationError{
-					field:  "Filter",
+				return RelationshipGraphResponseValidationError{
+					field:  fmt.Sprintf("Edges[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationshipDeleteRequestValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for SnapToken
+
 	if len(errors) > 0 {
-		return RelationshipDeleteRequestMultiError(errors)
+		return RelationshipGraphResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipDeleteRequest.ValidateAll() if the
+// RelationshipGraphResponseMultiError is an error wrapping multiple validation
+// errors returned by RelationshipGraphResponse.ValidateAll() if the
 // designated constraints aren't met.
-type RelationshipDeleteRequestMultiError []error
+type RelationshipGraphResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipDeleteRequestMultiError) Error() string {
+func (m RelationshipGraphResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7409,11 +7484,11 @@ func (m RelationshipDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipDeleteRequestMultiError) AllErrors() []error { return m }
+func (m RelationshipGraphResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipDeleteRequestValidationError is the validation error returned by
-// RelationshipDeleteRequest.Validate if the designated constraints aren't met.
-type RelationshipDeleteRequestValidationError struct {
+// RelationshipGraphResponseValidationError is the validation error returned by
+// RelationshipGraphResponse.Validate if the designated constraints aren't met.
+type RelationshipGraphResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7421,24 +7496,703 @@ type RelationshipDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipDeleteRequestValidationError) Field() string { return e.field }
+func (e RelationshipGraphResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipDeleteRequestValidationError) Reason() string { return e.reason }
+func (e RelationshipGraphResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipDeleteRequestValidationError) Cause() error { return e.cause }
+func (e RelationshipGraphResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipDeleteRequestValidationError) Key() bool { return e.key }
+func (e RelationshipGraphResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipDeleteRequestValidationError) ErrorName() string {
-	return "RelationshipDeleteRequestValidationError"
+func (e RelationshipGraphResponseValidationError) ErrorName() string {
+	return "RelationshipGraphResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipDeleteRequestValidationError) Error() string {
+func (e RelationshipGraphResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipGraphResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipGraphResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipGraphResponseValidationError{}
+
+// Validate checks the field values on RelationshipGraphNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipGraphNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipGraphNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipGraphNodeMultiError, or nil if none found.
+func (m *RelationshipGraphNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipGraphNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Id
+
+	// no validation rules for Label
+
+	if len(errors) > 0 {
+		return RelationshipGraphNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipGraphNodeMultiError is an error wrapping multiple validation
+// errors returned by RelationshipGraphNode.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipGraphNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipGraphNodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipGraphNodeMultiError) AllErrors() []error { return m }
+
+// RelationshipGraphNodeValidationError is the validation error returned by
+// RelationshipGraphNode.Validate if the designated constraints aren't met.
+type RelationshipGraphNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipGraphNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipGraphNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipGraphNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipGraphNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipGraphNodeValidationError) ErrorName() string {
+	return "RelationshipGraphNodeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipGraphNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipGraphNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipGraphNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipGraphNodeValidationError{}
+
+// Validate checks the field values on RelationshipGraphEdge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipGraphEdge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipGraphEdge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipGraphEdgeMultiError, or nil if none found.
+func (m *RelationshipGraphEdge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipGraphEdge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Label
+
+	if len(errors) > 0 {
+		return RelationshipGraphEdgeMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipGraphEdgeMultiError is an error wrapping multiple validation
+// errors returned by RelationshipGraphEdge.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipGraphEdgeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipGraphEdgeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipGraphEdgeMultiError) AllErrors() []error { return m }
+
+// RelationshipGraphEdgeValidationError is the validation error returned by
+// RelationshipGraphEdge.Validate if the designated constraints aren't met.
+type RelationshipGraphEdgeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipGraphEdgeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipGraphEdgeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipGraphEdgeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipGraphEdgeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipGraphEdgeValidationError) ErrorName() string {
+	return "RelationshipGraphEdgeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipGraphEdgeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipGraphEdge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipGraphEdgeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipGraphEdgeValidationError{}
+
+// Validate checks the field values on DataDeleteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataDeleteRequestMultiError, or nil if none found.
+func (m *DataDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := DataDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DataDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := DataDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTupleFilter() == nil {
+		err := DataDeleteRequestValidationError{
+			field:  "TupleFilter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTupleFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "TupleFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "TupleFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTupleFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataDeleteRequestValidationError{
+				field:  "TupleFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAttributeFilter() == nil {
+		err := DataDeleteRequestValidationError{
+			field:  "AttributeFilter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttributeFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "AttributeFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "AttributeFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributeFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataDeleteRequestValidationError{
+				field:  "AttributeFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DataDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataDeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by DataDeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DataDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataDeleteRequestMultiError) AllErrors() []error { return m }
+
+// DataDeleteRequestValidationError is the validation error returned by
+// DataDeleteRequest.Validate if the designated constraints aren't met.
+type DataDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataDeleteRequestValidationError) ErrorName() string {
+	return "DataDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataDeleteRequestValidationError{}
+
+var _DataDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on DataDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataDeleteResponseMultiError, or nil if none found.
+func (m *DataDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return DataDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataDeleteResponseMultiError is an error wrapping multiple validation errors
+// returned by DataDeleteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DataDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataDeleteResponseMultiError) AllErrors() []error { return m }
+
+// DataDeleteResponseValidationError is the validation error returned by
+// DataDeleteResponse.Validate if the designated constraints aren't met.
+type DataDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataDeleteResponseValidationError) ErrorName() string {
+	return "DataDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataDeleteResponseValidationError{}
+
+// Validate checks the field values on RelationshipDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipDeleteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipDeleteRequestMultiError, or nil if none found.
+func (m *RelationshipDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := RelationshipDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipDeleteRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipDeleteRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipDeleteRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelationshipDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipDeleteRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipDeleteRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipDeleteRequestValidationError is the validation error returned by
+// RelationshipDeleteRequest.Validate if the designated constraints aren't met.
+type RelationshipDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipDeleteRequestValidationError) ErrorName() string {
+	return "RelationshipDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipDeleteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7457,7 +8211,777 @@ func (e RelationshipDeleteRequestValidationError) Error() string {
 		cause)
 }
 
-var _ error = RelationshipDeleteRequestValidationError{}
+var _ error = RelationshipDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipDeleteRequestValidationError{}
+
+var _RelationshipDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on RelationshipDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipDeleteResponseMultiError, or nil if none found.
+func (m *RelationshipDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipDeleteResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipDeleteResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RelationshipDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipDeleteResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipDeleteResponseValidationError is the validation error returned
+// by RelationshipDeleteResponse.Validate if the designated constraints aren't met.
+type RelationshipDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipDeleteResponseValidationError) ErrorName() string {
+	return "RelationshipDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipDeleteResponseValidationError{}
+
+// Validate checks the field values on BundleRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BundleRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BundleRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BundleRunRequestMultiError, or nil if none found.
+func (m *BundleRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BundleRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := BundleRunRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BundleRunRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := BundleRunRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Arguments
+
+	// no validation rules for Version
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return BundleRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BundleRunRequestMultiError is an error wrapping multiple validation errors
+// returned by BundleRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BundleRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BundleRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BundleRunRequestMultiError) AllErrors() []error { return m }
+
+// BundleRunRequestValidationError is the validation error returned by
+// BundleRunRequest.Validate if the designated constraints aren't met.
+type BundleRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BundleRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BundleRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BundleRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BundleRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BundleRunRequestValidationError) ErrorName() string { return "BundleRunRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BundleRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBundleRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BundleRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BundleRunRequestValidationError{}
+
+var _BundleRunRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on BundleRunResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BundleRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BundleRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BundleRunResponseMultiError, or nil if none found.
+func (m *BundleRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BundleRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BundleRunResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BundleRunResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BundleRunResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BundleRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BundleRunResponseMultiError is an error wrapping multiple validation errors
+// returned by BundleRunResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BundleRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BundleRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BundleRunResponseMultiError) AllErrors() []error { return m }
+
+// BundleRunResponseValidationError is the validation error returned by
+// BundleRunResponse.Validate if the designated constraints aren't met.
+type BundleRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BundleRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BundleRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BundleRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BundleRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BundleRunResponseValidationError) ErrorName() string {
+	return "BundleRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BundleRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBundleRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BundleRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BundleRunResponseValidationError{}
+
+// Validate checks the field values on BundleWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BundleWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BundleWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BundleWriteRequestMultiError, or nil if none found.
+func (m *BundleWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BundleWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := BundleWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BundleWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := BundleWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBundles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BundleWriteRequestValidationError{
+						field:  fmt.Sprintf("Bundles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BundleWriteRequestValidationError{
+						field:  fmt.Sprintf("Bundles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BundleWriteRequestValidationError{
+					field:  fmt.Sprintf("Bundles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BundleWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BundleWriteRequestMultiError is an error wrapping multiple validation errors
+// returned by BundleWriteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BundleWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BundleWriteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BundleWriteRequestMultiError) AllErrors() []error { return m }
+
+// BundleWriteRequestValidationError is the validation error returned by
+// BundleWriteRequest.Validate if the designated constraints aren't met.
+type BundleWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BundleWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BundleWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BundleWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BundleWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BundleWriteRequestValidationError) ErrorName() string {
+	return "BundleWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BundleWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBundleWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BundleWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BundleWriteRequestValidationError{}
+
+var _BundleWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on BundleWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BundleWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BundleWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BundleWriteResponseMultiError, or nil if none found.
+func (m *BundleWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BundleWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return BundleWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BundleWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by BundleWriteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BundleWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BundleWriteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BundleWriteResponseMultiError) AllErrors() []error { return m }
+
+// BundleWriteResponseValidationError is the validation error returned by
+// BundleWriteResponse.Validate if the designated constraints aren't met.
+type BundleWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BundleWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BundleWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BundleWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BundleWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BundleWriteResponseValidationError) ErrorName() string {
+	return "BundleWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BundleWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBundleWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BundleWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BundleWriteResponseValidationError{}
+
+// Validate checks the field values on BundleReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BundleReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BundleReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BundleReadRequestMultiError, or nil if none found.
+func (m *BundleReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BundleReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := BundleReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BundleReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := BundleReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return BundleReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BundleReadRequestMultiError is an error wrapping multiple validation errors
+// returned by BundleReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BundleReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BundleReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BundleReadRequestMultiError) AllErrors() []error { return m }
+
+// BundleReadRequestValidationError is the validation error returned by
+// BundleReadRequest.Validate if the designated constraints aren't met.
+type BundleReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BundleReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BundleReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BundleReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BundleReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BundleReadRequestValidationError) ErrorName() string {
+	return "BundleReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BundleReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBundleReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BundleReadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7465,48 +8989,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipDeleteRequestValidationError{}
+} = BundleReadRequestValidationError{}
 
-var _RelationshipDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _BundleReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-// Validate checks the field values on RelationshipDeleteResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BundleReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipDeleteResponse) Validate() error {
+func (m *BundleReadResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipDeleteResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BundleReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipDeleteResponseMultiError, or nil if none found.
-func (m *RelationshipDeleteResponse) ValidateAll() error {
+// BundleReadResponseMultiError, or nil if none found.
+func (m *BundleReadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipDeleteResponse) validate(all bool) error {
+func (m *BundleReadResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	if all {
+		switch v := interface{}(m.GetBundle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BundleReadResponseValidationError{
+					field:  "Bundle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BundleReadResponseValidationError{
+					field:  "Bundle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBundle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BundleReadResponseValidationError{
+				field:  "Bundle",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return RelationshipDeleteResponseMultiError(errors)
+		return BundleReadResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipDeleteResponseMultiError is an error wrapping multiple
-// validation errors returned by RelationshipDeleteResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RelationshipDeleteResponseMultiError []error
+// BundleReadResponseMultiError is an error wrapping multiple validation errors
+// returned by BundleReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BundleReadResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipDeleteResponseMultiError) Error() string {
+func (m BundleReadResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7515,11 +9068,11 @@ func (m RelationshipDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipDeleteResponseMultiError) AllErrors() []error { return m }
+func (m BundleReadResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipDeleteResponseValidationError is the validation error returned
-// by RelationshipDeleteResponse.Validate if the designated constraints aren't met.
-type RelationshipDeleteResponseValidationError struct {
+// BundleReadResponseValidationError is the validation error returned by
+// BundleReadResponse.Validate if the designated constraints aren't met.
+type BundleReadResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7527,24 +9080,24 @@ type RelationshipDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipDeleteResponseValidationError) Field() string { return e.field }
+func (e BundleReadResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipDeleteResponseValidationError) Reason() string { return e.reason }
+func (e BundleReadResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipDeleteResponseValidationError) Cause() error { return e.cause }
+func (e BundleReadResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipDeleteResponseValidationError) Key() bool { return e.key }
+func (e BundleReadResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipDeleteResponseValidationError) ErrorName() string {
-	return "RelationshipDeleteResponseValidationError"
+func (e BundleReadResponseValidationError) ErrorName() string {
+	return "BundleReadResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipDeleteResponseValidationError) Error() string {
+func (e BundleReadResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7556,14 +9109,140 @@ func (e RelationshipDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipDeleteResponse.%s: %s%s",
+		"invalid %sBundleReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BundleReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BundleReadResponseValidationError{}
+
+// Validate checks the field values on BundleDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BundleDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BundleDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BundleDeleteRequestMultiError, or nil if none found.
+func (m *BundleDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BundleDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := BundleDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BundleDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := BundleDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return BundleDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BundleDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by BundleDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BundleDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BundleDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BundleDeleteRequestMultiError) AllErrors() []error { return m }
+
+// BundleDeleteRequestValidationError is the validation error returned by
+// BundleDeleteRequest.Validate if the designated constraints aren't met.
+type BundleDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BundleDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BundleDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BundleDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BundleDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BundleDeleteRequestValidationError) ErrorName() string {
+	return "BundleDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BundleDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBundleDeleteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipDeleteResponseValidationError{}
+var _ error = BundleDeleteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7571,70 +9250,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipDeleteResponseValidationError{}
+} = BundleDeleteRequestValidationError{}
 
-// Validate checks the field values on BundleRunRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *BundleRunRequest) Validate() error {
+var _BundleDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on BundleDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BundleDeleteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleRunRequest with the rules
+// ValidateAll checks the field values on BundleDeleteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleRunRequestMultiError, or nil if none found.
-func (m *BundleRunRequest) ValidateAll() error {
+// BundleDeleteResponseMultiError, or nil if none found.
+func (m *BundleDeleteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleRunRequest) validate(all bool) error {
+func (m *BundleDeleteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := BundleRunRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_BundleRunRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := BundleRunRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	// no validation rules for Name
 
-	// no validation rules for Arguments
-
 	if len(errors) > 0 {
-		return BundleRunRequestMultiError(errors)
+		return BundleDeleteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleRunRequestMultiError is an error wrapping multiple validation errors
-// returned by BundleRunRequest.ValidateAll() if the designated constraints
-// aren't met.
-type BundleRunRequestMultiError []error
+// BundleDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by BundleDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BundleDeleteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleRunRequestMultiError) Error() string {
+func (m BundleDeleteResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7643,11 +9300,11 @@ func (m BundleRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleRunRequestMultiError) AllErrors() []error { return m }
+func (m BundleDeleteResponseMultiError) AllErrors() []error { return m }
 
-// BundleRunRequestValidationError is the validation error returned by
-// BundleRunRequest.Validate if the designated constraints aren't met.
-type BundleRunRequestValidationError struct {
+// BundleDeleteResponseValidationError is the validation error returned by
+// BundleDeleteResponse.Validate if the designated constraints aren't met.
+type BundleDeleteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7655,22 +9312,24 @@ type BundleRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleRunRequestValidationError) Field() string { return e.field }
+func (e BundleDeleteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleRunRequestValidationError) Reason() string { return e.reason }
+func (e BundleDeleteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleRunRequestValidationError) Cause() error { return e.cause }
+func (e BundleDeleteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleRunRequestValidationError) Key() bool { return e.key }
+func (e BundleDeleteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleRunRequestValidationError) ErrorName() string { return "BundleRunRequestValidationError" }
+func (e BundleDeleteResponseValidationError) ErrorName() string {
+	return "BundleDeleteResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BundleRunRequestValidationError) Error() string {
+func (e BundleDeleteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7682,14 +9341,14 @@ func (e BundleRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleRunRequest.%s: %s%s",
+		"invalid %sBundleDeleteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleRunRequestValidationError{}
+var _ error = BundleDeleteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7697,48 +9356,87 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleRunRequestValidationError{}
-
-var _BundleRunRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = BundleDeleteResponseValidationError{}
 
-// Validate checks the field values on BundleRunResponse with the rules defined
+// Validate checks the field values on BundleListRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *BundleRunResponse) Validate() error {
+func (m *BundleListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleRunResponse with the rules
+// ValidateAll checks the field values on BundleListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleRunResponseMultiError, or nil if none found.
-func (m *BundleRunResponse) ValidateAll() error {
+// BundleListRequestMultiError, or nil if none found.
+func (m *BundleListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleRunResponse) validate(all bool) error {
+func (m *BundleListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	if len(m.GetTenantId()) > 128 {
+		err := BundleListRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BundleListRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := BundleListRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() < 1 {
+			err := BundleListRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
 
 	if len(errors) > 0 {
-		return BundleRunResponseMultiError(errors)
+		return BundleListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleRunResponseMultiError is an error wrapping multiple validation errors
-// returned by BundleRunResponse.ValidateAll() if the designated constraints
+// BundleListRequestMultiError is an error wrapping multiple validation errors
+// returned by BundleListRequest.ValidateAll() if the designated constraints
 // aren't met.
-type BundleRunResponseMultiError []error
+type BundleListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleRunResponseMultiError) Error() string {
+func (m BundleListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7747,11 +9445,11 @@ func (m BundleRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleRunResponseMultiError) AllErrors() []error { return m }
+func (m BundleListRequestMultiError) AllErrors() []error { return m }
 
-// BundleRunResponseValidationError is the validation error returned by
-// BundleRunResponse.Validate if the designated constraints aren't met.
-type BundleRunResponseValidationError struct {
+// BundleListRequestValidationError is the validation error returned by
+// BundleListRequest.Validate if the designated constraints aren't met.
+type BundleListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7759,24 +9457,24 @@ type BundleRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleRunResponseValidationError) Field() string { return e.field }
+func (e BundleListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleRunResponseValidationError) Reason() string { return e.reason }
+func (e BundleListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleRunResponseValidationError) Cause() error { return e.cause }
+func (e BundleListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleRunResponseValidationError) Key() bool { return e.key }
+func (e BundleListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleRunResponseValidationError) ErrorName() string {
-	return "BundleRunResponseValidationError"
+func (e BundleListRequestValidationError) ErrorName() string {
+	return "BundleListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleRunResponseValidationError) Error() string {
+func (e BundleListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7788,14 +9486,14 @@ func (e BundleRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleRunResponse.%s: %s%s",
+		"invalid %sBundleListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleRunResponseValidationError{}
+var _ error = BundleListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7803,52 +9501,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleRunResponseValidationError{}
+} = BundleListRequestValidationError{}
 
-// Validate checks the field values on BundleWriteRequest with the rules
+var _BundleListRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on BundleListResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BundleWriteRequest) Validate() error {
+func (m *BundleListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleWriteRequest with the rules
+// ValidateAll checks the field values on BundleListResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleWriteRequestMultiError, or nil if none found.
-func (m *BundleWriteRequest) ValidateAll() error {
+// BundleListResponseMultiError, or nil if none found.
+func (m *BundleListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleWriteRequest) validate(all bool) error {
+func (m *BundleListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := BundleWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_BundleWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := BundleWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	for idx, item := range m.GetBundles() {
 		_, _ = idx, item
 
@@ -7856,7 +9534,7 @@ func (m *BundleWriteRequest) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, BundleWriteRequestValidationError{
+					errors = append(errors, BundleListResponseValidationError{
 						field:  fmt.Sprintf("Bundles[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -7864,7 +9542,7 @@ func (m *BundleWriteRequest) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, BundleWriteRequestValidationError{
+					errors = append(errors, BundleListResponseValidationError{
 						field:  fmt.Sprintf("Bundles[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -7873,7 +9551,7 @@ func (m *BundleWriteRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return BundleWriteRequestValidationError{
+				return BundleListResponseValidationError{
 					field:  fmt.Sprintf("Bundles[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7883,20 +9561,22 @@ func (m *BundleWriteRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for ContinuousToken
+
 	if len(errors) > 0 {
-		return BundleWriteRequestMultiError(errors)
+		return BundleListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleWriteRequestMultiError is an error wrapping multiple validation errors
-// returned by BundleWriteRequest.ValidateAll() if the designated constraints
+// BundleListResponseMultiError is an error wrapping multiple validation errors
+// returned by BundleListResponse.ValidateAll() if the designated constraints
 // aren't met.
-type BundleWriteRequestMultiError []error
+type BundleListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleWriteRequestMultiError) Error() string {
+func (m BundleListResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7905,11 +9585,11 @@ func (m BundleWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleWriteRequestMultiError) AllErrors() []error { return m }
+func (m BundleListResponseMultiError) AllErrors() []error { return m }
 
-// BundleWriteRequestValidationError is the validation error returned by
-// BundleWriteRequest.Validate if the designated constraints aren't met.
-type BundleWriteRequestValidationError struct {
+// BundleListResponseValidationError is the validation error returned by
+// BundleListResponse.Validate if the designated constraints aren't met.
+type BundleListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7917,24 +9597,24 @@ type BundleWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleWriteRequestValidationError) Field() string { return e.field }
+func (e BundleListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleWriteRequestValidationError) Reason() string { return e.reason }
+func (e BundleListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleWriteRequestValidationError) Cause() error { return e.cause }
+func (e BundleListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleWriteRequestValidationError) Key() bool { return e.key }
+func (e BundleListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleWriteRequestValidationError) ErrorName() string {
-	return "BundleWriteRequestValidationError"
+func (e BundleListResponseValidationError) ErrorName() string {
+	return "BundleListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleWriteRequestValidationError) Error() string {
+func (e BundleListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7946,14 +9626,14 @@ func (e BundleWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleWriteRequest.%s: %s%s",
+		"invalid %sBundleListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleWriteRequestValidationError{}
+var _ error = BundleListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7961,46 +9641,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleWriteRequestValidationError{}
-
-var _BundleWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = BundleListResponseValidationError{}
 
-// Validate checks the field values on BundleWriteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BundleWriteResponse) Validate() error {
+// Validate checks the field values on BundleList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BundleList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleWriteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// BundleWriteResponseMultiError, or nil if none found.
-func (m *BundleWriteResponse) ValidateAll() error {
+// ValidateAll checks the field values on BundleList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BundleListMultiError, or
+// nil if none found.
+func (m *BundleList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleWriteResponse) validate(all bool) error {
+func (m *BundleList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
+	// no validation rules for Version
+
+	// no validation rules for CreatedAt
+
 	if len(errors) > 0 {
-		return BundleWriteResponseMultiError(errors)
+		return BundleListMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleWriteResponseMultiError is an error wrapping multiple validation
-// errors returned by BundleWriteResponse.ValidateAll() if the designated
-// constraints aren't met.
-type BundleWriteResponseMultiError []error
+// BundleListMultiError is an error wrapping multiple validation errors
+// returned by BundleList.ValidateAll() if the designated constraints aren't met.
+type BundleListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleWriteResponseMultiError) Error() string {
+func (m BundleListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8009,11 +9692,11 @@ func (m BundleWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleWriteResponseMultiError) AllErrors() []error { return m }
+func (m BundleListMultiError) AllErrors() []error { return m }
 
-// BundleWriteResponseValidationError is the validation error returned by
-// BundleWriteResponse.Validate if the designated constraints aren't met.
-type BundleWriteResponseValidationError struct {
+// BundleListValidationError is the validation error returned by
+// BundleList.Validate if the designated constraints aren't met.
+type BundleListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8021,24 +9704,22 @@ type BundleWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleWriteResponseValidationError) Field() string { return e.field }
+func (e BundleListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleWriteResponseValidationError) Reason() string { return e.reason }
+func (e BundleListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleWriteResponseValidationError) Cause() error { return e.cause }
+func (e BundleListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleWriteResponseValidationError) Key() bool { return e.key }
+func (e BundleListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleWriteResponseValidationError) ErrorName() string {
-	return "BundleWriteResponseValidationError"
-}
+func (e BundleListValidationError) ErrorName() string { return "BundleListValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BundleWriteResponseValidationError) Error() string {
+func (e BundleListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8050,14 +9731,14 @@ func (e BundleWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleWriteResponse.%s: %s%s",
+		"invalid %sBundleList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleWriteResponseValidationError{}
+var _ error = BundleListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8065,34 +9746,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleWriteResponseValidationError{}
+} = BundleListValidationError{}
 
-// Validate checks the field values on BundleReadRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *BundleReadRequest) Validate() error {
+// Validate checks the field values on TenantCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantCreateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleReadRequest with the rules
+// ValidateAll checks the field values on TenantCreateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleReadRequestMultiError, or nil if none found.
-func (m *BundleReadRequest) ValidateAll() error {
+// TenantCreateRequestMultiError, or nil if none found.
+func (m *TenantCreateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleReadRequest) validate(all bool) error {
+func (m *TenantCreateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := BundleReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
+	if len(m.GetId()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 64 bytes",
 		}
 		if !all {
 			return err
@@ -8100,10 +9781,10 @@ func (m *BundleReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_BundleReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := BundleReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+	if !_TenantCreateRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := TenantCreateRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
 		}
 		if !all {
 			return err
@@ -8111,22 +9792,31 @@ func (m *BundleReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Name
+	if len(m.GetName()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return BundleReadRequestMultiError(errors)
+		return TenantCreateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleReadRequestMultiError is an error wrapping multiple validation errors
-// returned by BundleReadRequest.ValidateAll() if the designated constraints
-// aren't met.
-type BundleReadRequestMultiError []error
+// TenantCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleReadRequestMultiError) Error() string {
+func (m TenantCreateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8135,11 +9825,11 @@ func (m BundleReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleReadRequestMultiError) AllErrors() []error { return m }
+func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
 
-// BundleReadRequestValidationError is the validation error returned by
-// BundleReadRequest.Validate if the designated constraints aren't met.
-type BundleReadRequestValidationError struct {
+// TenantCreateRequestValidationError is the validation error returned by
+// TenantCreateRequest.Validate if the designated constraints aren't met.
+type TenantCreateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8147,24 +9837,24 @@ type BundleReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleReadRequestValidationError) Field() string { return e.field }
+func (e TenantCreateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleReadRequestValidationError) Reason() string { return e.reason }
+func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleReadRequestValidationError) Cause() error { return e.cause }
+func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleReadRequestValidationError) Key() bool { return e.key }
+func (e TenantCreateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleReadRequestValidationError) ErrorName() string {
-	return "BundleReadRequestValidationError"
+func (e TenantCreateRequestValidationError) ErrorName() string {
+	return "TenantCreateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleReadRequestValidationError) Error() string {
+func (e TenantCreateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8176,14 +9866,14 @@ func (e BundleReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleReadRequest.%s: %s%s",
+		"invalid %sTenantCreateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleReadRequestValidationError{}
+var _ error = TenantCreateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8191,26 +9881,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleReadRequestValidationError{}
+} = TenantCreateRequestValidationError{}
 
-var _BundleReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _TenantCreateRequest_Id_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
 
-// Validate checks the field values on BundleReadResponse with the rules
+// Validate checks the field values on TenantCreateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BundleReadResponse) Validate() error {
+func (m *TenantCreateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleReadResponse with the rules
+// ValidateAll checks the field values on TenantCreateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleReadResponseMultiError, or nil if none found.
-func (m *BundleReadResponse) ValidateAll() error {
+// TenantCreateResponseMultiError, or nil if none found.
+func (m *TenantCreateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleReadResponse) validate(all bool) error {
+func (m *TenantCreateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8218,28 +9908,28 @@ func (m *BundleReadResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetBundle()).(type) {
+		switch v := interface{}(m.GetTenant()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BundleReadResponseValidationError{
-					field:  "Bundle",
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, BundleReadResponseValidationError{
-					field:  "Bundle",
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBundle()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return BundleReadResponseValidationError{
-				field:  "Bundle",
+			return TenantCreateResponseValidationError{
+				field:  "Tenant",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -8247,19 +9937,19 @@ func (m *BundleReadResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return BundleReadResponseMultiError(errors)
+		return TenantCreateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleReadResponseMultiError is an error wrapping multiple validation errors
-// returned by BundleReadResponse.ValidateAll() if the designated constraints
-// aren't met.
-type BundleReadResponseMultiError []error
+// TenantCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleReadResponseMultiError) Error() string {
+func (m TenantCreateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8268,11 +9958,11 @@ func (m BundleReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleReadResponseMultiError) AllErrors() []error { return m }
+func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
 
-// BundleReadResponseValidationError is the validation error returned by
-// BundleReadResponse.Validate if the designated constraints aren't met.
-type BundleReadResponseValidationError struct {
+// TenantCreateResponseValidationError is the validation error returned by
+// TenantCreateResponse.Validate if the designated constraints aren't met.
+type TenantCreateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8280,24 +9970,24 @@ type BundleReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleReadResponseValidationError) Field() string { return e.field }
+func (e TenantCreateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleReadResponseValidationError) Reason() string { return e.reason }
+func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleReadResponseValidationError) Cause() error { return e.cause }
+func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleReadResponseValidationError) Key() bool { return e.key }
+func (e TenantCreateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleReadResponseValidationError) ErrorName() string {
-	return "BundleReadResponseValidationError"
+func (e TenantCreateResponseValidationError) ErrorName() string {
+	return "TenantCreateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleReadResponseValidationError) Error() string {
+func (e TenantCreateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8309,14 +9999,14 @@ func (e BundleReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleReadResponse.%s: %s%s",
+		"invalid %sTenantCreateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleReadResponseValidationError{}
+var _ error = TenantCreateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8324,68 +10014,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleReadResponseValidationError{}
+} = TenantCreateResponseValidationError{}
 
-// Validate checks the field values on BundleDeleteRequest with the rules
+// Validate checks the field values on TenantDeleteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BundleDeleteRequest) Validate() error {
+func (m *TenantDeleteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleDeleteRequest with the rules
+// ValidateAll checks the field values on TenantDeleteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleDeleteRequestMultiError, or nil if none found.
-func (m *BundleDeleteRequest) ValidateAll() error {
+// TenantDeleteRequestMultiError, or nil if none found.
+func (m *TenantDeleteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleDeleteRequest) validate(all bool) error {
+func (m *TenantDeleteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := BundleDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_BundleDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := BundleDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Name
-
 	if len(errors) > 0 {
-		return BundleDeleteRequestMultiError(errors)
+		return TenantDeleteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by BundleDeleteRequest.ValidateAll() if the designated
+// TenantDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteRequest.ValidateAll() if the designated
 // constraints aren't met.
-type BundleDeleteRequestMultiError []error
+type TenantDeleteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleDeleteRequestMultiError) Error() string {
+func (m TenantDeleteRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8394,11 +10060,11 @@ func (m BundleDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleDeleteRequestMultiError) AllErrors() []error { return m }
+func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
 
-// BundleDeleteRequestValidationError is the validation error returned by
-// BundleDeleteRequest.Validate if the designated constraints aren't met.
-type BundleDeleteRequestValidationError struct {
+// TenantDeleteRequestValidationError is the validation error returned by
+// TenantDeleteRequest.Validate if the designated constraints aren't met.
+type TenantDeleteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8406,24 +10072,24 @@ type BundleDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleDeleteRequestValidationError) Field() string { return e.field }
+func (e TenantDeleteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleDeleteRequestValidationError) Reason() string { return e.reason }
+func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleDeleteRequestValidationError) Cause() error { return e.cause }
+func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleDeleteRequestValidationError) Key() bool { return e.key }
+func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleDeleteRequestValidationError) ErrorName() string {
-	return "BundleDeleteRequestValidationError"
+func (e TenantDeleteRequestValidationError) ErrorName() string {
+	return "TenantDeleteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleDeleteRequestValidationError) Error() string {
+func (e TenantDeleteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8435,14 +10101,14 @@ func (e BundleDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleDeleteRequest.%s: %s%s",
+		"invalid %sTenantDeleteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleDeleteRequestValidationError{}
+var _ error = TenantDeleteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8450,48 +10116,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleDeleteRequestValidationError{}
-
-var _BundleDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = TenantDeleteRequestValidationError{}
 
-// Validate checks the field values on BundleDeleteResponse with the rules
+// Validate checks the field values on TenantDeleteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BundleDeleteResponse) Validate() error {
+func (m *TenantDeleteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleDeleteResponse with the rules
+// ValidateAll checks the field values on TenantDeleteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleDeleteResponseMultiError, or nil if none found.
-func (m *BundleDeleteResponse) ValidateAll() error {
+// TenantDeleteResponseMultiError, or nil if none found.
+func (m *TenantDeleteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleDeleteResponse) validate(all bool) error {
+func (m *TenantDeleteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Name
-
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantDeleteResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantDeleteResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantDeleteResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return BundleDeleteResponseMultiError(errors)
+		return TenantDeleteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleDeleteResponseMultiError is an error wrapping multiple validation
-// errors returned by BundleDeleteResponse.ValidateAll() if the designated
+// TenantDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteResponse.ValidateAll() if the designated
 // constraints aren't met.
-type BundleDeleteResponseMultiError []error
+type TenantDeleteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleDeleteResponseMultiError) Error() string {
+func (m TenantDeleteResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8500,11 +10191,11 @@ func (m BundleDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleDeleteResponseMultiError) AllErrors() []error { return m }
+func (m TenantDeleteResponseMultiError) AllErrors() []error { return m }
 
-// BundleDeleteResponseValidationError is the validation error returned by
-// BundleDeleteResponse.Validate if the designated constraints aren't met.
-type BundleDeleteResponseValidationError struct {
+// TenantDeleteResponseValidationError is the validation error returned by
+// TenantDeleteResponse.Validate if the designated constraints aren't met.
+type TenantDeleteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8512,24 +10203,24 @@ type BundleDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleDeleteResponseValidationError) Field() string { return e.field }
+func (e TenantDeleteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleDeleteResponseValidationError) Reason() string { return e.reason }
+func (e TenantDeleteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleDeleteResponseValidationError) Cause() error { return e.cause }
+func (e TenantDeleteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleDeleteResponseValidationError) Key() bool { return e.key }
+func (e TenantDeleteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleDeleteResponseValidationError) ErrorName() string {
-	return "BundleDeleteResponseValidationError"
+func (e TenantDeleteResponseValidationError) ErrorName() string {
+	return "TenantDeleteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleDeleteResponseValidationError) Error() string {
+func (e TenantDeleteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8541,14 +10232,14 @@ func (e BundleDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleDeleteResponse.%s: %s%s",
+		"invalid %sTenantDeleteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleDeleteResponseValidationError{}
+var _ error = TenantDeleteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8556,77 +10247,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleDeleteResponseValidationError{}
+} = TenantDeleteResponseValidationError{}
 
-// Validate checks the field values on TenantCreateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantCreateRequest) Validate() error {
+// Validate checks the field values on TenantReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantReadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantCreateRequest with the rules
+// ValidateAll checks the field values on TenantReadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantCreateRequestMultiError, or nil if none found.
-func (m *TenantCreateRequest) ValidateAll() error {
+// TenantReadRequestMultiError, or nil if none found.
+func (m *TenantReadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantCreateRequest) validate(all bool) error {
+func (m *TenantReadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetId()) > 64 {
-		err := TenantCreateRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_TenantCreateRequest_Id_Pattern.MatchString(m.GetId()) {
-		err := TenantCreateRequestValidationError{
-			field:  "Id",
-			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetName()) > 64 {
-		err := TenantCreateRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return TenantCreateRequestMultiError(errors)
+		return TenantReadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantCreateRequestMultiError is an error wrapping multiple validation
-// errors returned by TenantCreateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TenantCreateRequestMultiError []error
+// TenantReadRequestMultiError is an error wrapping multiple validation errors
+// returned by TenantReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TenantReadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantCreateRequestMultiError) Error() string {
+func (m TenantReadRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8635,11 +10293,11 @@ func (m TenantCreateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
+func (m TenantReadRequestMultiError) AllErrors() []error { return m }
 
-// TenantCreateRequestValidationError is the validation error returned by
-// TenantCreateRequest.Validate if the designated constraints aren't met.
-type TenantCreateRequestValidationError struct {
+// TenantReadRequestValidationError is the validation error returned by
+// TenantReadRequest.Validate if the designated constraints aren't met.
+type TenantReadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8647,24 +10305,24 @@ type TenantCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantCreateRequestValidationError) Field() string { return e.field }
+func (e TenantReadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
+func (e TenantReadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
+func (e TenantReadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantCreateRequestValidationError) Key() bool { return e.key }
+func (e TenantReadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantCreateRequestValidationError) ErrorName() string {
-	return "TenantCreateRequestValidationError"
+func (e TenantReadRequestValidationError) ErrorName() string {
+	return "TenantReadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantCreateRequestValidationError) Error() string {
+func (e TenantReadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8676,14 +10334,14 @@ func (e TenantCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantCreateRequest.%s: %s%s",
+		"invalid %sTenantReadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantCreateRequestValidationError{}
+var _ error = TenantReadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8691,26 +10349,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantCreateRequestValidationError{}
-
-var _TenantCreateRequest_Id_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+} = TenantReadRequestValidationError{}
 
-// Validate checks the field values on TenantCreateResponse with the rules
+// Validate checks the field values on TenantReadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantCreateResponse) Validate() error {
+func (m *TenantReadResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantCreateResponse with the rules
+// ValidateAll checks the field values on TenantReadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantCreateResponseMultiError, or nil if none found.
-func (m *TenantCreateResponse) ValidateAll() error {
+// TenantReadResponseMultiError, or nil if none found.
+func (m *TenantReadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantCreateResponse) validate(all bool) error {
+func (m *TenantReadResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8721,7 +10377,7 @@ func (m *TenantCreateResponse) validate(all bool) error {
 		switch v := interface{}(m.GetTenant()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantCreateResponseValidationError{
+				errors = append(errors, TenantReadResponseValidationError{
 					field:  "Tenant",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8729,7 +10385,7 @@ func (m *TenantCreateResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantCreateResponseValidationError{
+				errors = append(errors, TenantReadResponseValidationError{
 					field:  "Tenant",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8738,7 +10394,7 @@ func (m *TenantCreateResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TenantCreateResponseValidationError{
+			return TenantReadResponseValidationError{
 				field:  "Tenant",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -8747,19 +10403,19 @@ func (m *TenantCreateResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TenantCreateResponseMultiError(errors)
+		return TenantReadResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantCreateResponseMultiError is an error wrapping multiple validation
-// errors returned by TenantCreateResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TenantCreateResponseMultiError []error
+// TenantReadResponseMultiError is an error wrapping multiple validation errors
+// returned by TenantReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TenantReadResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantCreateResponseMultiError) Error() string {
+func (m TenantReadResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8768,11 +10424,11 @@ func (m TenantCreateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
+func (m TenantReadResponseMultiError) AllErrors() []error { return m }
 
-// TenantCreateResponseValidationError is the validation error returned by
-// TenantCreateResponse.Validate if the designated constraints aren't met.
-type TenantCreateResponseValidationError struct {
+// TenantReadResponseValidationError is the validation error returned by
+// TenantReadResponse.Validate if the designated constraints aren't met.
+type TenantReadResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8780,24 +10436,24 @@ type TenantCreateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantCreateResponseValidationError) Field() string { return e.field }
+func (e TenantReadResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
+func (e TenantReadResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
+func (e TenantReadResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantCreateResponseValidationError) Key() bool { return e.key }
+func (e TenantReadResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantCreateResponseValidationError) ErrorName() string {
-	return "TenantCreateResponseValidationError"
+func (e TenantReadResponseValidationError) ErrorName() string {
+	return "TenantReadResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantCreateResponseValidationError) Error() string {
+func (e TenantReadResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8809,14 +10465,14 @@ func (e TenantCreateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantCreateResponse.%s: %s%s",
+		"invalid %sTenantReadResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantCreateResponseValidationError{}
+var _ error = TenantReadResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8824,44 +10480,96 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantCreateResponseValidationError{}
+} = TenantReadResponseValidationError{}
 
-// Validate checks the field values on TenantDeleteRequest with the rules
+// Validate checks the field values on TenantUpdateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantDeleteRequest) Validate() error {
+func (m *TenantUpdateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantDeleteRequest with the rules
+// ValidateAll checks the field values on TenantUpdateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantDeleteRequestMultiError, or nil if none found.
-func (m *TenantDeleteRequest) ValidateAll() error {
+// TenantUpdateRequestMultiError, or nil if none found.
+func (m *TenantUpdateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantDeleteRequest) validate(all bool) error {
+func (m *TenantUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetName() != "" {
+
+		if len(m.GetName()) > 64 {
+			err := TenantUpdateRequestValidationError{
+				field:  "Name",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := len(key); l < 1 || l > 64 {
+				err := TenantUpdateRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 64 bytes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if len(val) > 256 {
+				err := TenantUpdateRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 256 bytes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
-		return TenantDeleteRequestMultiError(errors)
+		return TenantUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by TenantDeleteRequest.ValidateAll() if the designated
+// TenantUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantUpdateRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TenantDeleteRequestMultiError []error
+type TenantUpdateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantDeleteRequestMultiError) Error() string {
+func (m TenantUpdateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8870,11 +10578,11 @@ func (m TenantDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
+func (m TenantUpdateRequestMultiError) AllErrors() []error { return m }
 
-// TenantDeleteRequestValidationError is the validation error returned by
-// TenantDeleteRequest.Validate if the designated constraints aren't met.
-type TenantDeleteRequestValidationError struct {
+// TenantUpdateRequestValidationError is the validation error returned by
+// TenantUpdateRequest.Validate if the designated constraints aren't met.
+type TenantUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8882,24 +10590,24 @@ type TenantDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantDeleteRequestValidationError) Field() string { return e.field }
+func (e TenantUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
+func (e TenantUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
+func (e TenantUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
+func (e TenantUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantDeleteRequestValidationError) ErrorName() string {
-	return "TenantDeleteRequestValidationError"
+func (e TenantUpdateRequestValidationError) ErrorName() string {
+	return "TenantUpdateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantDeleteRequestValidationError) Error() string {
+func (e TenantUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8911,14 +10619,14 @@ func (e TenantDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantDeleteRequest.%s: %s%s",
+		"invalid %sTenantUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantDeleteRequestValidationError{}
+var _ error = TenantUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8926,24 +10634,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantDeleteRequestValidationError{}
+} = TenantUpdateRequestValidationError{}
 
-// Validate checks the field values on TenantDeleteResponse with the rules
+// Validate checks the field values on TenantUpdateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantDeleteResponse) Validate() error {
+func (m *TenantUpdateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantDeleteResponse with the rules
+// ValidateAll checks the field values on TenantUpdateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantDeleteResponseMultiError, or nil if none found.
-func (m *TenantDeleteResponse) ValidateAll() error {
+// TenantUpdateResponseMultiError, or nil if none found.
+func (m *TenantUpdateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantDeleteResponse) validate(all bool) error {
+func (m *TenantUpdateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8954,7 +10662,7 @@ func (m *TenantDeleteResponse) validate(all bool) error {
 		switch v := interface{}(m.GetTenant()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantDeleteResponseValidationError{
+				errors = append(errors, TenantUpdateResponseValidationError{
 					field:  "Tenant",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8962,7 +10670,7 @@ func (m *TenantDeleteResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantDeleteResponseValidationError{
+				errors = append(errors, TenantUpdateResponseValidationError{
 					field:  "Tenant",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8971,7 +10679,7 @@ func (m *TenantDeleteResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TenantDeleteResponseValidationError{
+			return TenantUpdateResponseValidationError{
 				field:  "Tenant",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -8980,19 +10688,19 @@ func (m *TenantDeleteResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TenantDeleteResponseMultiError(errors)
+		return TenantUpdateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantDeleteResponseMultiError is an error wrapping multiple validation
-// errors returned by TenantDeleteResponse.ValidateAll() if the designated
+// TenantUpdateResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantUpdateResponse.ValidateAll() if the designated
 // constraints aren't met.
-type TenantDeleteResponseMultiError []error
+type TenantUpdateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantDeleteResponseMultiError) Error() string {
+func (m TenantUpdateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9001,11 +10709,11 @@ func (m TenantDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantDeleteResponseMultiError) AllErrors() []error { return m }
+func (m TenantUpdateResponseMultiError) AllErrors() []error { return m }
 
-// TenantDeleteResponseValidationError is the validation error returned by
-// TenantDeleteResponse.Validate if the designated constraints aren't met.
-type TenantDeleteResponseValidationError struct {
+// TenantUpdateResponseValidationError is the validation error returned by
+// TenantUpdateResponse.Validate if the designated constraints aren't met.
+type TenantUpdateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9013,24 +10721,24 @@ type TenantDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantDeleteResponseValidationError) Field() string { return e.field }
+func (e TenantUpdateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantDeleteResponseValidationError) Reason() string { return e.reason }
+func (e TenantUpdateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantDeleteResponseValidationError) Cause() error { return e.cause }
+func (e TenantUpdateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantDeleteResponseValidationError) Key() bool { return e.key }
+func (e TenantUpdateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantDeleteResponseValidationError) ErrorName() string {
-	return "TenantDeleteResponseValidationError"
+func (e TenantUpdateResponseValidationError) ErrorName() string {
+	return "TenantUpdateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantDeleteResponseValidationError) Error() string {
+func (e TenantUpdateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9042,14 +10750,14 @@ func (e TenantDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantDeleteResponse.%s: %s%s",
+		"invalid %sTenantUpdateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantDeleteResponseValidationError{}
+var _ error = TenantUpdateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9057,7 +10765,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantDeleteResponseValidationError{}
+} = TenantUpdateResponseValidationError{}
 
 // Validate checks the field values on TenantListRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -9100,6 +10808,35 @@ func (m *TenantListRequest) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantListRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return TenantListRequestMultiError(errors)
 	}
@@ -701,13 +701,14 @@ var Schema_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Data_Write_FullMethodName               = "/base.v1.Data/Write"
-	Data_WriteRelationships_FullMethodName  = "/base.v1.Data/WriteRelationships"
-	Data_ReadRelationships_FullMethodName   = "/base.v1.Data/ReadRelationships"
-	Data_ReadAttributes_FullMethodName      = "/base.v1.Data/ReadAttributes"
-	Data_Delete_FullMethodName              = "/base.v1.Data/Delete"
-	Data_DeleteRelationships_FullMethodName = "/base.v1.Data/DeleteRelationships"
-	Data_RunBundle_FullMethodName           = "/base.v1.Data/RunBundle"
+	Data_Write_FullMethodName                 = "/base.v1.Data/Write"
+	Data_WriteRelationships_FullMethodName    = "/base.v1.Data/WriteRelationships"
+	Data_ReadRelationships_FullMethodName     = "/base.v1.Data/ReadRelationships"
+	Data_ReadAttributes_FullMethodName        = "/base.v1.Data/ReadAttributes"
+	Data_ReadRelationshipGraph_FullMethodName = "/base.v1.Data/ReadRelationshipGraph"
+	Data_Delete_FullMethodName                = "/base.v1.Data/Delete"
+	Data_DeleteRelationships_FullMethodName   = "/base.v1.Data/DeleteRelationships"
+	Data_RunBundle_FullMethodName             = "/base.v1.Data/RunBundle"
 )
 
 // DataClient is the client API for Data service.
@@ -724,6 +725,8 @@ type DataClient interface {
 	ReadRelationships(ctx context.Context, in *RelationshipReadRequest, opts ...grpc.CallOption) (*RelationshipReadResponse, error)
 	// The ReadAttributes RPC method reads attribute(s) of a relation.
 	ReadAttributes(ctx context.Context, in *AttributeReadRequest, opts ...grpc.CallOption) (*AttributeReadResponse, error)
+	// The ReadRelationshipGraph RPC method walks the relationships around an entity and returns them as a graph.
+	ReadRelationshipGraph(ctx context.Context, in *RelationshipGraphRequest, opts ...grpc.CallOption) (*RelationshipGraphResponse, error)
 	// The Delete RPC method deletes a relation tuple.
 	Delete(ctx context.Context, in *DataDeleteRequest, opts ...grpc.CallOption) (*DataDeleteResponse, error)
 	// RPC method to delete relationships for a tenant, accessed via a POST request to the specified path, tagged as "Data" in OpenAPI documentation.
@@ -780,6 +783,16 @@ func (c *dataClient) ReadAttributes(ctx context.Context, in *AttributeReadReques
 	return out, nil
 }
 
+func (c *dataClient) ReadRelationshipGraph(ctx context.Context, in *RelationshipGraphRequest, opts ...grpc.CallOption) (*RelationshipGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RelationshipGraphResponse)
+	err := c.cc.Invoke(ctx, Data_ReadRelationshipGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) Delete(ctx context.Context, in *DataDeleteRequest, opts ...grpc.CallOption) (*DataDeleteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DataDeleteResponse)
@@ -824,6 +837,8 @@ type DataServer interface {
 	ReadRelationships(context.Context, *RelationshipReadRequest) (*RelationshipReadResponse, error)
 	// The ReadAttributes RPC method reads attribute(s) of a relation.
 	ReadAttributes(context.Context, *AttributeReadRequest) (*AttributeReadResponse, error)
+	// The ReadRelationshipGraph RPC method walks the relationships around an entity and returns them as a graph.
+	ReadRelationshipGraph(context.Context, *RelationshipGraphRequest) (*RelationshipGraphResponse, error)
 	// The Delete RPC method deletes a relation tuple.
 	Delete(context.Context, *DataDeleteRequest) (*DataDeleteResponse, error)
 	// RPC method to delete relationships for a tenant, accessed via a POST request to the specified path, tagged as "Data" in OpenAPI documentation.
@@ -849,6 +864,9 @@ func (UnimplementedDataServer) ReadRelationships(context.Context, *RelationshipR
 func (UnimplementedDataServer) ReadAttributes(context.Context, *AttributeReadRequest) (*AttributeReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadAttributes not implemented")
 }
+func (UnimplementedDataServer) ReadRelationshipGraph(context.Context, *RelationshipGraphRequest) (*RelationshipGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadRelationshipGraph not implemented")
+}
 func (UnimplementedDataServer) Delete(context.Context, *DataDeleteRequest) (*DataDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -943,6 +961,24 @@ func _Data_ReadAttributes_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_ReadRelationshipGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ReadRelationshipGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ReadRelationshipGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ReadRelationshipGraph(ctx, req.(*RelationshipGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DataDeleteRequest)
 	if err := dec(in); err != nil {
@@ -1020,6 +1056,10 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadAttributes",
 			Handler:    _Data_ReadAttributes_Handler,
 		},
+		{
+			MethodName: "ReadRelationshipGraph",
+			Handler:    _Data_ReadRelationshipGraph_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _Data_Delete_Handler,
@@ -1041,6 +1081,7 @@ const (
 	Bundle_Write_FullMethodName  = "/base.v1.Bundle/Write"
 	Bundle_Read_FullMethodName   = "/base.v1.Bundle/Read"
 	Bundle_Delete_FullMethodName = "/base.v1.Bundle/Delete"
+	Bundle_List_FullMethodName   = "/base.v1.Bundle/List"
 )
 
 // BundleClient is the client API for Bundle service.
@@ -1053,6 +1094,8 @@ type BundleClient interface {
 	Read(ctx context.Context, in *BundleReadRequest, opts ...grpc.CallOption) (*BundleReadResponse, error)
 	// Deletes a specific data bundle. This method is used to remove existing bundles from the system.
 	Delete(ctx context.Context, in *BundleDeleteRequest, opts ...grpc.CallOption) (*BundleDeleteResponse, error)
+	// Lists the bundles of a tenant, or the versions of a single bundle when a name is given.
+	List(ctx context.Context, in *BundleListRequest, opts ...grpc.CallOption) (*BundleListResponse, error)
 }
 
 type bundleClient struct {
@@ -1093,6 +1136,16 @@ func (c *bundleClient) Delete(ctx context.Context, in *BundleDeleteRequest, opts
 	return out, nil
 }
 
+func (c *bundleClient) List(ctx context.Context, in *BundleListRequest, opts ...grpc.CallOption) (*BundleListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleListResponse)
+	err := c.cc.Invoke(ctx, Bundle_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BundleServer is the server API for Bundle service.
 // All implementations must embed UnimplementedBundleServer
 // for forward compatibility
@@ -1103,6 +1156,8 @@ type BundleServer interface {
 	Read(context.Context, *BundleReadRequest) (*BundleReadResponse, error)
 	// Deletes a specific data bundle. This method is used to remove existing bundles from the system.
 	Delete(context.Context, *BundleDeleteRequest) (*BundleDeleteResponse, error)
+	// Lists the bundles of a tenant, or the versions of a single bundle when a name is given.
+	List(context.Context, *BundleListRequest) (*BundleListResponse, error)
 	mustEmbedUnimplementedBundleServer()
 }
 
@@ -1119,6 +1174,9 @@ func (UnimplementedBundleServer) Read(context.Context, *BundleReadRequest) (*Bun
 func (UnimplementedBundleServer) Delete(context.Context, *BundleDeleteRequest) (*BundleDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedBundleServer) List(context.Context, *BundleListRequest) (*BundleListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
 func (UnimplementedBundleServer) mustEmbedUnimplementedBundleServer() {}
 
 // UnsafeBundleServer may be embedded to opt out of forward compatibility for this service.
@@ -1186,6 +1244,24 @@ func _Bundle_Delete_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bundle_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BundleListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bundle_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServer).List(ctx, req.(*BundleListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Bundle_ServiceDesc is the grpc.ServiceDesc for Bundle service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1205,6 +1281,10 @@ var Bundle_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Bundle_Delete_Handler,
 		},
+		{
+			MethodName: "List",
+			Handler:    _Bundle_List_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
@@ -1213,6 +1293,8 @@ var Bundle_ServiceDesc = grpc.ServiceDesc{
 const (
 	Tenancy_Create_FullMethodName = "/base.v1.Tenancy/Create"
 	Tenancy_Delete_FullMethodName = "/base.v1.Tenancy/Delete"
+	Tenancy_Read_FullMethodName   = "/base.v1.Tenancy/Read"
+	Tenancy_Update_FullMethodName = "/base.v1.Tenancy/Update"
 	Tenancy_List_FullMethodName   = "/base.v1.Tenancy/List"
 )
 
@@ -1226,6 +1308,12 @@ type TenancyClient interface {
 	// Delete is a unary RPC to delete an existing tenant.
 	// It requires a TenantDeleteRequest and returns a TenantDeleteResponse.
 	Delete(ctx context.Context, in *TenantDeleteRequest, opts ...grpc.CallOption) (*TenantDeleteResponse, error)
+	// Read is a unary RPC to get a single tenant by its identifier.
+	// It requires a TenantReadRequest and returns a TenantReadResponse.
+	Read(ctx context.Context, in *TenantReadRequest, opts ...grpc.CallOption) (*TenantReadResponse, error)
+	// Update is a unary RPC to rename a tenant and to change its labels.
+	// It requires a TenantUpdateRequest and returns a TenantUpdateResponse.
+	Update(ctx context.Context, in *TenantUpdateRequest, opts ...grpc.CallOption) (*TenantUpdateResponse, error)
 	// List is a unary RPC to get a list of all tenants.
 	// It requires a TenantListRequest and returns a TenantListResponse.
 	List(ctx context.Context, in *TenantListRequest, opts ...grpc.CallOption) (*TenantListResponse, error)
@@ -1259,6 +1347,26 @@ func (c *tenancyClient) Delete(ctx context.Context, in *TenantDeleteRequest, opt
 	return out, nil
 }
 
+func (c *tenancyClient) Read(ctx context.Context, in *TenantReadRequest, opts ...grpc.CallOption) (*TenantReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantReadResponse)
+	err := c.cc.Invoke(ctx, Tenancy_Read_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenancyClient) Update(ctx context.Context, in *TenantUpdateRequest, opts ...grpc.CallOption) (*TenantUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantUpdateResponse)
+	err := c.cc.Invoke(ctx, Tenancy_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tenancyClient) List(ctx context.Context, in *TenantListRequest, opts ...grpc.CallOption) (*TenantListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TenantListResponse)
@@ -1279,6 +1387,12 @@ type TenancyServer interface {
 	// Delete is a unary RPC to delete an existing tenant.
 	// It requires a TenantDeleteRequest and returns a TenantDeleteResponse.
 	Delete(context.Context, *TenantDeleteRequest) (*TenantDeleteResponse, error)
+	// Read is a unary RPC to get a single tenant by its identifier.
+	// It requires a TenantReadRequest and returns a TenantReadResponse.
+	Read(context.Context, *TenantReadRequest) (*TenantReadResponse, error)
+	// Update is a unary RPC to rename a tenant and to change its labels.
+	// It requires a TenantUpdateRequest and returns a TenantUpdateResponse.
+	Update(context.Context, *TenantUpdateRequest) (*TenantUpdateResponse, error)
 	// List is a unary RPC to get a list of all tenants.
 	// It requires a TenantListRequest and returns a TenantListResponse.
 	List(context.Context, *TenantListRequest) (*TenantListResponse, error)
@@ -1295,6 +1409,12 @@ func (UnimplementedTenancyServer) Create(context.Context, *TenantCreateRequest)
 func (UnimplementedTenancyServer) Delete(context.Context, *TenantDeleteRequest) (*TenantDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedTenancyServer) Read(context.Context, *TenantReadRequest) (*TenantReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedTenancyServer) Update(context.Context, *TenantUpdateRequest) (*TenantUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
 func (UnimplementedTenancyServer) List(context.Context, *TenantListRequest) (*TenantListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -1347,6 +1467,42 @@ func _Tenancy_Delete_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tenancy_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenancyServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenancy_Read_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenancyServer).Read(ctx, req.(*TenantReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenancy_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenancyServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tenancy_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenancyServer).Update(ctx, req.(*TenantUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Tenancy_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TenantListRequest)
 	if err := dec(in); err != nil {
@@ -1380,6 +1536,14 @@ var Tenancy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Tenancy_Delete_Handler,
 		},
+		{
+			MethodName: "Read",
+			Handler:    _Tenancy_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Tenancy_Update_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _Tenancy_List_Handler,
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/cel-go/cel"
 
+	"github.com/Permify/permify/pkg/dsl/functions"
 	"github.com/Permify/permify/pkg/dsl/utils"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -79,7 +80,7 @@ func Entities(defs ...*base.EntityDefinition) []*base.EntityDefinition {
 func Rule(name string, arguments map[string]base.AttributeType, expression string) *base.RuleDefinition {
 	// Initialize an empty slice of environment options.
 	var envOptions []cel.EnvOption
-	envOptions = append(envOptions, cel.Variable("context", cel.DynType))
+	envOptions = append(envOptions, cel.Variable("context", cel.DynType), functions.Library())
 
 	// Iterate through each argument.
 	for name, ty := range arguments {
@@ -49,6 +49,24 @@ func NewSchemaLoader() *Loader {
 	}
 }
 
+// NewLocalSchemaLoader initializes and returns a new Loader instance for the files given to the commands of the CLI.
+// Unlike NewSchemaLoader, it reads files from any path of the local file system, such as absolute paths and paths
+// outside the working directory, and the imports of a file are read from there as well.
+func NewLocalSchemaLoader() *Loader {
+	return &Loader{
+		loaders: map[Type]func(string) (string, error){
+			// URL loader function for handling URL type schemas.
+			URL: loadFromURL,
+
+			// File loader function reading the file at any path.
+			File: loadFromLocalFile,
+
+			// Inline loader function for handling inline type schemas.
+			Inline: loadInline,
+		},
+	}
+}
+
 // LoadSchema loads a schema based on its type
 func (s *Loader) LoadSchema(input string) (string, error) {
 	schemaType, err := determineSchemaType(input)
@@ -152,6 +170,16 @@ func loadFromFile(path string) (string, error) {
 	return string(content), nil
 }
 
+// loadFromLocalFile reads the file at the given path, without restricting the path to the working directory.
+func loadFromLocalFile(path string) (string, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
 // loadInline is a function that handles inline schema types.
 func loadInline(schema string) (string, error) {
 	// Add validation if necessary. For example:
@@ -0,0 +1,216 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	"github.com/Permify/permify/pkg/dsl/token"
+)
+
+// Module represents a single file of a schema that is split into multiple files with import statements.
+type Module struct {
+	// Name is the path or the URL of the file, or the key of the file in the given files.
+	// It is empty for inline schemas.
+	Name string
+	// Content is the schema definition in the file.
+	Content string
+}
+
+// NewInlineSchemaLoader initializes and returns a new Loader instance that can only load inline schemas.
+// Imports are resolved from the given files only, the file system and the network are never accessed.
+func NewInlineSchemaLoader() *Loader {
+	return &Loader{
+		loaders: map[Type]func(string) (string, error){
+			// Inline loader function for handling inline type schemas.
+			Inline: loadInline,
+		},
+	}
+}
+
+// LoadModules loads the schema from the given input, which can be a URL, a file path or an inline schema,
+// and resolves its imports. See ResolveModules for how the imports are resolved.
+func (s *Loader) LoadModules(input string, files map[string]string) ([]Module, error) {
+	return s.LoadEmbeddedModules("", input, files)
+}
+
+// LoadEmbeddedModules loads the schema embedded in the file with the given name, such as the schema of a shape file,
+// and resolves its imports. The input can be a URL, a file path or an inline schema. An inline schema is named after
+// the file, so that its imports are relative to the file and not to the working directory, and its errors carry the
+// name of the file.
+func (s *Loader) LoadEmbeddedModules(name, input string, files map[string]string) ([]Module, error) {
+	content, err := s.LoadSchema(input)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := Module{Name: name, Content: content}
+
+	schemaType, err := determineSchemaType(input)
+	if err != nil {
+		return nil, fmt.Errorf("error determining schema type: %w", err)
+	}
+	if schemaType != Inline {
+		entry.Name = input
+	}
+
+	return s.ResolveModules(entry, files)
+}
+
+// ResolveModules resolves the imports of the entry file recursively and returns all files of the schema,
+// where every file is included once and comes after the files it imports.
+//
+// Import paths are relative to the importing file. An import is looked up in the given files first,
+// and it is loaded from a URL or a file path if the loader supports it.
+func (s *Loader) ResolveModules(entry Module, files map[string]string) ([]Module, error) {
+	var resolved []Module
+	visited := map[string]bool{entry.Name: true}
+
+	// clean the names of the given files, so that they match the resolved import paths
+	cleaned := make(map[string]string, len(files))
+	for name, content := range files {
+		if !isURL(name) {
+			name = path.Clean(name)
+		}
+		cleaned[name] = content
+	}
+
+	var resolve func(file Module) error
+	resolve = func(file Module) error {
+		sch, err := parser.NewFileParser(file.Name, file.Content).Parse()
+		if err != nil {
+			return err
+		}
+
+		for _, imp := range sch.Imports {
+			name, err := importName(file.Name, imp.Path.Literal)
+			if err != nil {
+				return token.NewError(imp.Path.PositionInfo, err.Error())
+			}
+
+			if visited[name] {
+				continue
+			}
+			visited[name] = true
+
+			content, err := s.loadImport(name, cleaned)
+			if err != nil {
+				return token.NewError(imp.Path.PositionInfo, fmt.Sprintf("import %s cannot be loaded: %s", imp.Path.Literal, err.Error()))
+			}
+
+			if err = resolve(Module{Name: name, Content: content}); err != nil {
+				return err
+			}
+		}
+
+		resolved = append(resolved, file)
+		return nil
+	}
+
+	if err := resolve(entry); err != nil {
+		return nil, err
+	}
+
+	return resolved, nil
+}
+
+// loadImport loads the content of the imported file with the given name.
+func (s *Loader) loadImport(name string, files map[string]string) (string, error) {
+	if content, ok := files[name]; ok {
+		return content, nil
+	}
+
+	schemaType := File
+	if isURL(name) {
+		schemaType = URL
+	}
+
+	loaderFunc, exists := s.loaders[schemaType]
+	if !exists {
+		return "", errors.New("file not found")
+	}
+
+	return loaderFunc(name)
+}
+
+// importName returns the name of the imported file, resolving the import path relative to the importing file.
+func importName(importer, importPath string) (string, error) {
+	if isURL(importPath) {
+		return importPath, nil
+	}
+
+	if isURL(importer) {
+		base, err := url.Parse(importer)
+		if err != nil {
+			return "", err
+		}
+		ref, err := url.Parse(importPath)
+		if err != nil {
+			return "", err
+		}
+		return base.ResolveReference(ref).String(), nil
+	}
+
+	if path.IsAbs(importPath) {
+		return "", errors.New("import path must be relative")
+	}
+
+	return path.Join(path.Dir(importer), importPath), nil
+}
+
+// Parse parses the given modules into a single schema. Definitions are not allowed to be duplicated across files,
+// and the errors include the file of the definition.
+func Parse(modules ...Module) (*ast.Schema, error) {
+	sch := ast.NewSchema()
+
+	// definitions maps the name of every entity, rule and macro to the file it is defined in
+	definitions := map[string]string{}
+
+	for _, file := range modules {
+		fsch, err := parser.NewFileParser(file.Name, file.Content).Parse()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, st := range fsch.Statements {
+			if defined, ok := definitions[st.GetName()]; ok {
+				return nil, token.NewError(statementPosition(st), fmt.Sprintf("duplication found for %s, already defined in %s", st.GetName(), fileName(defined)))
+			}
+			definitions[st.GetName()] = file.Name
+		}
+
+		if err = sch.GetReferences().Merge(fsch.GetReferences()); err != nil {
+			return nil, err
+		}
+
+		sch.Statements = append(sch.Statements, fsch.Statements...)
+		sch.Imports = append(sch.Imports, fsch.Imports...)
+	}
+
+	return sch, nil
+}
+
+// statementPosition returns the position of the name of an entity, rule or macro statement.
+func statementPosition(st ast.Statement) token.PositionInfo {
+	switch s := st.(type) {
+	case *ast.EntityStatement:
+		return s.Name.PositionInfo
+	case *ast.RuleStatement:
+		return s.Name.PositionInfo
+	case *ast.MacroStatement:
+		return s.Name.PositionInfo
+	default:
+		return token.PositionInfo{}
+	}
+}
+
+// fileName returns the name of the file to be displayed in the error messages.
+func fileName(name string) string {
+	if name == "" {
+		return "inline schema"
+	}
+	return name
+}
@@ -0,0 +1,211 @@
+package schema
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/token"
+)
+
+var _ = Describe("Modules", func() {
+	Context("ResolveModules function", func() {
+		It("should resolve imports from the given files", func() {
+			loader := NewInlineSchemaLoader()
+			modules, err := loader.ResolveModules(Module{Content: `
+			import "./organization.perm"
+
+			entity repository {
+				relation parent @organization
+				relation owner @user
+
+				permission edit = owner or parent.admin
+			}`}, map[string]string{
+				"organization.perm": `
+				import "common/user.perm"
+
+				entity organization {
+					relation admin @user
+				}`,
+				"common/user.perm": `entity user {}`,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(modules).Should(HaveLen(3))
+			Expect(modules[0].Name).Should(Equal("common/user.perm"))
+			Expect(modules[1].Name).Should(Equal("organization.perm"))
+			Expect(modules[2].Name).Should(Equal(""))
+
+			sch, err := Parse(modules...)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sch.Statements).Should(HaveLen(3))
+
+			_, _, err = compiler.NewCompiler(true, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should include files imported more than once a single time", func() {
+			loader := NewInlineSchemaLoader()
+			modules, err := loader.ResolveModules(Module{Content: `
+			import "user.perm"
+			import "organization.perm"`}, map[string]string{
+				"organization.perm": `
+				import "user.perm"
+				import "organization.perm"
+
+				entity organization {
+					relation admin @user
+				}`,
+				"user.perm": `entity user {}`,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(modules).Should(HaveLen(3))
+		})
+
+		It("should not access the file system with the inline loader", func() {
+			loader := NewInlineSchemaLoader()
+			_, err := loader.ResolveModules(Module{Content: `
+			import "testdata/modules/user.perm"`}, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("2:12:import testdata/modules/user.perm cannot be loaded: file not found"))
+		})
+
+		It("should report syntax errors with the file", func() {
+			loader := NewInlineSchemaLoader()
+			_, err := loader.ResolveModules(Module{Content: `import "user.perm"`}, map[string]string{
+				"user.perm": `entity user {`,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(HavePrefix("user.perm:"))
+
+			var positioned *token.Error
+			Expect(errors.As(err, &positioned)).Should(BeTrue())
+			Expect(positioned.PositionInfo.File).Should(Equal("user.perm"))
+			Expect(positioned.PositionInfo.LinePosition).Should(Equal(1))
+		})
+	})
+
+	Context("LoadModules function", func() {
+		It("should load imports relative to the importing file", func() {
+			loader := NewSchemaLoader()
+			modules, err := loader.LoadModules("testdata/modules/main.perm", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(modules).Should(HaveLen(3))
+			Expect(modules[0].Name).Should(Equal("testdata/modules/user.perm"))
+			Expect(modules[1].Name).Should(Equal("testdata/modules/teams/organization.perm"))
+			Expect(modules[2].Name).Should(Equal("testdata/modules/main.perm"))
+
+			sch, err := Parse(modules...)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = compiler.NewCompiler(true, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should load absolute paths and their imports with the local loader", func() {
+			main, err := filepath.Abs("testdata/modules/main.perm")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = NewSchemaLoader().LoadModules(main, nil)
+			Expect(err).Should(MatchError("invalid file path"))
+
+			modules, err := NewLocalSchemaLoader().LoadModules(main, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			dir := filepath.Dir(main)
+			Expect(modules).Should(HaveLen(3))
+			Expect(modules[0].Name).Should(Equal(filepath.Join(dir, "user.perm")))
+			Expect(modules[1].Name).Should(Equal(filepath.Join(dir, "teams/organization.perm")))
+			Expect(modules[2].Name).Should(Equal(main))
+		})
+	})
+
+	Context("LoadEmbeddedModules function", func() {
+		embedded := `
+		import "teams/organization.perm"
+
+		entity repository {
+			relation parent @organization
+			permission edit = parent.admin
+		}`
+
+		// chdir - changes the working directory for the rest of the test
+		chdir := func(dir string) {
+			wd, err := os.Getwd()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(os.Chdir(dir)).Should(Succeed())
+			DeferCleanup(os.Chdir, wd)
+		}
+
+		It("should resolve imports relative to the file from any working directory", func() {
+			chdir("testdata")
+
+			modules, err := NewSchemaLoader().LoadEmbeddedModules("modules/shape.yaml", embedded, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(modules).Should(HaveLen(3))
+			Expect(modules[0].Name).Should(Equal("modules/user.perm"))
+			Expect(modules[1].Name).Should(Equal("modules/teams/organization.perm"))
+			Expect(modules[2].Name).Should(Equal("modules/shape.yaml"))
+
+			chdir("modules")
+
+			modules, err = NewSchemaLoader().LoadEmbeddedModules("shape.yaml", embedded, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(modules).Should(HaveLen(3))
+			Expect(modules[2].Name).Should(Equal("shape.yaml"))
+
+			_, err = NewSchemaLoader().LoadModules(embedded, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should report the errors of the schema with the file", func() {
+			dir, err := filepath.Abs("testdata/modules")
+			Expect(err).ShouldNot(HaveOccurred())
+			chdir(GinkgoT().TempDir())
+
+			shape := filepath.Join(dir, "shape.yaml")
+			_, err = NewLocalSchemaLoader().LoadEmbeddedModules(shape, `import "teams/team.perm"`, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(HavePrefix(shape + ":1:9:import teams/team.perm cannot be loaded"))
+
+			_, err = NewLocalSchemaLoader().LoadModules(`import "teams/organization.perm"`, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(HavePrefix("1:9:import teams/organization.perm cannot be loaded"))
+		})
+	})
+
+	Context("Parse function", func() {
+		It("should detect duplicate definitions across files", func() {
+			_, err := Parse(
+				Module{Name: "user.perm", Content: `entity user {}`},
+				Module{Name: "team.perm", Content: `
+				entity team {}
+
+				entity user {}`},
+			)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal("team.perm:4:13:duplication found for user, already defined in user.perm"))
+		})
+
+		It("should report compile errors with the file", func() {
+			sch, err := Parse(
+				Module{Name: "user.perm", Content: `entity user {}`},
+				Module{Name: "team.perm", Content: `
+				entity team {
+					relation member @user
+					permission view = owner
+				}`},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = compiler.NewCompiler(true, sch).Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(HavePrefix("team.perm:4:"))
+		})
+	})
+})
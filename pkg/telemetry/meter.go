@@ -22,17 +22,14 @@ import (
 )
 
 // NewMeter - Creates new meter
-func NewMeter(exporter metric.Exporter, interval time.Duration) func(context.Context) error {
+func NewMeter(reader metric.Reader) func(context.Context) error {
 	hostName, err := os.Hostname()
 	if err != nil {
 		return func(context.Context) error { return nil }
 	}
 
 	mp := metric.NewMeterProvider(
-		metric.WithReader(metric.NewPeriodicReader(
-			exporter,
-			metric.WithInterval(interval),
-		)),
+		metric.WithReader(reader),
 		metric.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceNameKey.String("permify"),
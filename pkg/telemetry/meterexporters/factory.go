@@ -2,6 +2,7 @@ package meterexporters
 
 import (
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/otel/sdk/metric"
 )
@@ -15,3 +16,18 @@ func ExporterFactory(name, endpoint string, insecure bool, urlpath string, heade
 		return nil, fmt.Errorf("%s meter exporter is unsupported", name)
 	}
 }
+
+// ReaderFactory - Create meter reader according to given params. Push based exporters are
+// wrapped in a periodic reader, while the prometheus exporter is read on every scrape.
+func ReaderFactory(name, endpoint string, insecure bool, urlpath string, headers map[string]string, protocol string, interval time.Duration) (metric.Reader, error) {
+	switch name {
+	case "prometheus":
+		return NewPrometheus()
+	default:
+		exporter, err := ExporterFactory(name, endpoint, insecure, urlpath, headers, protocol)
+		if err != nil {
+			return nil, err
+		}
+		return metric.NewPeriodicReader(exporter, metric.WithInterval(interval)), nil
+	}
+}
@@ -0,0 +1,26 @@
+package meterexporters
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+// NewPrometheus - Creates new Prometheus exporter. Unlike the push based exporters it is a reader
+// that collects the metrics whenever the handler returned by PrometheusHandler is scraped.
+func NewPrometheus() (metric.Reader, error) {
+	exporter, err := otelprom.New(otelprom.WithRegisterer(prometheus.DefaultRegisterer))
+	if err != nil {
+		return nil, err
+	}
+
+	return exporter, nil
+}
+
+// PrometheusHandler - Returns the handler serving the metrics collected by the Prometheus exporter.
+func PrometheusHandler() http.Handler {
+	return promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{})
+}